@@ -2,6 +2,6 @@ module github.com/kiracore/sekai-cli
 
 go 1.21.0
 
-toolchain go1.21.13
+toolchain go1.24.4
 
 require github.com/goccy/go-yaml v1.15.13
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"os"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/config"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/keys"
+)
+
+// addressEntry is an address book entry as printed by config address list.
+type addressEntry struct {
+	Alias   string `json:"alias"`
+	Address string `json:"address"`
+}
+
+// resolveAddresses resolves recipients given as addresses, key names or
+// address book aliases, in that order, to addresses.
+func (a *App) resolveAddresses(client sdk.Client, namesOrAddresses ...string) ([]string, error) {
+	resolver := keys.NewResolver(client, a.config.Addresses)
+	addresses := make([]string, len(namesOrAddresses))
+	for i, s := range namesOrAddresses {
+		address, err := resolver.Resolve(context.Background(), s)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = address
+	}
+	return addresses, nil
+}
+
+// buildConfigAddressCommand builds the config address command group.
+func (a *App) buildConfigAddressCommand() *cli.Command {
+	addressCmd := cli.NewCommand("address")
+	addressCmd.Short = "Manage the address book"
+	addressCmd.Long = `Manage the address book of the config file: aliases for addresses that are
+not in the keyring. Wherever a recipient address is accepted, such as in
+'bank send' and scenario steps, a key name is tried first, then an alias.`
+
+	addCmd := cli.NewCommand("add")
+	addCmd.Short = "Add or replace an address book entry"
+	addCmd.Usage = `  sekai-cli config address add treasury kira1...`
+	addCmd.Args = []cli.Arg{
+		{Name: "alias", Required: true},
+		{Name: "address", Required: true, Description: "kira1... or kiravaloper1... address"},
+	}
+	addCmd.Run = func(ctx *cli.Context) error {
+		alias, address := ctx.Args[0], ctx.Args[1]
+		path, err := a.updateConfigFile(func(c *config.Config) error {
+			return c.AddAddress(alias, address)
+		})
+		if err != nil {
+			return err
+		}
+		ctx.Printf("Added %s = %s to %s\n", alias, address, path)
+		return nil
+	}
+	addressCmd.AddCommand(addCmd)
+
+	removeCmd := cli.NewCommand("remove")
+	removeCmd.Aliases = []string{"rm"}
+	removeCmd.Short = "Remove an address book entry"
+	removeCmd.Args = []cli.Arg{{Name: "alias", Required: true}}
+	removeCmd.Run = func(ctx *cli.Context) error {
+		alias := ctx.Args[0]
+		path, err := a.updateConfigFile(func(c *config.Config) error {
+			return c.RemoveAddress(alias)
+		})
+		if err != nil {
+			return err
+		}
+		ctx.Printf("Removed %s from %s\n", alias, path)
+		return nil
+	}
+	addressCmd.AddCommand(removeCmd)
+
+	listCmd := cli.NewCommand("list")
+	listCmd.Aliases = []string{"ls"}
+	listCmd.Short = "List address book entries"
+	listCmd.Run = func(ctx *cli.Context) error {
+		entries := []addressEntry{}
+		for _, alias := range a.config.AddressAliases() {
+			entries = append(entries, addressEntry{Alias: alias, Address: a.config.Addresses[alias]})
+		}
+		return a.printOutput(ctx, entries)
+	}
+	addressCmd.AddCommand(listCmd)
+
+	return addressCmd
+}
+
+// updateConfigFile applies update to the config file as written, without
+// environment overrides, saves it and returns its path.
+func (a *App) updateConfigFile(update func(*config.Config) error) (string, error) {
+	path := getStringOrDefault(a.config.Path(), config.DefaultConfigPath())
+	fileConfig := config.Default()
+	if _, err := os.Stat(path); err == nil {
+		if err := fileConfig.LoadFromFile(path); err != nil {
+			return "", err
+		}
+	}
+	if err := update(fileConfig); err != nil {
+		return "", err
+	}
+	if err := fileConfig.Save(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
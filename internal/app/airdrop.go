@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/output"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/txs"
+)
+
+// buildAirdropCommand builds "bank airdrop".
+func (a *App) buildAirdropCommand() *cli.Command {
+	cmd := cli.NewCommand("airdrop")
+	cmd.Short = "Send tokens to a list of recipients, resumable after an interruption"
+	cmd.Long = `Send each recipient of a CSV file of "address,amount" rows its amount, in
+multi-send batches that are each waited for until included in a block.
+
+Every batch is recorded in the --state file when broadcast and again once
+its result is known, so re-running the same command after an interruption
+skips the recipients already sent. A batch signed with a stale account
+sequence is sent again; a batch the chain rejects is retried one recipient
+at a time, and recipients that still fail are reported at the end. The run
+stops on errors that would fail every batch, such as insufficient funds or
+an unreachable node.`
+	cmd.Usage = `  sekai-cli bank airdrop genesis --recipients-file recipients.csv --state airdrop.state --fees 100ukex
+  sekai-cli bank airdrop genesis --recipients-file recipients.csv --state airdrop.state --batch-size 50 -y`
+	cmd.Args = []cli.Arg{
+		{Name: "from", Required: true, Description: "Sender key name", Complete: cli.CompleteKeys},
+	}
+	cmd.Flags = []cli.Flag{
+		{Name: "recipients-file", Usage: "CSV file of address,amount rows, one per recipient (- for stdin)"},
+		{Name: "state", Usage: "State file recording the batches sent, read again to resume"},
+		{Name: "batch-size", Usage: "Recipients per multi-send transaction", Default: strconv.Itoa(bank.DefaultAirdropBatchSize)},
+		{Name: "sequence-retries", Usage: "How often a batch is sent again after an account sequence mismatch", Default: strconv.Itoa(bank.DefaultAirdropSequenceRetries)},
+	}
+	cli.AddTxFlags(cmd)
+	cmd.Run = a.runAirdrop
+	return cmd
+}
+
+// runAirdrop runs "bank airdrop". Progress goes to stderr; the result,
+// including recipients that failed, is printed even when the run stops
+// early.
+func (a *App) runAirdrop(ctx *cli.Context) error {
+	if len(ctx.Args) != 1 {
+		return fmt.Errorf("sender required")
+	}
+	path, statePath := ctx.GetFlag("recipients-file"), ctx.GetFlag("state")
+	if path == "" || statePath == "" {
+		return cli.Usagef("--recipients-file and --state required")
+	}
+	batchSize, err := strconv.Atoi(ctx.GetFlag("batch-size"))
+	if err != nil || batchSize < 1 {
+		return cli.Usagef("invalid --batch-size: %s", ctx.GetFlag("batch-size"))
+	}
+	retries, err := strconv.Atoi(ctx.GetFlag("sequence-retries"))
+	if err != nil || retries < 0 {
+		return cli.Usagef("invalid --sequence-retries: %s", ctx.GetFlag("sequence-retries"))
+	}
+	if retries == 0 {
+		retries = -1
+	}
+	timeout, err := parseDuration(ctx.GetFlag("wait-timeout"))
+	if err != nil || timeout <= 0 {
+		return cli.Usagef("invalid --wait-timeout: %s", ctx.GetFlag("wait-timeout"))
+	}
+
+	var r io.Reader = ctx.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to read --recipients-file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	recipients, err := bank.ParseRecipientsCSV(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	ledger, err := bank.OpenAirdropLedger(statePath)
+	if err != nil {
+		return err
+	}
+	defer ledger.Close()
+
+	pending := ledger.Pending(recipients)
+	total, err := bank.SumRecipients(pending)
+	if err != nil {
+		return err
+	}
+	from := ctx.Args[0]
+	if err := a.confirmTx(ctx,
+		fmt.Sprintf("From:     %s", from),
+		fmt.Sprintf("To:       %d of %d recipients from %s (%d already sent)", len(pending), len(recipients), path, len(recipients)-len(pending)),
+		fmt.Sprintf("Total:    %s", total),
+		fmt.Sprintf("Batches:  %d of up to %d recipients", (len(pending)+batchSize-1)/batchSize, batchSize),
+	); err != nil {
+		return err
+	}
+
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return err
+	}
+	txMod := txs.New(client)
+	tty := output.IsTerminal(ctx.Stderr)
+	result, err := bank.New(client).Airdrop(context.Background(), from, recipients, &bank.AirdropOptions{
+		SendOptions: bank.SendOptions{
+			Fees:          ctx.GetFlag("fees"),
+			Gas:           ctx.GetFlag("gas"),
+			Memo:          ctx.GetFlag("memo"),
+			BroadcastMode: "sync",
+		},
+		BatchSize:       batchSize,
+		SequenceRetries: retries,
+		Ledger:          ledger,
+		Confirm: func(waitCtx context.Context, hash string) (bool, error) {
+			result, err := txMod.Wait(waitCtx, hash, &txs.WaitOptions{Timeout: timeout})
+			if err != nil {
+				return false, err
+			}
+			return result.Success(), nil
+		},
+		Progress: func(done, total int) {
+			if tty {
+				ctx.Errorf("\r\x1b[KSent %d of %d recipients", done, total)
+			} else {
+				ctx.Errorf("Sent %d of %d recipients\n", done, total)
+			}
+		},
+	})
+	if tty {
+		ctx.Errorf("\r\x1b[K")
+	}
+	if result != nil {
+		if printErr := a.printOutput(ctx, result); printErr != nil {
+			return printErr
+		}
+	}
+	if errors.Is(err, txs.ErrNotConfirmed) {
+		return fmt.Errorf("%w; re-run the same command to check it and resume", err)
+	}
+	if err != nil {
+		return fmt.Errorf("airdrop stopped: %w; re-run the same command to resume", err)
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("%d of %d recipients failed", len(result.Failed), len(recipients))
+	}
+	return nil
+}
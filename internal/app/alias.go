@@ -0,0 +1,164 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// maxAliasDepth limits how deeply aliases may expand into other aliases,
+// so an alias that refers to itself fails instead of looping.
+const maxAliasDepth = 8
+
+var (
+	aliasArgRef  = regexp.MustCompile(`\.arg(\d+)\b`)
+	aliasArgsRef = regexp.MustCompile(`\.args\b`)
+)
+
+// aliasInfo describes a configured alias for --list-aliases.
+type aliasInfo struct {
+	Name    string `json:"name"`
+	Command string `json:"command"`
+}
+
+// addAliases registers the aliases of the config as subcommands of root.
+// Aliases whose name collides with a built-in command, or whose template
+// does not parse, are skipped with a warning.
+func (a *App) addAliases(root *cli.Command) {
+	for _, name := range sortedKeys(a.config.Aliases) {
+		name, text := name, a.config.Aliases[name]
+		if err := checkAliasName(root, name); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: alias %q ignored: %v\n", name, err)
+			continue
+		}
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: alias %q ignored: %v\n", name, err)
+			continue
+		}
+
+		cmd := cli.NewCommand(name)
+		cmd.Short = "Alias for: " + text
+		cmd.Passthrough = true
+		cmd.AddArg(cli.Arg{Name: "args", Description: "Arguments substituted into the template; the rest are appended"})
+		cmd.Run = func(ctx *cli.Context) error {
+			return a.runAlias(ctx, name, text, tmpl)
+		}
+		root.AddCommand(cmd)
+		a.aliases = append(a.aliases, aliasInfo{Name: name, Command: text})
+	}
+}
+
+// checkAliasName rejects alias names that are not plain words or that
+// collide with a built-in command.
+func checkAliasName(root *cli.Command, name string) error {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+		return fmt.Errorf("invalid name")
+	}
+	if name == "help" || name == completeCommand {
+		return fmt.Errorf("collides with built-in command %q", name)
+	}
+	for _, sub := range root.SubCommands {
+		if sub.Name == name || slices.Contains(sub.Aliases, name) {
+			return fmt.Errorf("collides with built-in command %q", sub.Name)
+		}
+	}
+	return nil
+}
+
+// runAlias expands an alias with the given arguments and executes the
+// resulting command line through the command tree, keeping the global
+// flags given before the alias name.
+func (a *App) runAlias(ctx *cli.Context, name, text string, tmpl *template.Template) error {
+	if a.aliasDepth >= maxAliasDepth {
+		return fmt.Errorf("alias %q expands recursively", name)
+	}
+
+	args, err := expandAlias(tmpl, text, ctx.Args)
+	if err != nil {
+		return fmt.Errorf("alias %q: %w", name, err)
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("alias %q expands to an empty command", name)
+	}
+
+	a.aliasDepth++
+	defer func() { a.aliasDepth-- }()
+
+	sub := &cli.Context{
+		Stdin:  ctx.Stdin,
+		Stdout: ctx.Stdout,
+		Stderr: ctx.Stderr,
+	}
+	return a.root.ExecuteContext(sub, append(ctx.SetFlagArgs(), args...))
+}
+
+// expandAlias fills an alias template with the positional arguments, named
+// arg0, arg1, ... and args for all of them, and splits the result into
+// words. Arguments the template does not refer to are appended, so
+// "alias --fees 100ukex" passes the flag on.
+func expandAlias(tmpl *template.Template, text string, args []string) ([]string, error) {
+	needed := 0
+	used := make(map[int]bool)
+	for _, m := range aliasArgRef.FindAllStringSubmatch(text, -1) {
+		i, _ := strconv.Atoi(m[1])
+		used[i] = true
+		if i+1 > needed {
+			needed = i + 1
+		}
+	}
+	usesAll := aliasArgsRef.MatchString(text)
+	if len(args) < needed {
+		return nil, fmt.Errorf("needs %d argument(s), got %d (%s)", needed, len(args), text)
+	}
+
+	data := map[string]string{}
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+		data["arg"+strconv.Itoa(i)] = quoted[i]
+	}
+	data["args"] = strings.Join(quoted, " ")
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return nil, err
+	}
+	words, err := splitShellLine(sb.String())
+	if err != nil {
+		return nil, err
+	}
+	if usesAll {
+		return words, nil
+	}
+	for i, arg := range args {
+		if !used[i] {
+			words = append(words, arg)
+		}
+	}
+	return words, nil
+}
+
+// shellQuote quotes s for splitShellLine if it contains spaces, quotes or
+// backslashes.
+func shellQuote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t'\"\\") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// listAliases prints the registered aliases.
+func (a *App) listAliases(ctx *cli.Context) error {
+	aliases := a.aliases
+	if aliases == nil {
+		aliases = []aliasInfo{}
+	}
+	return a.printOutput(ctx, aliases)
+}
@@ -3,8 +3,16 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/big"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +24,9 @@ import (
 	"github.com/kiracore/sekai-cli/pkg/scenarios"
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/failover"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/grpc"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/kube"
 	"github.com/kiracore/sekai-cli/pkg/sdk/client/rest"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/auth"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
@@ -24,16 +35,20 @@ import (
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/collectives"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/custody"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/distributor"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/ethereum"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/evidence"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/keys"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/layer2"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/multistaking"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/params"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/recovery"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/slashing"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/spending"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/staking"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/status"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/tokens"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/txs"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/ubi"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/upgrade"
 	"github.com/kiracore/sekai-cli/pkg/sdk/types"
@@ -46,6 +61,38 @@ type App struct {
 	sdk       *sdk.SEKAI
 	root      *cli.Command
 	formatter output.Formatter
+
+	// capture, when set, receives command results instead of printing them.
+	// Used by watch mode to compare successive polls.
+	capture func(data interface{})
+
+	// session holds variables set with "set" in the interactive shell.
+	session map[string]string
+
+	// profile is the name of the active config profile, selected before
+	// each command.
+	profile string
+
+	// cacheWarned records that the stale cache warning was printed.
+	cacheWarned bool
+
+	// aliases are the config aliases registered as commands.
+	aliases []aliasInfo
+
+	// aliasDepth counts the aliases being expanded, to stop recursion.
+	aliasDepth int
+
+	// outputFormat is the --output of the running command, for printing
+	// its error.
+	outputFormat string
+
+	// denoms is the display info of the network's denoms for --humanize,
+	// queried on first use.
+	denoms types.DenomRegistry
+
+	// monikers maps validator addresses to monikers for enriching query
+	// results, loaded on first use.
+	monikers map[string]string
 }
 
 // New creates a new CLI application.
@@ -63,12 +110,17 @@ func New(cfg *config.Config) (*App, error) {
 
 // Run executes the CLI with the given arguments.
 func (a *App) Run(args []string) error {
+	if len(args) > 0 && args[0] == completeCommand {
+		a.completeValues(os.Stdout, args[1:])
+		return nil
+	}
 	return a.root.Execute(args)
 }
 
 // buildRootCommand builds the root CLI command.
 func (a *App) buildRootCommand() *cli.Command {
 	root := cli.NewCommand("sekai-cli")
+	root.EnvPrefix = "SEKAI"
 	root.Short = "SEKAI blockchain CLI"
 	root.Long = `sekai-cli is a command-line interface for interacting with SEKAI blockchain.
 
@@ -79,13 +131,48 @@ Docker exec (for local development) or directly via REST API.`
 	// Add global flags
 	root.AddFlag(cli.Flag{Name: "help", Short: "h", Usage: "Show help"})
 	root.AddFlag(cli.Flag{Name: "config", Short: "c", Usage: "Path to config file"})
-	root.AddFlag(cli.Flag{Name: "output", Short: "o", Usage: "Output format (text, json, yaml)", Default: "text"})
+	root.AddFlag(cli.Flag{Name: "profile", Usage: "Config profile to use (default: the active profile)"})
+	root.AddFlag(cli.Flag{Name: "output", Short: "o", Usage: "Output format (text, json, yaml, table, csv)", Default: "text"})
+	root.AddFlag(cli.Flag{Name: "humanize", Usage: "Show coin amounts in their display unit using the token's decimals, e.g. 1 KEX (1000000ukex)"})
+	root.AddFlag(cli.Flag{Name: "no-enrich", Usage: "Do not add validator monikers next to validator addresses in query results"})
+	root.AddFlag(cli.Flag{Name: "columns", Usage: "Comma-separated columns to show with --output table or csv"})
 	root.AddFlag(cli.Flag{Name: "container", Usage: "Docker container name", Default: "sekin-sekai-1"})
-	root.AddFlag(cli.Flag{Name: "node", Usage: "Node RPC endpoint", Default: "tcp://localhost:26657"})
+	root.AddFlag(cli.Flag{Name: "runtime", Usage: "Container runtime (docker, podman)"})
+	root.AddFlag(cli.Flag{Name: "docker-host", Usage: "Container daemon address, e.g. ssh://user@host (default: DOCKER_HOST)"})
+	root.AddFlag(cli.Flag{Name: "kube-pod", Usage: "Kubernetes pod running sekaid (enables Kubernetes mode)"})
+	root.AddFlag(cli.Flag{Name: "kube-namespace", Usage: "Kubernetes namespace (auto-detects the pod if --kube-pod is not set)"})
+	root.AddFlag(cli.Flag{Name: "kube-container", Usage: "Container within the Kubernetes pod"})
+	root.AddFlag(cli.Flag{Name: "kube-context", Usage: "Kubeconfig context"})
+	root.AddFlag(cli.Flag{Name: "node", Usage: "Node RPC endpoint, or a comma-separated list to fail over between", Default: "tcp://localhost:26657"})
+	root.AddFlag(cli.Flag{Name: "node-strategy", Usage: "How to pick among several --node or --rest endpoints (first-healthy, round-robin)", Default: failover.StrategyFirstHealthy})
 	root.AddFlag(cli.Flag{Name: "chain-id", Usage: "Chain ID"})
 	root.AddFlag(cli.Flag{Name: "keyring-backend", Usage: "Keyring backend", Default: "test"})
+	root.AddFlag(cli.Flag{Name: "keyring-dir", Usage: "Keyring directory in the container (default: the sekaid home)"})
+	root.AddFlag(cli.Flag{Name: "keyring-passphrase-file", Usage: "File whose first line unlocks the file keyring backend, fed to sekaid on stdin"})
 	root.AddFlag(cli.Flag{Name: "home", Usage: "Sekaid home directory", Default: "/sekai"})
-	root.AddFlag(cli.Flag{Name: "rest", Usage: "REST API endpoint (enables REST mode)"})
+	root.AddFlag(cli.Flag{Name: "rest", Usage: "REST API endpoint, or a comma-separated list to fail over between (enables REST mode)"})
+	root.AddFlag(cli.Flag{Name: "grpc", Usage: "sekaid gRPC endpoint host:port (https:// for TLS), or a comma-separated list to fail over between"})
+	root.AddFlag(cli.Flag{Name: "no-color", Usage: "Disable colored text output (also disabled by NO_COLOR or a non-terminal stdout)"})
+	root.AddFlag(cli.Flag{Name: "timeout", Usage: "Maximum time for each request to the node, e.g. 10s or 2m (0 disables; default: 30s for queries, 60s for transactions)"})
+	root.AddFlag(cli.Flag{Name: "rest-retries", Usage: "Retries for failed REST queries (transactions are never retried)", Default: "2"})
+	root.AddFlag(cli.Flag{Name: "max-cache-age", Usage: "Warn when the network cache is older than this, e.g. 12h or 7d (0 disables; default: config cache_ttl or 24h)"})
+	root.AddFlag(cli.Flag{Name: "list-aliases", Usage: "List the command aliases defined in the config"})
+	root.AddFlag(cli.Flag{Name: "debug", Usage: "Log the sekaid commands and REST requests issued, and their raw responses, to stderr (secrets redacted)"})
+	root.AddFlag(cli.Flag{Name: "trace", Usage: "Save the command line, stdout and stderr of every failed sekaid command to a timestamped file in this directory (secrets redacted)"})
+
+	root.PreRun = a.beforeCommand
+	root.Run = func(ctx *cli.Context) error {
+		if ctx.GetFlag("list-aliases") == "true" {
+			return a.listAliases(ctx)
+		}
+		if err := ctx.Command.ShowHelp(ctx); err != nil {
+			return err
+		}
+		if len(ctx.Args) > 0 {
+			return cli.Usagef("unknown command %q", ctx.Args[0])
+		}
+		return nil
+	}
 
 	// Add subcommands
 	root.AddCommand(a.buildInitCommand())
@@ -95,24 +182,196 @@ Docker exec (for local development) or directly via REST API.`
 	root.AddCommand(a.buildKeysCommand())
 	root.AddCommand(a.buildBankCommand())
 	root.AddCommand(a.buildQueryCommand())
-	root.AddCommand(a.buildTxCommand())
+	txCmd := a.buildTxCommand()
+	txCmd.AddCommand(a.buildTxSimulateCommand())
+	txCmd.AddCommand(a.buildTxSignCommand())
+	txCmd.AddCommand(a.buildTxBroadcastCommand())
+	txCmd.AddCommand(a.buildTxEncodeCommand())
+	txCmd.AddCommand(a.buildTxDecodeCommand())
+	root.AddCommand(txCmd)
 	root.AddCommand(a.buildVersionCommand())
 	root.AddCommand(a.buildConfigCommand())
 	root.AddCommand(a.buildScenarioCommand())
+	root.AddCommand(a.buildToolsCommand())
 	root.AddCommand(a.buildCompletionCommand())
+	root.AddCommand(a.buildShellCommand())
+
+	a.addGenerateOnlySupport(root)
+	a.addAliases(root)
 
 	return root
 }
 
+// beforeCommand runs before every command: it selects the config profile
+// and validates settings shared by all commands.
+func (a *App) beforeCommand(ctx *cli.Context) error {
+	a.outputFormat = getStringOrDefault(ctx.GetFlag("output"), a.config.Output)
+	if err := a.selectProfile(ctx); err != nil {
+		return err
+	}
+	if _, err := a.cacheMaxAge(ctx); err != nil {
+		return err
+	}
+	if err := a.loadMemo(ctx); err != nil {
+		return err
+	}
+	return a.checkFees(ctx)
+}
+
+// cacheMaxAge returns the age after which the cache is reported as stale.
+// Priority order: flag > config > default. Zero disables the warning.
+func (a *App) cacheMaxAge(ctx *cli.Context) (time.Duration, error) {
+	value := getStringOrDefault(ctx.GetFlag("max-cache-age"), a.config.CacheTTL)
+	if value == "" {
+		return cache.DefaultTTL, nil
+	}
+	if value == "0" {
+		return 0, nil
+	}
+	seconds, err := parseDurationSeconds(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max cache age: %w", err)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// loadCache loads the cache of the active profile, returning nil if there
+// is none. A stale cache is still used, so commands keep working offline,
+// but a warning suggesting 'sekai-cli sync' is printed once.
+func (a *App) loadCache(ctx *cli.Context) *cache.Cache {
+	cachedData := cache.TryLoad()
+	if cachedData == nil || a.cacheWarned {
+		return cachedData
+	}
+	maxAge, err := a.cacheMaxAge(ctx)
+	if err != nil {
+		maxAge = cache.DefaultTTL
+	}
+	if cachedData.IsStale(maxAge) {
+		a.cacheWarned = true
+		fmt.Fprintf(ctx.Stderr, "Warning: network cache was synced %s (older than %s); run 'sekai-cli sync' to refresh\n",
+			cachedData.FormatAge(), formatCacheAge(maxAge))
+	}
+	return cachedData
+}
+
+// formatCacheAge formats a cache age limit, using days where exact.
+func formatCacheAge(d time.Duration) string {
+	if d >= 24*time.Hour && d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return d.String()
+}
+
+// selectProfile activates the config profile given by --profile, or the
+// active profile from the config, and selects its cache. Within the cache,
+// the network of --chain-id (or the profile's chain ID) is selected, else
+// the last used one.
+func (a *App) selectProfile(ctx *cli.Context) error {
+	name := ctx.GetFlag("profile")
+	if _, err := a.config.Profile(name); err != nil {
+		return err
+	}
+	if name == "" {
+		name = a.config.ActiveProfile
+	}
+	a.profile = name
+	cache.SetProfile(name)
+	cache.SelectChainID(a.setting(ctx, "chain-id", a.activeProfile().ChainID))
+	return nil
+}
+
+// activeProfile returns the selected config profile.
+func (a *App) activeProfile() *config.Profile {
+	profile, err := a.config.Profile(a.profile)
+	if err != nil {
+		return &config.Profile{}
+	}
+	return profile
+}
+
+// setting returns a flag given on the command line, else the active
+// profile's value if set, else the flag's default.
+func (a *App) setting(ctx *cli.Context, flag, profileVal string) string {
+	if ctx.IsSet(flag) || profileVal == "" {
+		return ctx.GetFlag(flag)
+	}
+	return profileVal
+}
+
+// txFees returns the fees used for transactions.
+// Priority order: flag > profile > cache > config. The cached network
+// minimum is scaled by --fee-multiplier, capped at the network maximum and
+// paid in --fee-denom, converted at the denom's cached fee rate.
+func (a *App) txFees(ctx *cli.Context, cachedData *cache.Cache) (string, error) {
+	if fees := a.setting(ctx, "fees", a.activeProfile().Fees); fees != "" {
+		return fees, nil
+	}
+	multiplier, err := feeMultiplier(ctx)
+	if err != nil {
+		return "", err
+	}
+	if cachedData != nil {
+		if minFee := cachedData.GetMinFee(); minFee != "" {
+			denom := getStringOrDefault(ctx.GetFlag("fee-denom"), "ukex")
+			return convertFee(scaleFee(minFee, cachedData.GetMaxFee(), multiplier), denom, cachedData.FeeRates[denom])
+		}
+	}
+	return a.config.Fees, nil
+}
+
+// feeMultiplier returns the --fee-multiplier for the network minimum fee.
+func feeMultiplier(ctx *cli.Context) (float64, error) {
+	v := ctx.GetFlag("fee-multiplier")
+	if v == "" {
+		return 1, nil
+	}
+	m, err := strconv.ParseFloat(v, 64)
+	if err != nil || m <= 0 || math.IsInf(m, 0) {
+		return 0, cli.Usagef("invalid --fee-multiplier: %s", v)
+	}
+	return m, nil
+}
+
+// scaleFee multiplies the fee amount minFee, rounding up, and caps it at
+// maxFee when that is set. Amounts that do not parse are returned as is.
+func scaleFee(minFee, maxFee string, multiplier float64) string {
+	fee, err := strconv.ParseUint(minFee, 10, 64)
+	if err != nil {
+		return minFee
+	}
+	scaled := uint64(math.Ceil(float64(fee) * multiplier))
+	if limit, err := strconv.ParseUint(maxFee, 10, 64); err == nil && limit > 0 && scaled > limit {
+		scaled = limit
+	}
+	return strconv.FormatUint(scaled, 10)
+}
+
 // getClient creates or returns the SDK client based on context flags.
-// Priority order: flags > cache > config
+// Every request of the client is bounded by --timeout.
 func (a *App) getClient(ctx *cli.Context) (sdk.Client, error) {
 	if a.client != nil {
 		return a.client, nil
 	}
+	queryTimeout, txTimeout, err := requestTimeouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client, err := a.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.client = newTimeoutClient(client, queryTimeout, txTimeout)
+	return a.client, nil
+}
+
+// connect creates the SDK client based on context flags.
+// Priority order: flags > profile > cache > config
+func (a *App) connect(ctx *cli.Context) (sdk.Client, error) {
+	profile := a.activeProfile()
 
 	// Try to load cache for defaults (cache takes priority over config)
-	cachedData := cache.TryLoad()
+	cachedData := a.loadCache(ctx)
 
 	// Helper to get value with priority: flag > cache > config
 	getValueWithCache := func(flagVal, cacheVal, configVal string) string {
@@ -130,85 +389,239 @@ func (a *App) getClient(ctx *cli.Context) (sdk.Client, error) {
 	if cachedData != nil {
 		cacheChainID = cachedData.GetChainID()
 	}
-	chainID := getValueWithCache(ctx.GetFlag("chain-id"), cacheChainID, a.config.ChainID)
+	chainID := getValueWithCache(a.setting(ctx, "chain-id", profile.ChainID), cacheChainID, a.config.ChainID)
 
 	// Check if REST mode is enabled
-	restURL := ctx.GetFlag("rest")
-	if restURL == "" {
-		restURL = a.config.RESTURL
+	restURL := getStringOrDefault(a.setting(ctx, "rest", profile.RESTURL), a.config.RESTURL)
+
+	// Only query commands have --height
+	height, err := queryHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only transaction commands have --sign-mode
+	signMode := ctx.GetFlag("sign-mode")
+	if err := sdk.ValidateSignMode(signMode); err != nil {
+		return nil, cli.Usagef("invalid --sign-mode: %w", err)
+	}
+
+	// If --grpc flag is provided, use gRPC client
+	if grpcTarget := ctx.GetFlag("grpc"); grpcTarget != "" {
+		grpcOpts := []grpc.Option{
+			grpc.WithChainID(chainID),
+			grpc.WithHeight(height),
+		}
+		if logger := debugLogger(ctx); logger != nil {
+			grpcOpts = append(grpcOpts, grpc.WithLogger(logger))
+		}
+		return a.withFailover(ctx, grpcTarget, func(target string) (sdk.Client, error) {
+			client, err := grpc.NewClient(target, grpcOpts...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+			}
+			return client, nil
+		})
 	}
 
 	// If --rest flag is provided or UseREST is configured, use REST client
-	if restURL != "" && (ctx.GetFlag("rest") != "" || a.config.UseREST) {
-		client, err := rest.NewClient(restURL,
+	if restURL != "" && (ctx.GetFlag("rest") != "" || profile.UseREST || a.config.UseREST) {
+		retries, err := strconv.Atoi(getStringOrDefault(ctx.GetFlag("rest-retries"), "2"))
+		if err != nil || retries < 0 {
+			return nil, cli.Usagef("invalid --rest-retries: %s", ctx.GetFlag("rest-retries"))
+		}
+		restOpts := []rest.Option{
 			rest.WithChainID(chainID),
-		)
+			rest.WithRetry(retries+1, rest.DefaultRetryBaseDelay),
+			rest.WithHeight(height),
+		}
+		if logger := debugLogger(ctx); logger != nil {
+			restOpts = append(restOpts, rest.WithLogger(logger))
+		}
+		client, err := a.withFailover(ctx, restURL, func(url string) (sdk.Client, error) {
+			client, err := rest.NewClient(url, restOpts...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create REST client: %w", err)
+			}
+			return client, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
+	fees, err := a.txFees(ctx, cachedData)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gas adjustment: explicit flag > config
+	gasAdjustment := a.config.GasAdjustment
+	if ctx.IsSet("gas-adjustment") {
+		adj, err := strconv.ParseFloat(ctx.GetFlag("gas-adjustment"), 64)
+		if err != nil || adj <= 0 {
+			return nil, cli.Usagef("invalid --gas-adjustment: %s", ctx.GetFlag("gas-adjustment"))
+		}
+		gasAdjustment = adj
+	}
+
+	// Build options; the node is set per endpoint of a failover list
+	node := getStringOrDefault(a.setting(ctx, "node", profile.Node), a.config.Node)
+	opts := []docker.Option{
+		docker.WithChainID(chainID),
+		docker.WithKeyringBackend(getStringOrDefault(a.setting(ctx, "keyring-backend", profile.KeyringBackend), a.config.KeyringBackend)),
+		docker.WithHome(getStringOrDefault(a.setting(ctx, "home", profile.Home), a.config.Home)),
+		docker.WithFees(fees),
+		docker.WithGas(getStringOrDefault(profile.Gas, a.config.Gas)),
+		docker.WithGasAdjustment(gasAdjustment),
+		docker.WithSequenceRetry(ctx.GetFlag("sequence-retry") == "true"),
+		docker.WithSignMode(signMode),
+		docker.WithHeight(height),
+	}
+	if dir := ctx.GetFlag("keyring-dir"); dir != "" {
+		opts = append(opts, docker.WithKeyringDir(dir))
+	}
+	passphrase, err := keyringPassphrase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if passphrase != "" {
+		opts = append(opts, docker.WithKeyringPassphrase(passphrase))
+	}
+	if logger := debugLogger(ctx); logger != nil {
+		opts = append(opts, docker.WithLogger(logger))
+	}
+	if dir := ctx.GetFlag("trace"); dir != "" {
+		opts = append(opts, docker.WithTraceDir(dir))
+	}
+
+	// Kubernetes mode: exec into a pod instead of a container
+	if ctx.GetFlag("kube-pod") != "" || ctx.GetFlag("kube-namespace") != "" {
+		target := kube.Target{
+			Namespace: ctx.GetFlag("kube-namespace"),
+			Pod:       ctx.GetFlag("kube-pod"),
+			Container: ctx.GetFlag("kube-container"),
+			Context:   ctx.GetFlag("kube-context"),
+		}
+		if target.Pod == "" {
+			detected, err := kube.FindSekaiPod(context.Background(), target.Namespace, target.Context)
+			if err != nil {
+				return nil, fmt.Errorf("no pod specified and auto-detection failed: %w", err)
+			}
+			target.Pod = detected
+		}
+		client, err := a.withFailover(ctx, node, func(node string) (sdk.Client, error) {
+			client, err := kube.NewClient(target, append([]docker.Option{docker.WithNode(node)}, opts...)...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create kube client: %w", err)
+			}
+			return client, nil
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create REST client: %w", err)
+			return nil, err
 		}
-		a.client = client
 		return client, nil
 	}
 
 	// Get container: flag > cache > config > auto-detect
+	runtime := a.getRuntime(ctx)
 	var cacheContainer string
 	if cachedData != nil {
 		cacheContainer = cachedData.GetContainer()
 	}
-	container := getValueWithCache(ctx.GetFlag("container"), cacheContainer, a.config.Container)
+	container := getValueWithCache(a.setting(ctx, "container", profile.Container), cacheContainer, a.config.Container)
 
 	if container == "" {
 		// Try to auto-detect
-		detected, err := docker.FindSekaiContainer()
+		detected, err := runtime.FindSekaiContainer()
 		if err != nil {
 			return nil, fmt.Errorf("no container specified and auto-detection failed: %w\nRun 'sekai-cli init' to configure", err)
 		}
 		container = detected
 	}
 
-	// Get fees: flag > cache > config
-	var cacheFees string
-	if cachedData != nil {
-		cacheFees = cachedData.GetMinFee()
-		if cacheFees != "" {
-			cacheFees = cacheFees + "ukex"
+	opts = append(opts, docker.WithRuntime(runtime.Name), docker.WithHost(runtime.Host))
+	client, err := a.withFailover(ctx, node, func(node string) (sdk.Client, error) {
+		client, err := docker.NewClient(container, append([]docker.Option{docker.WithNode(node)}, opts...)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client: %w", err)
 		}
+		return client, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	fees := getValueWithCache(ctx.GetFlag("fees"), cacheFees, a.config.Fees)
+	return client, nil
+}
 
-	// Build options
-	opts := []docker.Option{
-		docker.WithChainID(chainID),
-		docker.WithKeyringBackend(getStringOrDefault(ctx.GetFlag("keyring-backend"), a.config.KeyringBackend)),
-		docker.WithHome(getStringOrDefault(ctx.GetFlag("home"), a.config.Home)),
-		docker.WithNode(getStringOrDefault(ctx.GetFlag("node"), a.config.Node)),
-		docker.WithFees(fees),
-		docker.WithGas(a.config.Gas),
-		docker.WithGasAdjustment(a.config.GasAdjustment),
+// withFailover creates the client for endpoints, a comma-separated list.
+// A single endpoint gets a plain client; several are wrapped in a failover
+// client that health-checks them and picks one by --node-strategy.
+func (a *App) withFailover(ctx *cli.Context, endpoints string, newClient func(string) (sdk.Client, error)) (sdk.Client, error) {
+	list := failover.SplitEndpoints(endpoints)
+	if len(list) <= 1 {
+		return newClient(strings.TrimSpace(endpoints))
 	}
-
-	client, err := docker.NewClient(container, opts...)
+	var clients []failover.Endpoint
+	for _, e := range list {
+		client, err := newClient(e)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, failover.Endpoint{Name: e, Client: client})
+	}
+	strategy := ctx.GetFlag("node-strategy")
+	if !ctx.IsSet("node-strategy") && a.config.NodeStrategy != "" {
+		strategy = a.config.NodeStrategy
+	}
+	opts := []failover.Option{failover.WithStrategy(strategy)}
+	if logger := debugLogger(ctx); logger != nil {
+		opts = append(opts, failover.WithLogger(logger))
+	}
+	client, err := failover.NewClient(clients, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create client: %w", err)
+		return nil, cli.Usagef("invalid --node-strategy: %w", err)
 	}
-
-	a.client = client
 	return client, nil
 }
 
+// getRuntime returns the container runtime from flags, profile or config.
+// Priority order: flags > profile > config > local docker
+func (a *App) getRuntime(ctx *cli.Context) docker.Runtime {
+	profile := a.activeProfile()
+	return docker.Runtime{
+		Name: getStringOrDefault(a.setting(ctx, "runtime", profile.Runtime), getStringOrDefault(a.config.Runtime, docker.RuntimeDocker)),
+		Host: getStringOrDefault(a.setting(ctx, "docker-host", profile.DockerHost), a.config.DockerHost),
+	}
+}
+
 // getDefaultFrom returns the default signer key from cache.
-func (a *App) getDefaultFrom() string {
-	if cachedData := cache.TryLoad(); cachedData != nil {
+func (a *App) getDefaultFrom(ctx *cli.Context) string {
+	if cachedData := a.loadCache(ctx); cachedData != nil {
 		return cachedData.GetDefaultKey()
 	}
 	return ""
 }
 
-// getFromFlag returns the --from value, falling back to cache default.
+// debugLogger returns the logger for --debug output, or nil without
+// --debug.
+func debugLogger(ctx *cli.Context) sdk.Logger {
+	if ctx.GetFlag("debug") != "true" {
+		return nil
+	}
+	return log.New(ctx.Stderr, "[debug] ", 0)
+}
+
+// getFromFlag returns the --from value, falling back to the shell session
+// and then the cache default.
 func (a *App) getFromFlag(ctx *cli.Context) string {
 	from := ctx.GetFlag("from")
 	if from == "" {
-		from = a.getDefaultFrom()
+		from = a.session["from"]
+	}
+	if from == "" {
+		from = a.getDefaultFrom(ctx)
 	}
 	return from
 }
@@ -234,20 +647,222 @@ func (a *App) getFormatter(ctx *cli.Context) output.Formatter {
 	if format == "" {
 		format = a.config.Output
 	}
-	return output.NewFormatterFromString(format)
+	formatter := output.NewFormatterFromString(format)
+	if text, ok := formatter.(*output.TextFormatter); ok && a.colorEnabled(ctx) {
+		text.Theme = output.DefaultTheme()
+	}
+	switch f := formatter.(type) {
+	case *output.TableFormatter:
+		f.Columns = splitColumns(ctx.GetFlag("columns"))
+	case *output.CSVFormatter:
+		f.Columns = splitColumns(ctx.GetFlag("columns"))
+	}
+	return formatter
+}
+
+// splitColumns splits the comma-separated --columns flag, dropping blanks.
+func splitColumns(columns string) []string {
+	var split []string
+	for _, c := range strings.Split(columns, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			split = append(split, c)
+		}
+	}
+	return split
+}
+
+// colorEnabled reports whether text output to ctx.Stdout may be colored.
+func (a *App) colorEnabled(ctx *cli.Context) bool {
+	return output.ColorEnabled(ctx.Stdout, ctx.GetFlag("no-color") == "true")
 }
 
 // printOutput prints data using the configured formatter.
 func (a *App) printOutput(ctx *cli.Context, data interface{}) error {
+	if a.capture != nil {
+		a.capture(data)
+		return nil
+	}
+	if resp, ok := data.(*sdk.TxResponse); ok {
+		if waitRequested(ctx) && resp.TxHash != "" {
+			return a.waitForTx(ctx, resp)
+		}
+		if ctx.GetFlag("result-only") == "true" {
+			return a.printTxResult(ctx, resp)
+		}
+	}
+	var total uint64
+	counted := false
+	if ctx.GetFlag("count-total") == "true" {
+		data, total, counted = withTotal(ctx, data)
+	}
+	if ctx.GetFlag("humanize") == "true" {
+		data = output.Humanize(data, a.denomResolver(ctx))
+	}
+	if path := ctx.GetFlag("field"); path != "" {
+		value, err := output.ExtractField(data, path)
+		if err != nil {
+			return err
+		}
+		return output.WriteField(ctx.Stdout, a.getFormatter(ctx), value)
+	}
 	formatter := a.getFormatter(ctx)
-	return formatter.Format(ctx.Stdout, data)
+	if err := formatter.Format(ctx.Stdout, data); err != nil {
+		return err
+	}
+	// Tables show only the list, so the total follows it
+	if _, ok := formatter.(*output.TableFormatter); ok && counted {
+		ctx.Printf("\ntotal: %d\n", total)
+	}
+	return nil
+}
+
+// waitRequested reports whether a tx command should wait for inclusion:
+// with --wait, or with --broadcast-mode block, which is broadcast as sync
+// and then polled because sekaid no longer supports it.
+func waitRequested(ctx *cli.Context) bool {
+	return ctx.GetFlag("wait") == "true" || ctx.GetFlag("broadcast-mode") == "block"
+}
+
+// waitForTx waits for a broadcast transaction to be included in a block
+// and prints the final result. A failed transaction is printed and then
+// returned as an error; on timeout the broadcast response is printed with
+// a "not yet confirmed" error.
+func (a *App) waitForTx(ctx *cli.Context, resp *sdk.TxResponse) error {
+	timeout, err := parseDuration(ctx.GetFlag("wait-timeout"))
+	if err != nil || timeout <= 0 {
+		return cli.Usagef("invalid --wait-timeout: %s", ctx.GetFlag("wait-timeout"))
+	}
+
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return err
+	}
+	ctx.Errorf("Waiting for tx %s to be included (timeout %s)...\n", resp.TxHash, timeout)
+	result, err := txs.New(client).Wait(context.Background(), resp.TxHash, &txs.WaitOptions{Timeout: timeout})
+	if errors.Is(err, txs.ErrNotConfirmed) {
+		if printErr := a.printTxResponse(ctx, resp); printErr != nil {
+			return printErr
+		}
+		return fmt.Errorf("transaction %s not yet confirmed after %s; check later with 'sekai-cli query tx %s'", resp.TxHash, timeout, resp.TxHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	if ctx.GetFlag("result-only") == "true" {
+		final := *resp
+		final.Code = result.Code
+		final.Height = result.Height
+		err = a.printTxResult(ctx, &final)
+	} else {
+		err = a.getFormatter(ctx).Format(ctx.Stdout, result)
+	}
+	if err != nil {
+		return err
+	}
+	if !result.Success() {
+		return &txFailedError{hash: result.TxHash, code: result.Code, rawLog: result.RawLog}
+	}
+	return nil
+}
+
+// printTxResponse prints a broadcast response, honoring --result-only.
+func (a *App) printTxResponse(ctx *cli.Context, resp *sdk.TxResponse) error {
+	if ctx.GetFlag("result-only") == "true" {
+		return a.printTxResult(ctx, resp)
+	}
+	return a.getFormatter(ctx).Format(ctx.Stdout, resp)
+}
+
+// getPagination builds pagination options from the pagination flags.
+func getPagination(ctx *cli.Context) (*sdk.Pagination, error) {
+	p := &sdk.Pagination{
+		Key:        ctx.GetFlag("page-key"),
+		CountTotal: ctx.GetFlag("count-total") == "true",
+		Reverse:    ctx.GetFlag("reverse") == "true",
+	}
+	for name, dst := range map[string]*uint64{"limit": &p.Limit, "offset": &p.Offset, "page": &p.Page} {
+		v := ctx.GetFlag(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, cli.Usagef("invalid --%s: %s", name, v)
+		}
+		*dst = n
+	}
+	if p.Page > 0 && (p.Offset > 0 || p.Key != "") {
+		return nil, cli.Usagef("--page cannot be combined with --offset or --page-key")
+	}
+	return p, nil
+}
+
+// printPaginated prints a list result and, for text and table output,
+// a footer with the next page key when the backend returns one. The total
+// is printed by printOutput with --count-total.
+func (a *App) printPaginated(ctx *cli.Context, data interface{}) error {
+	if err := a.printOutput(ctx, data); err != nil {
+		return err
+	}
+	if a.capture != nil || ctx.GetFlag("field") != "" {
+		return nil
+	}
+	switch a.getFormatter(ctx).(type) {
+	case *output.TextFormatter, *output.TableFormatter:
+	default:
+		return nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+	var page struct {
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+	if json.Unmarshal(b, &page) != nil {
+		return nil
+	}
+	if page.Pagination.NextKey != "" {
+		ctx.Printf("\nnext_key: %s (use --page-key to fetch the next page)\n", page.Pagination.NextKey)
+	}
+	return nil
+}
+
+// txResult is the compact form of a broadcast result printed by --result-only.
+type txResult struct {
+	Hash string `json:"hash"`
+	Code uint32 `json:"code"`
+}
+
+// printTxResult prints only the tx hash and result code.
+// Text output is a single "<hash> <code>" line for easy capture in scripts.
+func (a *App) printTxResult(ctx *cli.Context, resp *sdk.TxResponse) error {
+	result := txResult{Hash: resp.TxHash, Code: resp.Code}
+	switch a.getFormatter(ctx).(type) {
+	case *output.JSONFormatter:
+		return (&output.JSONFormatter{}).Format(ctx.Stdout, result)
+	case *output.YAMLFormatter:
+		return (&output.YAMLFormatter{}).Format(ctx.Stdout, result)
+	default:
+		ctx.Printf("%s %d\n", result.Hash, result.Code)
+		return nil
+	}
 }
 
 // buildStatusCommand builds the status command.
 func (a *App) buildStatusCommand() *cli.Command {
 	cmd := cli.NewCommand("status")
 	cmd.Short = "Get node status"
-	cmd.Long = "Query the status of the connected SEKAI node."
+	cmd.Long = `Query the status of the connected SEKAI node.
+
+With --summary a digest is printed instead: whether the node is synced or
+catching up, chain ID, latest block height and time, validator address and
+connected peer count.`
+	cmd.AddFlag(cli.Flag{Name: "summary", Usage: "Print a digest with sync state and peer count"})
 
 	cmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
@@ -256,6 +871,13 @@ func (a *App) buildStatusCommand() *cli.Command {
 		}
 
 		statusMod := status.New(client)
+		if ctx.GetFlag("summary") == "true" {
+			summary, err := statusMod.Summary(context.Background())
+			if err != nil {
+				return fmt.Errorf("failed to get status: %w", err)
+			}
+			return a.printOutput(ctx, summary)
+		}
 		resp, err := statusMod.Status(context.Background())
 		if err != nil {
 			return fmt.Errorf("failed to get status: %w", err)
@@ -263,10 +885,65 @@ func (a *App) buildStatusCommand() *cli.Command {
 
 		return a.printOutput(ctx, resp)
 	}
+	a.addWatchSupport(cmd)
 
 	return cmd
 }
 
+// exportPassphrase returns the passphrase encrypting an exported key, read
+// from --passphrase-file or prompted for twice.
+func exportPassphrase(ctx *cli.Context) (string, error) {
+	if path := ctx.GetFlag("passphrase-file"); path != "" {
+		return readPassphraseFile(path)
+	}
+	if !ctx.IsInteractive() {
+		return "", fmt.Errorf("passphrase required: stdin is not a terminal (use --passphrase-file)")
+	}
+	passphrase, err := ctx.ReadPassword("Enter passphrase to encrypt the exported key: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase must not be empty")
+	}
+	repeated, err := ctx.ReadPassword("Repeat passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if repeated != passphrase {
+		return "", fmt.Errorf("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+// readPassphraseFile reads a passphrase from the first line of a file.
+func readPassphraseFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+	passphrase, _, _ := strings.Cut(string(data), "\n")
+	passphrase = strings.TrimSuffix(passphrase, "\r")
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase file %s is empty", path)
+	}
+	return passphrase, nil
+}
+
+// keyringPassphrase returns the file keyring passphrase from
+// --keyring-passphrase-file, or "" if not set. It warns when other users
+// can read the file.
+func keyringPassphrase(ctx *cli.Context) (string, error) {
+	path := ctx.GetFlag("keyring-passphrase-file")
+	if path == "" {
+		return "", nil
+	}
+	if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0o004 != 0 {
+		ctx.Errorf("Warning: --keyring-passphrase-file %s is readable by all users; restrict it with chmod 600\n", path)
+	}
+	return readPassphraseFile(path)
+}
+
 // buildKeysCommand builds the keys command group.
 func (a *App) buildKeysCommand() *cli.Command {
 	keysCmd := cli.NewCommand("keys")
@@ -277,6 +954,7 @@ func (a *App) buildKeysCommand() *cli.Command {
 	listCmd := cli.NewCommand("list")
 	listCmd.Aliases = []string{"ls"}
 	listCmd.Short = "List all keys"
+	listCmd.AddFlag(cli.Flag{Name: "address-only", Usage: "Print only the addresses, one per line"})
 	listCmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -287,6 +965,12 @@ func (a *App) buildKeysCommand() *cli.Command {
 		if err != nil {
 			return err
 		}
+		if ctx.GetFlag("address-only") == "true" {
+			for _, key := range keysList {
+				ctx.Println(key.Address)
+			}
+			return nil
+		}
 		return a.printOutput(ctx, keysList)
 	}
 	keysCmd.AddCommand(listCmd)
@@ -294,7 +978,8 @@ func (a *App) buildKeysCommand() *cli.Command {
 	// keys show
 	showCmd := cli.NewCommand("show")
 	showCmd.Short = "Show key details"
-	showCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name"}}
+	showCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name", Complete: cli.CompleteKeys}}
+	showCmd.AddFlag(cli.Flag{Name: "address-only", Usage: "Print only the address"})
 	showCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("key name required")
@@ -308,6 +993,10 @@ func (a *App) buildKeysCommand() *cli.Command {
 		if err != nil {
 			return err
 		}
+		if ctx.GetFlag("address-only") == "true" {
+			ctx.Println(info.Address)
+			return nil
+		}
 		return a.printOutput(ctx, info)
 	}
 	keysCmd.AddCommand(showCmd)
@@ -316,19 +1005,35 @@ func (a *App) buildKeysCommand() *cli.Command {
 	addCmd := cli.NewCommand("add")
 	addCmd.Short = "Create a new key"
 	addCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name"}}
+	addCmd.Usage = `  sekai-cli keys add mykey
+  sekai-cli keys add treasury --multisig alice,bob,carol --multisig-threshold 2`
 	addCmd.AddFlag(cli.Flag{Name: "recover", Usage: "Recover from mnemonic"})
+	addCmd.AddFlag(cli.Flag{Name: "multisig", Usage: "Comma-separated existing key names to combine into a multisig key"})
+	addCmd.AddFlag(cli.Flag{Name: "multisig-threshold", Usage: "Number of signatures required for the multisig key"})
 	addCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("key name required")
 		}
+		opts := &sdk.KeyAddOptions{
+			Recover: ctx.GetFlag("recover") == "true",
+		}
+		if multisig := ctx.GetFlag("multisig"); multisig != "" {
+			for _, key := range strings.Split(multisig, ",") {
+				opts.Multisig = append(opts.Multisig, strings.TrimSpace(key))
+			}
+			threshold, err := strconv.Atoi(ctx.GetFlag("multisig-threshold"))
+			if err != nil {
+				return cli.Usagef("--multisig-threshold required with --multisig")
+			}
+			opts.MultisigThreshold = threshold
+		} else if ctx.GetFlag("multisig-threshold") != "" {
+			return cli.Usagef("--multisig-threshold requires --multisig")
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		keysMod := keys.New(client)
-		opts := &sdk.KeyAddOptions{
-			Recover: ctx.GetFlag("recover") == "true",
-		}
 		info, err := keysMod.Add(context.Background(), ctx.Args[0], opts)
 		if err != nil {
 			return err
@@ -341,12 +1046,17 @@ func (a *App) buildKeysCommand() *cli.Command {
 	deleteCmd := cli.NewCommand("delete")
 	deleteCmd.Aliases = []string{"rm"}
 	deleteCmd.Short = "Delete a key"
-	deleteCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name"}}
+	deleteCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name", Complete: cli.CompleteKeys}}
 	deleteCmd.AddFlag(cli.Flag{Name: "force", Short: "f", Usage: "Force deletion"})
+	deleteCmd.AddFlag(cli.Flag{Name: "yes", Short: "y", Usage: "Skip confirmation prompt"})
 	deleteCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("key name required")
 		}
+		if err := ctx.Confirm(fmt.Sprintf("Delete key %q?", ctx.Args[0]),
+			"This cannot be undone unless you have the mnemonic."); err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
@@ -361,6 +1071,323 @@ func (a *App) buildKeysCommand() *cli.Command {
 	}
 	keysCmd.AddCommand(deleteCmd)
 
+	// keys export
+	exportCmd := cli.NewCommand("export")
+	exportCmd.Short = "Export a private key"
+	exportCmd.Long = `Export a private key as ASCII armor encrypted with a passphrase, or as
+unarmored hex with --unarmored-hex.
+
+The output is secret key material. It is refused on a terminal unless
+--unsafe is given; redirect it to a file instead.`
+	exportCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Key name", Complete: cli.CompleteKeys}}
+	exportCmd.Usage = `  sekai-cli keys export alice > alice.armor
+  sekai-cli keys export alice --passphrase-file pass.txt > alice.armor
+  sekai-cli keys export alice --unarmored-hex > alice.hex`
+	exportCmd.AddFlag(cli.Flag{Name: "unarmored-hex", Usage: "Export the unencrypted private key as hex"})
+	exportCmd.AddFlag(cli.Flag{Name: "unsafe", Usage: "Allow printing the key to a terminal"})
+	exportCmd.AddFlag(cli.Flag{Name: "passphrase-file", Usage: "Read the encryption passphrase from a file instead of prompting"})
+	exportCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("key name required")
+		}
+		if output.IsTerminal(ctx.Stdout) && ctx.GetFlag("unsafe") != "true" {
+			return fmt.Errorf("refusing to print a private key to a terminal: redirect the output to a file or pass --unsafe")
+		}
+		unarmored := ctx.GetFlag("unarmored-hex") == "true"
+		if unarmored && ctx.GetFlag("passphrase-file") != "" {
+			return cli.Usagef("--passphrase-file cannot be used with --unarmored-hex")
+		}
+
+		var passphrase string
+		if !unarmored {
+			var err error
+			passphrase, err = exportPassphrase(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		keysMod := keys.New(client)
+		var key string
+		if unarmored {
+			key, err = keysMod.ExportHex(context.Background(), ctx.Args[0])
+		} else {
+			key, err = keysMod.ExportWithPassphrase(context.Background(), ctx.Args[0], passphrase)
+		}
+		if err != nil {
+			return err
+		}
+		ctx.Println(key)
+		return nil
+	}
+	keysCmd.AddCommand(exportCmd)
+
+	// keys import
+	importCmd := cli.NewCommand("import")
+	importCmd.Short = "Import a private key"
+	importCmd.Long = `Import a private key exported with 'keys export', reading it from a
+file or from stdin when the file is omitted or "-".
+
+An armored key is decrypted with its passphrase, which is prompted for
+unless --passphrase-file is given (required when the key is read from
+stdin). Use --unarmored-hex for a hex private key.`
+	importCmd.Args = []cli.Arg{
+		{Name: "name", Required: true, Description: "Name for the imported key"},
+		{Name: "file", Description: "Key file (default: stdin)"},
+	}
+	importCmd.Usage = `  sekai-cli keys import alice alice.armor
+  sekai-cli keys import alice --passphrase-file pass.txt < alice.armor
+  sekai-cli keys import alice alice.hex --unarmored-hex`
+	importCmd.AddFlag(cli.Flag{Name: "unarmored-hex", Usage: "Import an unencrypted hex private key"})
+	importCmd.AddFlag(cli.Flag{Name: "key-type", Usage: "Key algorithm of a hex private key (default: secp256k1)"})
+	importCmd.AddFlag(cli.Flag{Name: "passphrase-file", Usage: "Read the decryption passphrase from a file instead of prompting"})
+	importCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("key name required")
+		}
+		unarmored := ctx.GetFlag("unarmored-hex") == "true"
+		if unarmored && ctx.GetFlag("passphrase-file") != "" {
+			return cli.Usagef("--passphrase-file cannot be used with --unarmored-hex")
+		}
+		if !unarmored && ctx.GetFlag("key-type") != "" {
+			return cli.Usagef("--key-type requires --unarmored-hex")
+		}
+
+		file := "-"
+		if len(ctx.Args) > 1 {
+			file = ctx.Args[1]
+		}
+		fromStdin := file == "-"
+		var data []byte
+		var err error
+		if fromStdin {
+			if ctx.IsInteractive() {
+				return fmt.Errorf("key file required (or pipe the key on stdin)")
+			}
+			data, err = io.ReadAll(ctx.Stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read key: %w", err)
+		}
+		key := strings.TrimSpace(string(data))
+		if key == "" {
+			return fmt.Errorf("no key to import")
+		}
+
+		var passphrase string
+		if !unarmored {
+			switch {
+			case ctx.GetFlag("passphrase-file") != "":
+				passphrase, err = readPassphraseFile(ctx.GetFlag("passphrase-file"))
+			case fromStdin:
+				err = cli.Usagef("--passphrase-file is required when the key is read from stdin")
+			default:
+				passphrase, err = ctx.ReadPassword("Enter passphrase to decrypt the key: ")
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		keysMod := keys.New(client)
+		if unarmored {
+			err = keysMod.ImportHex(context.Background(), ctx.Args[0], key, ctx.GetFlag("key-type"))
+		} else {
+			err = keysMod.ImportWithPassphrase(context.Background(), ctx.Args[0], key, passphrase)
+		}
+		if err != nil {
+			return err
+		}
+		info, err := keysMod.Show(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, info)
+	}
+	keysCmd.AddCommand(importCmd)
+
+	// keys rename
+	renameCmd := cli.NewCommand("rename")
+	renameCmd.Short = "Rename a key"
+	renameCmd.Args = []cli.Arg{
+		{Name: "old-name", Required: true, Description: "Current key name", Complete: cli.CompleteKeys},
+		{Name: "new-name", Required: true, Description: "New key name"},
+	}
+	renameCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return fmt.Errorf("old and new key names required")
+		}
+		oldName, newName := ctx.Args[0], ctx.Args[1]
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		keysMod := keys.New(client)
+		exists, err := keysMod.Exists(context.Background(), oldName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("key %q not found", oldName)
+		}
+		taken, err := keysMod.Exists(context.Background(), newName)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("key %q already exists", newName)
+		}
+		if err := keysMod.Rename(context.Background(), oldName, newName); err != nil {
+			return err
+		}
+		info, err := keysMod.Show(context.Background(), newName)
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, info)
+	}
+	keysCmd.AddCommand(renameCmd)
+
+	// keys migrate
+	migrateCmd := cli.NewCommand("migrate")
+	migrateCmd.Short = "Migrate keys from the legacy amino format to protobuf"
+	migrateCmd.Long = `Migrate all keys in the keyring from the legacy amino serialization to
+protobuf, as required after upgrading from older sekaid releases. The keys in
+the keyring after the migration are printed.`
+	migrateCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		keysMod := keys.New(client)
+		if err := keysMod.Migrate(context.Background()); err != nil {
+			return fmt.Errorf("failed to migrate keys: %w", err)
+		}
+		migrated, err := keysMod.List(context.Background())
+		if err != nil {
+			return err
+		}
+		ctx.Errorf("Migrated %d key(s)\n", len(migrated))
+		return a.printOutput(ctx, migrated)
+	}
+	keysCmd.AddCommand(migrateCmd)
+
+	// keys sign-message
+	signMsgCmd := cli.NewCommand("sign-message")
+	signMsgCmd.Short = "Sign an off-chain message"
+	signMsgCmd.Long = "Sign an arbitrary message with a key (ADR-036). The signature and public key are base64 encoded."
+	signMsgCmd.Args = []cli.Arg{
+		{Name: "key", Required: true, Description: "Key name", Complete: cli.CompleteKeys},
+		{Name: "message", Required: true, Description: "Message to sign"},
+	}
+	signMsgCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return fmt.Errorf("key name and message required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		keysMod := keys.New(client)
+		signed, err := keysMod.SignMessage(context.Background(), ctx.Args[0], ctx.Args[1])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, signed)
+	}
+	keysCmd.AddCommand(signMsgCmd)
+
+	// keys verify-message
+	verifyMsgCmd := cli.NewCommand("verify-message")
+	verifyMsgCmd.Short = "Verify an off-chain message signature"
+	verifyMsgCmd.Long = `Verify a base64 message signature created with sign-message.
+
+The signer's public key is looked up in the keyring, then on chain. Pass
+--pubkey if the account is in neither.`
+	verifyMsgCmd.Args = []cli.Arg{
+		{Name: "address", Required: true, Description: "Signer address"},
+		{Name: "message", Required: true, Description: "Signed message"},
+		{Name: "signature", Required: true, Description: "Base64 signature"},
+	}
+	verifyMsgCmd.AddFlag(cli.Flag{Name: "pubkey", Usage: "Base64 public key of the signer"})
+	verifyMsgCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 3 {
+			return fmt.Errorf("address, message and signature required")
+		}
+		address, message, signature := ctx.Args[0], ctx.Args[1], ctx.Args[2]
+
+		var valid bool
+		var err error
+		if pubKey := ctx.GetFlag("pubkey"); pubKey != "" {
+			valid, err = keys.VerifyMessageWithPubKey(address, message, signature, pubKey)
+		} else {
+			client, cerr := a.getClient(ctx)
+			if cerr != nil {
+				return cerr
+			}
+			valid, err = keys.New(client).VerifyMessage(context.Background(), address, message, signature)
+		}
+		if err != nil {
+			return err
+		}
+
+		result := struct {
+			Address string `json:"address"`
+			Message string `json:"message"`
+			Valid   bool   `json:"valid"`
+		}{address, message, valid}
+		if err := a.printOutput(ctx, result); err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("signature is not valid")
+		}
+		return nil
+	}
+	keysCmd.AddCommand(verifyMsgCmd)
+
+	// keys convert-address
+	convertCmd := cli.NewCommand("convert-address")
+	convertCmd.Short = "Convert an address between kira, kiravaloper and kiravalcons"
+	convertCmd.Long = `Re-encode a kira, kiravaloper or kiravalcons address with another prefix,
+or show it with every prefix when --to is not given. This works offline.
+
+A validator's operator address and its account address are the same bytes,
+so converting between kira and kiravaloper is exact. The consensus address of
+a node is derived from its separate consensus key instead; a kiravalcons
+address converted from an account is not that node's consensus address.`
+	convertCmd.Usage = `  sekai-cli keys convert-address kira1... --to kiravaloper
+  sekai-cli keys convert-address kiravaloper1...`
+	convertCmd.Args = []cli.Arg{
+		{Name: "address", Required: true, Description: "kira1..., kiravaloper1... or kiravalcons1... address"},
+	}
+	convertCmd.AddFlag(cli.Flag{Name: "to", Usage: "Prefix to convert to (kira, kiravaloper, kiravalcons)"})
+	convertCmd.Run = func(ctx *cli.Context) error {
+		if to := ctx.GetFlag("to"); to != "" {
+			address, err := keys.ConvertAddress(ctx.Args[0], to)
+			if err != nil {
+				return err
+			}
+			return a.printOutput(ctx, address)
+		}
+		related, err := keys.ConvertAddressAll(ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, related)
+	}
+	keysCmd.AddCommand(convertCmd)
+
 	return keysCmd
 }
 
@@ -374,6 +1401,7 @@ func (a *App) buildBankCommand() *cli.Command {
 	balancesCmd := cli.NewCommand("balances")
 	balancesCmd.Short = "Query account balances"
 	balancesCmd.Args = []cli.Arg{{Name: "address", Required: true, Description: "Account address"}}
+	balancesCmd.AddFlag(cli.Flag{Name: "denom", Usage: "Print only the balance of this denom (0<denom> if none)", Complete: cli.CompleteDenoms})
 	balancesCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("address required")
@@ -383,26 +1411,56 @@ func (a *App) buildBankCommand() *cli.Command {
 			return err
 		}
 		bankMod := bank.New(client)
+		if denom := ctx.GetFlag("denom"); denom != "" {
+			balance, err := bankMod.Balance(context.Background(), ctx.Args[0], denom)
+			if err != nil {
+				return err
+			}
+			return a.printOutput(ctx, balance)
+		}
 		balances, err := bankMod.Balances(context.Background(), ctx.Args[0])
 		if err != nil {
 			return err
 		}
 		return a.printOutput(ctx, balances)
 	}
+	a.addWatchSupport(balancesCmd)
 	bankCmd.AddCommand(balancesCmd)
 
 	// bank send
 	sendCmd := cli.NewCommand("send")
 	sendCmd.Short = "Send tokens"
 	sendCmd.Args = []cli.Arg{
-		{Name: "from", Required: true, Description: "Sender key name"},
-		{Name: "to", Required: true, Description: "Recipient address"},
-		{Name: "amount", Required: true, Description: "Amount to send (e.g., 100ukex)"},
+		{Name: "from", Description: "Sender key name (prompted with --interactive)", Complete: cli.CompleteKeys},
+		{Name: "to", Description: "Recipient address, key name or address book alias (prompted with --interactive)"},
+		{Name: "amount", Description: "Amount to send, e.g. 100ukex or 100ukex,50samolean (prompted with --interactive)"},
+	}
+	sendCmd.Usage = `  sekai-cli bank send genesis kira1... 100ukex
+  sekai-cli bank send genesis kira1... 100ukex,50samolean
+  sekai-cli bank send genesis kira1... --all --fees 100ukex`
+	sendCmd.Flags = []cli.Flag{
+		{Name: "interactive", Usage: "Prompt step by step for sender, recipient and amount"},
+		{Name: "all", Usage: "Send the entire spendable balance of the sender, minus fees"},
 	}
 	cli.AddTxFlags(sendCmd)
 	sendCmd.Run = func(ctx *cli.Context) error {
-		if len(ctx.Args) < 3 {
-			return fmt.Errorf("from, to, and amount required")
+		sendAll := ctx.GetFlag("all") == "true"
+		if ctx.GetFlag("interactive") == "true" {
+			if sendAll {
+				return cli.Usagef("--all cannot be combined with --interactive")
+			}
+			client, err := a.getClient(ctx)
+			if err != nil {
+				return err
+			}
+			return a.runSendWizard(ctx, client)
+		}
+		if sendAll {
+			if len(ctx.Args) != 2 {
+				return fmt.Errorf("from and to required, and no amount, with --all")
+			}
+		} else if len(ctx.Args) < 3 {
+			return fmt.Errorf("from, to, and amount required (or use --interactive or --all)")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -410,11 +1468,6 @@ func (a *App) buildBankCommand() *cli.Command {
 		}
 		bankMod := bank.New(client)
 
-		coins, err := types.ParseCoins(ctx.Args[2])
-		if err != nil {
-			return fmt.Errorf("invalid amount: %w", err)
-		}
-
 		opts := &bank.SendOptions{
 			Fees:          ctx.GetFlag("fees"),
 			Gas:           ctx.GetFlag("gas"),
@@ -422,7 +1475,51 @@ func (a *App) buildBankCommand() *cli.Command {
 			BroadcastMode: ctx.GetFlag("broadcast-mode"),
 		}
 
-		resp, err := bankMod.Send(context.Background(), ctx.Args[0], ctx.Args[1], coins, opts)
+		var coins types.Coins
+		if sendAll {
+			// Pin the fee so that exactly the subtracted amount is paid
+			opts.Fees, err = a.txFees(ctx, a.loadCache(ctx))
+			if err != nil {
+				return err
+			}
+			fees, err := types.ParseCoins(opts.Fees)
+			if err != nil {
+				return fmt.Errorf("invalid fees: %w", err)
+			}
+			sender := ctx.Args[0]
+			if !types.IsValidAddress(sender) {
+				sender, err = keys.New(client).GetAddress(context.Background(), sender)
+				if err != nil {
+					return err
+				}
+			}
+			coins, err = bankMod.SweepAmount(context.Background(), sender, fees)
+			if err != nil {
+				return err
+			}
+		} else {
+			coins, err = types.ParseCoins(ctx.Args[2])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+		}
+		if coins.IsZero() {
+			return fmt.Errorf("invalid amount: nothing to send")
+		}
+		to, err := a.resolveAddresses(client, ctx.Args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", ctx.Args[0]),
+			fmt.Sprintf("To:       %s", to[0]),
+			fmt.Sprintf("Amount:   %s", coins),
+		); err != nil {
+			return err
+		}
+
+		resp, err := bankMod.Send(context.Background(), ctx.Args[0], to[0], coins, opts)
 		if err != nil {
 			return err
 		}
@@ -430,6 +1527,9 @@ func (a *App) buildBankCommand() *cli.Command {
 	}
 	bankCmd.AddCommand(sendCmd)
 
+	// bank airdrop
+	bankCmd.AddCommand(a.buildAirdropCommand())
+
 	return bankCmd
 }
 
@@ -450,13 +1550,20 @@ func (a *App) buildQueryCommand() *cli.Command {
 	queryCmd.AddCommand(a.buildQueryUBICommand())
 	queryCmd.AddCommand(a.buildQueryUpgradeCommand())
 	queryCmd.AddCommand(a.buildQuerySlashingCommand())
+	queryCmd.AddCommand(a.buildQueryEvidenceCommand())
 	queryCmd.AddCommand(a.buildQueryDistributorCommand())
 	queryCmd.AddCommand(a.buildQueryBasketCommand())
 	queryCmd.AddCommand(a.buildQueryCollectivesCommand())
 	queryCmd.AddCommand(a.buildQueryCustodyCommand())
 	queryCmd.AddCommand(a.buildQueryBridgeCommand())
+	queryCmd.AddCommand(a.buildQueryEthereumCommand())
 	queryCmd.AddCommand(a.buildQueryLayer2Command())
 	queryCmd.AddCommand(a.buildQueryRecoveryCommand())
+	queryCmd.AddCommand(a.buildQueryParamsCommand())
+	queryCmd.AddCommand(a.buildQueryTxCommand())
+
+	a.addHeightSupport(queryCmd)
+	a.addWatchSupport(queryCmd)
 
 	return queryCmd
 }
@@ -470,6 +1577,7 @@ func (a *App) buildQueryAuthCommand() *cli.Command {
 	accountCmd := cli.NewCommand("account")
 	accountCmd.Short = "Query account by address"
 	accountCmd.Args = []cli.Arg{{Name: "address", Required: true}}
+	accountCmd.AddFlag(cli.Flag{Name: "decode", Usage: "Decode the pubkey to bech32, resolve the account type and show any vesting schedule"})
 	accountCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("address required")
@@ -479,6 +1587,13 @@ func (a *App) buildQueryAuthCommand() *cli.Command {
 			return err
 		}
 		authMod := auth.New(client)
+		if ctx.GetFlag("decode") == "true" {
+			account, err := authMod.DecodedAccount(context.Background(), ctx.Args[0])
+			if err != nil {
+				return err
+			}
+			return a.printOutput(ctx, account)
+		}
 		account, err := authMod.Account(context.Background(), ctx.Args[0])
 		if err != nil {
 			return err
@@ -490,17 +1605,49 @@ func (a *App) buildQueryAuthCommand() *cli.Command {
 	// query auth accounts
 	accountsCmd := cli.NewCommand("accounts")
 	accountsCmd.Short = "Query all accounts"
+	accountsCmd.Long = `Query one page of accounts.
+
+With --output json --stream, query every page from the first (or
+--page-key), --limit accounts at a time, and print the accounts as one JSON
+array while the pages arrive, so chains with many accounts do not need the
+whole list in memory. If a page fails, the array is left unterminated.`
+	accountsCmd.Usage = `  sekai-cli query auth accounts --limit 50
+  sekai-cli query auth accounts --output json --stream --limit 1000 > accounts.json`
+	cli.AddPaginationFlags(accountsCmd)
+	accountsCmd.AddFlag(streamFlag)
 	accountsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
+		stream, err := a.newJSONStream(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		authMod := auth.New(client)
-		accounts, err := authMod.Accounts(context.Background(), nil)
+		if stream != nil {
+			err := authMod.AccountPages(context.Background(), pagination, func(page *auth.AccountsResponse) error {
+				for _, account := range page.Accounts {
+					if err := stream.Write(account); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			return stream.Close()
+		}
+		accounts, err := authMod.Accounts(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, accounts)
+		return a.printPaginated(ctx, accounts)
 	}
 	authQuery.AddCommand(accountsCmd)
 
@@ -591,16 +1738,51 @@ func (a *App) buildQueryBankCommand() *cli.Command {
 
 	balancesCmd := cli.NewCommand("balances")
 	balancesCmd.Short = "Query account balances"
-	balancesCmd.Args = []cli.Arg{{Name: "address", Required: true}}
+	balancesCmd.Long = `Query the balances of an account.
+
+With --all-accounts, list instead every account holding at least --min of a
+denom, largest first. All holders of the denom are queried page by page,
+which takes a while on chains with many accounts; progress is shown on
+stderr. --top keeps only the largest holders.
+
+With --output json --stream, the holders are printed as one JSON array
+while the pages arrive, in the order the node returns them rather than
+largest first, so the owners need not all be held in memory.`
+	balancesCmd.Usage = `  sekai-cli query bank balances kira1...
+  sekai-cli query bank balances --all-accounts --min 1000000000ukex --top 20
+  sekai-cli query bank balances --all-accounts --denom ukex --output csv > holders.csv`
+	balancesCmd.Args = []cli.Arg{{Name: "address", Description: "Account address (not with --all-accounts)"}}
+	balancesCmd.AddFlag(cli.Flag{Name: "denom", Usage: "Print only the balance of this denom (0<denom> if none); with --all-accounts, the denom to report", Complete: cli.CompleteDenoms})
+	balancesCmd.AddFlag(cli.Flag{Name: "all-accounts", Usage: "List the accounts holding a denom, largest first, instead of one account's balances"})
+	balancesCmd.AddFlag(cli.Flag{Name: "min", Usage: "With --all-accounts, only accounts holding at least this amount or coin, e.g. 1000000ukex"})
+	balancesCmd.AddFlag(cli.Flag{Name: "top", Usage: "With --all-accounts, only the N largest holders"})
+	balancesCmd.AddFlag(cli.Flag{Name: "stream", Bool: true, Usage: "With --all-accounts and --output json, print the holders as one JSON array while the pages arrive, unsorted"})
 	balancesCmd.Run = func(ctx *cli.Context) error {
-		if len(ctx.Args) < 1 {
+		allAccounts := ctx.GetFlag("all-accounts") == "true"
+		if allAccounts && len(ctx.Args) > 0 {
+			return cli.Usagef("--all-accounts takes no address")
+		}
+		if !allAccounts && (ctx.GetFlag("min") != "" || ctx.GetFlag("top") != "" || ctx.GetFlag("stream") == "true") {
+			return cli.Usagef("--min, --top and --stream require --all-accounts")
+		}
+		if !allAccounts && len(ctx.Args) < 1 {
 			return fmt.Errorf("address required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
+		if allAccounts {
+			return a.printHolders(ctx, client)
+		}
 		bankMod := bank.New(client)
+		if denom := ctx.GetFlag("denom"); denom != "" {
+			balance, err := bankMod.Balance(context.Background(), ctx.Args[0], denom)
+			if err != nil {
+				return err
+			}
+			return a.printOutput(ctx, balance)
+		}
 		balances, err := bankMod.Balances(context.Background(), ctx.Args[0])
 		if err != nil {
 			return err
@@ -638,18 +1820,42 @@ func (a *App) buildQueryBankCommand() *cli.Command {
 			return err
 		}
 		bankMod := bank.New(client)
-		balances, err := bankMod.SpendableBalances(context.Background(), ctx.Args[0])
+		balances, err := bankMod.SpendableBalances(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, balances)
+	}
+	bankQuery.AddCommand(spendableCmd)
+
+	// spendable-balance
+	spendableDenomCmd := cli.NewCommand("spendable-balance")
+	spendableDenomCmd.Short = "Query the spendable balance of one denom (0<denom> if none)"
+	spendableDenomCmd.Args = []cli.Arg{
+		{Name: "address", Required: true},
+		{Name: "denom", Required: true, Complete: cli.CompleteDenoms},
+	}
+	spendableDenomCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return fmt.Errorf("address and denom required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		bankMod := bank.New(client)
+		balance, err := bankMod.SpendableBalanceByDenom(context.Background(), ctx.Args[0], ctx.Args[1])
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, balances)
+		return a.printOutput(ctx, balance)
 	}
-	bankQuery.AddCommand(spendableCmd)
+	bankQuery.AddCommand(spendableDenomCmd)
 
 	// denom-metadata
 	denomMetaCmd := cli.NewCommand("denom-metadata")
 	denomMetaCmd.Short = "Query denom metadata"
-	denomMetaCmd.AddFlag(cli.Flag{Name: "denom", Usage: "Denom to query metadata for"})
+	denomMetaCmd.AddFlag(cli.Flag{Name: "denom", Usage: "Denom to query metadata for", Complete: cli.CompleteDenoms})
 	denomMetaCmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -713,21 +1919,53 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	proposalsCmd.Short = "Query proposals"
 	proposalsCmd.AddFlag(cli.Flag{Name: "voter", Usage: "Filter by voter"})
 	proposalsCmd.AddFlag(cli.Flag{Name: "status", Usage: "Filter by status"})
+	proposalsCmd.AddFlag(cli.Flag{Name: "type", Usage: "Filter by proposal type (e.g. SetNetworkProperty)"})
+	proposalsCmd.AddFlag(cli.Flag{Name: "sort-by", Usage: "Sort by field (" + strings.Join(gov.ProposalSortKeys, ", ") + ")"})
+	proposalsCmd.AddFlag(cli.Flag{Name: "order", Usage: "Sort order (asc, desc)", Default: "asc"})
+	cli.AddPaginationFlags(proposalsCmd)
+	proposalsCmd.Usage = `  sekai-cli query customgov proposals --type SetNetworkProperty
+  sekai-cli query customgov proposals --status VOTE_RESULT_PASSED --type SetNetworkProperty
+  sekai-cli query customgov proposals --sort-by voting-end --order desc
+
+Filters combine: only proposals matching all of them are shown. --type and
+sorting apply to the returned page of results.`
 	proposalsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
+		sortBy := ctx.GetFlag("sort-by")
+		order := ctx.GetFlag("order")
+		if order != "asc" && order != "desc" {
+			return fmt.Errorf("invalid order %q (valid: asc, desc)", order)
+		}
+		if sortBy != "" && !slices.Contains(gov.ProposalSortKeys, sortBy) {
+			return fmt.Errorf("invalid sort key %q (valid: %s)", sortBy, strings.Join(gov.ProposalSortKeys, ", "))
+		}
+		if sortBy == "" && ctx.IsSet("order") {
+			return cli.Usagef("--order requires --sort-by")
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		govMod := gov.New(client)
 		opts := &gov.ProposalQueryOpts{
-			Voter:  ctx.GetFlag("voter"),
-			Status: ctx.GetFlag("status"),
+			Voter:      ctx.GetFlag("voter"),
+			Status:     ctx.GetFlag("status"),
+			Type:       ctx.GetFlag("type"),
+			Pagination: pagination,
 		}
 		proposals, err := govMod.Proposals(context.Background(), opts)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, proposals)
+		if sortBy != "" {
+			if err := gov.SortProposals(proposals.Proposals, sortBy, order == "desc"); err != nil {
+				return err
+			}
+		}
+		return a.printPaginated(ctx, proposals)
 	}
 	govQuery.AddCommand(proposalsCmd)
 
@@ -735,6 +1973,17 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	proposalCmd := cli.NewCommand("proposal")
 	proposalCmd.Short = "Query proposal by ID"
 	proposalCmd.Args = []cli.Arg{{Name: "proposal-id", Required: true}}
+	proposalCmd.Usage = `  sekai-cli query customgov proposal 12
+  sekai-cli query customgov proposal 12 --watch-until-final --poll 10s --watch-timeout 30m`
+	proposalCmd.AddFlag(cli.Flag{Name: "watch-until-final", Usage: "Poll until the proposal is passed, rejected or fails quorum, printing result changes to stderr"})
+	proposalCmd.AddFlag(cli.Flag{Name: "poll", Usage: "Poll interval for --watch-until-final", Default: "10s"})
+	proposalCmd.AddFlag(cli.Flag{Name: "watch-timeout", Usage: "Give up --watch-until-final after this long and exit non-zero", Default: "30m"})
+	proposalCmd.PreRun = func(ctx *cli.Context) error {
+		if ctx.GetFlag("watch-until-final") == "true" && ctx.GetFlag("watch") != "" {
+			return cli.Usagef("--watch-until-final cannot be combined with --watch")
+		}
+		return nil
+	}
 	proposalCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("proposal ID required")
@@ -744,6 +1993,9 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 			return err
 		}
 		govMod := gov.New(client)
+		if ctx.GetFlag("watch-until-final") == "true" {
+			return a.watchProposalUntilFinal(ctx, govMod, ctx.Args[0])
+		}
 		proposal, err := govMod.Proposal(context.Background(), ctx.Args[0])
 		if err != nil {
 			return err
@@ -773,20 +2025,74 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	}
 	govQuery.AddCommand(votesCmd)
 
+	// tally
+	tallyCmd := cli.NewCommand("tally")
+	tallyCmd.Aliases = []string{"proposal-votes-tally"}
+	tallyCmd.Short = "Tally the votes on a proposal and project its outcome"
+	tallyCmd.Long = `Count yes, no, abstain and veto votes on a proposal and project the outcome
+if voting ended now. quorum_reached compares the turnout of eligible voters
+with the network vote quorum (from the cache, else queried); would_pass also
+requires more than half of the non-abstaining votes to be yes and less than a
+third to be vetoes.`
+	tallyCmd.Usage = `  sekai-cli query customgov tally 3
+  sekai-cli query customgov tally 3 -o json | jq -e .would_pass`
+	tallyCmd.Args = []cli.Arg{{Name: "proposal-id", Required: true}}
+	tallyCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("proposal ID required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		opts := &gov.TallyOpts{}
+		if cachedData := a.loadCache(ctx); cachedData != nil {
+			opts.VoteQuorum = cachedData.Network.VoteQuorum
+		}
+		tally, err := gov.New(client).ProposalTally(context.Background(), ctx.Args[0], opts)
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, tally)
+	}
+	govQuery.AddCommand(tallyCmd)
+
 	// councilors
 	councilorsCmd := cli.NewCommand("councilors")
 	councilorsCmd.Short = "Query councilors"
+	councilorsCmd.Long = `List the councilors with the moniker of each, optionally only those with one
+status. A count of the whole council by status is printed to stderr.`
+	councilorsCmd.Usage = `  sekai-cli query customgov councilors
+  sekai-cli query customgov councilors --status jailed`
+	councilorsCmd.Flags = []cli.Flag{
+		{Name: "status", Usage: "Only list councilors with this status (" + strings.Join(gov.CouncilorStatuses, ", ") + ")"},
+	}
 	councilorsCmd.Run = func(ctx *cli.Context) error {
+		status := strings.ToLower(ctx.GetFlag("status"))
+		if status != "" && !slices.Contains(gov.CouncilorStatuses, status) {
+			return cli.Usagef("invalid --status: %s (expected %s)", ctx.GetFlag("status"), strings.Join(gov.CouncilorStatuses, ", "))
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
-		govMod := gov.New(client)
-		councilors, err := govMod.Councilors(context.Background())
+		councilors, err := gov.New(client).Councilors(context.Background())
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, councilors)
+		counts := gov.CountCouncilors(councilors)
+		if status != "" {
+			councilors = gov.FilterCouncilors(councilors, status)
+		}
+		if err := a.printOutput(ctx, a.withMonikers(ctx, client, councilors)); err != nil {
+			return err
+		}
+		summary := fmt.Sprintf("%d active, %d paused, %d jailed", counts["active"], counts["paused"], counts["jailed"])
+		if counts["inactive"] > 0 {
+			summary += fmt.Sprintf(", %d inactive", counts["inactive"])
+		}
+		ctx.Errorf("%s\n", summary)
+		return nil
 	}
 	govQuery.AddCommand(councilorsCmd)
 
@@ -911,17 +2217,22 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	// identity-records
 	idRecordsCmd := cli.NewCommand("identity-records")
 	idRecordsCmd.Short = "Query all identity records"
+	cli.AddPaginationFlags(idRecordsCmd)
 	idRecordsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		govMod := gov.New(client)
-		records, err := govMod.IdentityRecords(context.Background())
+		records, err := govMod.IdentityRecordsPage(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, records)
+		return a.printPaginated(ctx, records)
 	}
 	govQuery.AddCommand(idRecordsCmd)
 
@@ -970,17 +2281,22 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	// data-registry-keys
 	dataKeysCmd := cli.NewCommand("data-registry-keys")
 	dataKeysCmd.Short = "Query all data registry keys"
+	cli.AddPaginationFlags(dataKeysCmd)
 	dataKeysCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		govMod := gov.New(client)
-		keys, err := govMod.DataRegistryKeys(context.Background())
+		keys, err := govMod.DataRegistryKeysPage(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, keys)
+		return a.printPaginated(ctx, keys)
 	}
 	govQuery.AddCommand(dataKeysCmd)
 
@@ -1194,17 +2510,22 @@ func (a *App) buildQueryCustomgovCommand() *cli.Command {
 	// all-identity-record-verify-requests
 	allIdVerifyReqsCmd := cli.NewCommand("all-identity-record-verify-requests")
 	allIdVerifyReqsCmd.Short = "Query all identity record verify requests"
+	cli.AddPaginationFlags(allIdVerifyReqsCmd)
 	allIdVerifyReqsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		govMod := gov.New(client)
-		reqs, err := govMod.AllIdentityRecordVerifyRequests(context.Background())
+		reqs, err := govMod.AllIdentityRecordVerifyRequestsPage(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, reqs)
+		return a.printPaginated(ctx, reqs)
 	}
 	govQuery.AddCommand(allIdVerifyReqsCmd)
 
@@ -1372,51 +2693,86 @@ func (a *App) buildQueryCustomstakingCommand() *cli.Command {
 	validatorsCmd.AddFlag(cli.Flag{Name: "status", Usage: "Filter by status"})
 	validatorsCmd.AddFlag(cli.Flag{Name: "pubkey", Usage: "Filter by pubkey"})
 	validatorsCmd.AddFlag(cli.Flag{Name: "proposer", Usage: "Filter by proposer"})
+	cli.AddPaginationFlags(validatorsCmd)
 	validatorsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		stakingMod := staking.New(client)
 		opts := &staking.ValidatorQueryOpts{
-			Address:  ctx.GetFlag("addr"),
-			ValAddr:  ctx.GetFlag("val-addr"),
-			Moniker:  ctx.GetFlag("moniker"),
-			Status:   ctx.GetFlag("status"),
-			PubKey:   ctx.GetFlag("pubkey"),
-			Proposer: ctx.GetFlag("proposer"),
+			Address:    ctx.GetFlag("addr"),
+			ValAddr:    ctx.GetFlag("val-addr"),
+			Moniker:    ctx.GetFlag("moniker"),
+			Status:     ctx.GetFlag("status"),
+			PubKey:     ctx.GetFlag("pubkey"),
+			Proposer:   ctx.GetFlag("proposer"),
+			Pagination: pagination,
 		}
 		validators, err := stakingMod.Validators(context.Background(), opts)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, validators)
+		return a.printPaginated(ctx, validators)
 	}
 	stakingQuery.AddCommand(validatorsCmd)
 
 	// validator
 	validatorCmd := cli.NewCommand("validator")
 	validatorCmd.Short = "Query a validator by address, val-address, or moniker"
+	validatorCmd.Usage = `  sekai-cli query customstaking validator --moniker validator-1
+  sekai-cli query customstaking validator --self
+  sekai-cli query customstaking validator --self --from operator`
 	validatorCmd.AddFlag(cli.Flag{Name: "addr", Usage: "Query by address"})
 	validatorCmd.AddFlag(cli.Flag{Name: "val-addr", Usage: "Query by validator address"})
 	validatorCmd.AddFlag(cli.Flag{Name: "moniker", Usage: "Query by moniker"})
+	validatorCmd.AddFlag(cli.Flag{Name: "self", Usage: "Query the validator of the --from key, or of the default key"})
+	validatorCmd.AddFlag(cli.Flag{Name: "from", Usage: "Key name or address whose validator --self queries", Complete: cli.CompleteKeys})
 	validatorCmd.Run = func(ctx *cli.Context) error {
 		addr := ctx.GetFlag("addr")
 		valAddr := ctx.GetFlag("val-addr")
 		moniker := ctx.GetFlag("moniker")
-		if addr == "" && valAddr == "" && moniker == "" {
-			return fmt.Errorf("at least one of --addr, --val-addr, or --moniker required")
+		self := ctx.GetFlag("self") == "true"
+		if self && (addr != "" || valAddr != "" || moniker != "") {
+			return cli.Usagef("--self cannot be combined with --addr, --val-addr or --moniker")
+		}
+		if !self && addr == "" && valAddr == "" && moniker == "" {
+			return fmt.Errorf("at least one of --addr, --val-addr, --moniker or --self required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
+		from := ""
+		if self {
+			from = a.getFromFlag(ctx)
+			if from == "" {
+				return cli.Usagef("--self requires --from or a default key (run 'sekai-cli init' to set default)")
+			}
+			addr = from
+			if !types.IsValidAddress(from) {
+				addr, err = keys.New(client).GetAddress(context.Background(), from)
+				if err != nil {
+					return err
+				}
+			}
+		}
 		stakingMod := staking.New(client)
 		validator, err := stakingMod.Validator(context.Background(), &staking.ValidatorQueryOpts{
 			Address: addr,
 			ValAddr: valAddr,
 			Moniker: moniker,
 		})
+		if self && (sdk.IsNotFound(err) || err == nil && validator.GetValKey() == "" && validator.Status == "") {
+			if from != addr {
+				from = fmt.Sprintf("%s (%s)", from, addr)
+			}
+			return fmt.Errorf("%s is not a validator: claim a seat with 'sekai-cli tx customstaking claim-validator-seat'", from)
+		}
 		if err != nil {
 			return err
 		}
@@ -1435,16 +2791,46 @@ func (a *App) buildQueryTokensCommand() *cli.Command {
 	// all-rates
 	allRatesCmd := cli.NewCommand("all-rates")
 	allRatesCmd.Short = "Query all token rates"
+	allRatesCmd.AddFlag(cli.Flag{Name: "staking-only", Usage: "Only tokens with staking enabled"})
+	allRatesCmd.AddFlag(cli.Flag{Name: "fee-payments-only", Usage: "Only tokens that can pay transaction fees"})
+	allRatesCmd.AddFlag(cli.Flag{Name: "denom-prefix", Usage: "Only denoms starting with this prefix"})
+	allRatesCmd.AddFlag(cli.Flag{Name: "sort-by", Usage: "Sort by field (" + strings.Join(tokens.RateSortKeys, ", ") + ")"})
+	allRatesCmd.AddFlag(cli.Flag{Name: "order", Usage: "Sort order (asc, desc)", Default: "asc"})
+	allRatesCmd.Usage = `  sekai-cli query tokens all-rates --staking-only
+  sekai-cli query tokens all-rates --fee-payments-only --sort-by fee-rate --order desc
+  sekai-cli query tokens all-rates --denom-prefix v1/
+
+Filters combine: a token must match all of them.`
 	allRatesCmd.Run = func(ctx *cli.Context) error {
+		sortBy := ctx.GetFlag("sort-by")
+		order := ctx.GetFlag("order")
+		if order != "asc" && order != "desc" {
+			return fmt.Errorf("invalid order %q (valid: asc, desc)", order)
+		}
+		if sortBy != "" && !slices.Contains(tokens.RateSortKeys, sortBy) {
+			return fmt.Errorf("invalid sort key %q (valid: %s)", sortBy, strings.Join(tokens.RateSortKeys, ", "))
+		}
+		if sortBy == "" && ctx.IsSet("order") {
+			return cli.Usagef("--order requires --sort-by")
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		tokensMod := tokens.New(client)
-		rates, err := tokensMod.AllRates(context.Background())
+		rates, err := tokensMod.FilteredRates(context.Background(), &tokens.RatesQueryOpts{
+			StakingOnly:     ctx.GetFlag("staking-only") == "true",
+			FeePaymentsOnly: ctx.GetFlag("fee-payments-only") == "true",
+			DenomPrefix:     ctx.GetFlag("denom-prefix"),
+		})
 		if err != nil {
 			return err
 		}
+		if sortBy != "" {
+			if err := tokens.SortRates(rates.Data, sortBy, order == "desc"); err != nil {
+				return err
+			}
+		}
 		return a.printOutput(ctx, rates)
 	}
 	tokensQuery.AddCommand(allRatesCmd)
@@ -1452,7 +2838,7 @@ func (a *App) buildQueryTokensCommand() *cli.Command {
 	// rate
 	rateCmd := cli.NewCommand("rate")
 	rateCmd.Short = "Query token rate by denom"
-	rateCmd.Args = []cli.Arg{{Name: "denom", Required: true}}
+	rateCmd.Args = []cli.Arg{{Name: "denom", Required: true, Complete: cli.CompleteDenoms}}
 	rateCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("denom required")
@@ -1473,7 +2859,7 @@ func (a *App) buildQueryTokensCommand() *cli.Command {
 	// rates-by-denom
 	ratesByDenomCmd := cli.NewCommand("rates-by-denom")
 	ratesByDenomCmd.Short = "Query token rates by denom"
-	ratesByDenomCmd.Args = []cli.Arg{{Name: "denom", Required: true}}
+	ratesByDenomCmd.Args = []cli.Arg{{Name: "denom", Required: true, Complete: cli.CompleteDenoms}}
 	ratesByDenomCmd.Run = func(ctx *cli.Context) error {
 		if len(ctx.Args) < 1 {
 			return fmt.Errorf("denom required")
@@ -1508,6 +2894,30 @@ func (a *App) buildQueryTokensCommand() *cli.Command {
 	}
 	tokensQuery.AddCommand(blackWhitesCmd)
 
+	// token-info
+	tokenInfoCmd := cli.NewCommand("token-info")
+	tokenInfoCmd.Short = "Query rate, supply, black/white status and metadata of a token"
+	tokenInfoCmd.Long = `Query everything known about a token in one call: its rate and fee
+payment settings, supply, black/white list status and bank metadata.
+Parts that cannot be queried are listed under "missing".`
+	tokenInfoCmd.Args = []cli.Arg{{Name: "denom", Required: true, Complete: cli.CompleteDenoms}}
+	tokenInfoCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("denom required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		tokensMod := tokens.New(client)
+		info, err := tokensMod.TokenInfo(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, info)
+	}
+	tokensQuery.AddCommand(tokenInfoCmd)
+
 	return tokensQuery
 }
 
@@ -1519,17 +2929,27 @@ func (a *App) buildQueryMultistakingCommand() *cli.Command {
 	// pools
 	poolsCmd := cli.NewCommand("pools")
 	poolsCmd.Short = "Query all staking pools"
+	poolsCmd.Long = `Query the staking pools. sekaid returns every pool at once, so --limit,
+--offset and --page cut the page from them in pool ID order, and the next
+page key is a pool ID.`
+	poolsCmd.Usage = `  sekai-cli query multistaking pools --limit 20
+  sekai-cli query multistaking pools --limit 20 --page-key 21`
+	cli.AddPaginationFlags(poolsCmd)
 	poolsCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		msMod := multistaking.New(client)
-		pools, err := msMod.Pools(context.Background())
+		pools, err := msMod.PoolsPage(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, pools)
+		return a.printPaginated(ctx, a.withMonikers(ctx, client, pools))
 	}
 	multistakingQuery.AddCommand(poolsCmd)
 
@@ -1578,6 +2998,30 @@ func (a *App) buildQueryMultistakingCommand() *cli.Command {
 	}
 	multistakingQuery.AddCommand(rewardsCmd)
 
+	// rewards-total
+	rewardsTotalCmd := cli.NewCommand("rewards-total")
+	rewardsTotalCmd.Short = "Query a delegator's outstanding rewards summed across its pools"
+	rewardsTotalCmd.Long = `Query the outstanding rewards of a delegator summed per denom, together
+with the number and IDs of the staking pools it has delegated to. Compare
+with 'query multistaking delegations' for the per-pool positions.`
+	rewardsTotalCmd.Args = []cli.Arg{{Name: "delegator", Required: true}}
+	rewardsTotalCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("delegator address required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		msMod := multistaking.New(client)
+		total, err := msMod.TotalRewards(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, total)
+	}
+	multistakingQuery.AddCommand(rewardsTotalCmd)
+
 	// compound-info
 	compoundCmd := cli.NewCommand("compound-info")
 	compoundCmd.Short = "Query compound information of a delegator"
@@ -1599,6 +3043,37 @@ func (a *App) buildQueryMultistakingCommand() *cli.Command {
 	}
 	multistakingQuery.AddCommand(compoundCmd)
 
+	// delegations
+	delegationsCmd := cli.NewCommand("delegations")
+	delegationsCmd.Short = "Query a delegator's positions across all staking pools"
+	delegationsCmd.Long = `List the pools a delegator has staked in, with its share tokens, their
+staked value, and its outstanding rewards. Pages are taken over the pools in
+ID order; --page-key is a pool ID.`
+	delegationsCmd.Usage = `  sekai-cli query multistaking delegations kira1...
+  sekai-cli query multistaking delegations kira1... --limit 10 --page 2`
+	delegationsCmd.Args = []cli.Arg{{Name: "delegator", Required: true}}
+	cli.AddPaginationFlags(delegationsCmd)
+	delegationsCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("delegator address required")
+		}
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		msMod := multistaking.New(client)
+		delegations, err := msMod.DelegatorDelegations(context.Background(), ctx.Args[0], pagination)
+		if err != nil {
+			return err
+		}
+		return a.printPaginated(ctx, delegations)
+	}
+	multistakingQuery.AddCommand(delegationsCmd)
+
 	// staking-pool-delegators
 	delegatorsCmd := cli.NewCommand("staking-pool-delegators")
 	delegatorsCmd.Short = "Query staking pool delegators for a validator"
@@ -1757,6 +3232,70 @@ func (a *App) buildQueryUBICommand() *cli.Command {
 	return ubiQuery
 }
 
+// buildQueryParamsCommand builds the query params command, which reads
+// parameters of any subspace through the params module.
+func (a *App) buildQueryParamsCommand() *cli.Command {
+	paramsCmd := cli.NewCommand("params")
+	paramsCmd.Short = "Query the parameters of a module subspace"
+	paramsCmd.Args = []cli.Arg{
+		{Name: "subspace", Description: "Parameter subspace, e.g. auth (lists the known subspaces if omitted)"},
+		{Name: "key", Description: "Parameter keys to query (default: all keys of a known subspace)"},
+	}
+	paramsCmd.Usage = `  sekai-cli query params
+  sekai-cli query params auth
+  sekai-cli query params bank SendEnabled`
+	paramsCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) == 0 {
+			type subspace struct {
+				Subspace string   `json:"subspace"`
+				Keys     []string `json:"keys"`
+			}
+			var subspaces []subspace
+			for _, name := range params.Subspaces() {
+				keys, _ := params.SubspaceKeys(name)
+				subspaces = append(subspaces, subspace{Subspace: name, Keys: keys})
+			}
+			return a.printOutput(ctx, subspaces)
+		}
+
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		paramsMod := params.New(client)
+		result, err := paramsMod.Params(context.Background(), ctx.Args[0], ctx.Args[1:]...)
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	return paramsCmd
+}
+
+// buildQueryTxCommand builds the query tx command.
+func (a *App) buildQueryTxCommand() *cli.Command {
+	txQuery := cli.NewCommand("tx")
+	txQuery.Short = "Query a transaction by hash"
+	txQuery.Long = `Query the result of an included transaction: code, raw log, gas, height and events.`
+	txQuery.Args = []cli.Arg{{Name: "hash", Required: true, Description: "Transaction hash"}}
+	txQuery.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("tx hash required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		txsMod := txs.New(client)
+		result, err := txsMod.Tx(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	return txQuery
+}
+
 // buildQueryUpgradeCommand builds the query upgrade command group.
 func (a *App) buildQueryUpgradeCommand() *cli.Command {
 	upgradeQuery := cli.NewCommand("upgrade")
@@ -1765,12 +3304,24 @@ func (a *App) buildQueryUpgradeCommand() *cli.Command {
 	// current-plan
 	currentPlanCmd := cli.NewCommand("current-plan")
 	currentPlanCmd.Short = "Query current upgrade plan"
+	currentPlanCmd.Long = `Query the current upgrade plan.
+
+With --countdown, show instead how long until the plan triggers: the time
+left until its min upgrade time and the blocks left until its upgrade
+height, counted from the node's latest block. The status reads "passed"
+once both are reached, and "no upgrade plan set" if there is no plan.`
+	currentPlanCmd.Usage = `  sekai-cli query upgrade current-plan
+  sekai-cli query upgrade current-plan --countdown`
+	currentPlanCmd.AddFlag(countdownFlag)
 	currentPlanCmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		upgradeMod := upgrade.New(client)
+		if ctx.GetFlag("countdown") == "true" {
+			return a.printUpgradeCountdown(ctx, client, upgradeMod.CurrentPlan)
+		}
 		result, err := upgradeMod.CurrentPlan(context.Background())
 		if err != nil {
 			return err
@@ -1782,12 +3333,21 @@ func (a *App) buildQueryUpgradeCommand() *cli.Command {
 	// next-plan
 	nextPlanCmd := cli.NewCommand("next-plan")
 	nextPlanCmd.Short = "Query next upgrade plan"
+	nextPlanCmd.Long = `Query the next upgrade plan.
+
+With --countdown, show instead how long until the plan triggers, as for
+current-plan.`
+	nextPlanCmd.Usage = `  sekai-cli query upgrade next-plan --countdown`
+	nextPlanCmd.AddFlag(countdownFlag)
 	nextPlanCmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		upgradeMod := upgrade.New(client)
+		if ctx.GetFlag("countdown") == "true" {
+			return a.printUpgradeCountdown(ctx, client, upgradeMod.NextPlan)
+		}
 		result, err := upgradeMod.NextPlan(context.Background())
 		if err != nil {
 			return err
@@ -1825,20 +3385,60 @@ func (a *App) buildQuerySlashingCommand() *cli.Command {
 	}
 	slashingQuery.AddCommand(signingInfoCmd)
 
+	// jail-status
+	jailStatusCmd := cli.NewCommand("jail-status")
+	jailStatusCmd.Aliases = []string{"validator-jailed-status"}
+	jailStatusCmd.Short = "Show whether a validator is jailed and when it can unjail"
+	jailStatusCmd.Args = []cli.Arg{{Name: "val-address", Required: true}}
+	jailStatusCmd.Usage = `  sekai-cli query customslashing jail-status kiravaloper1...
+  sekai-cli query customslashing jail-status kiravalcons1...
+
+Accepts an operator or a consensus address. The unjail deadline uses the
+network's unjail_max_time from the cache, queried if it is not cached.`
+	jailStatusCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		unjailMaxTime := ""
+		if cachedData := a.loadCache(ctx); cachedData != nil {
+			unjailMaxTime = cachedData.GetUnjailMaxTime()
+		}
+		if unjailMaxTime == "" {
+			if props, err := gov.New(client).NetworkProperties(context.Background()); err == nil {
+				unjailMaxTime = props.UnjailMaxTime
+			}
+		}
+		seconds, _ := strconv.ParseInt(unjailMaxTime, 10, 64)
+
+		slashingMod := slashing.New(client)
+		result, err := slashingMod.JailStatus(context.Background(), ctx.Args[0], time.Duration(seconds)*time.Second)
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	slashingQuery.AddCommand(jailStatusCmd)
+
 	// signing-infos
 	signingInfosCmd := cli.NewCommand("signing-infos")
 	signingInfosCmd.Short = "Query all validator signing infos"
+	cli.AddPaginationFlags(signingInfosCmd)
 	signingInfosCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
 		client, err := a.getClient(ctx)
 		if err != nil {
 			return err
 		}
 		slashingMod := slashing.New(client)
-		result, err := slashingMod.SigningInfos(context.Background())
+		result, err := slashingMod.SigningInfosPage(context.Background(), pagination)
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, result)
+		return a.printPaginated(ctx, a.withMonikers(ctx, client, result))
 	}
 	slashingQuery.AddCommand(signingInfosCmd)
 
@@ -1913,6 +3513,55 @@ func (a *App) buildQuerySlashingCommand() *cli.Command {
 	return slashingQuery
 }
 
+// buildQueryEvidenceCommand builds the query customevidence command group.
+func (a *App) buildQueryEvidenceCommand() *cli.Command {
+	evidenceQuery := cli.NewCommand("customevidence")
+	evidenceQuery.Aliases = []string{"evidence"}
+	evidenceQuery.Short = "Evidence query commands"
+	evidenceQuery.Long = "Query submitted misbehaviour evidence, such as validators signing two blocks at the same height."
+
+	// list
+	listCmd := cli.NewCommand("list")
+	listCmd.Short = "Query all submitted evidence"
+	cli.AddPaginationFlags(listCmd)
+	listCmd.Run = func(ctx *cli.Context) error {
+		pagination, err := getPagination(ctx)
+		if err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		result, err := evidence.New(client).AllEvidencePage(context.Background(), pagination)
+		if err != nil {
+			return err
+		}
+		return a.printPaginated(ctx, result)
+	}
+	evidenceQuery.AddCommand(listCmd)
+
+	// show
+	showCmd := cli.NewCommand("show")
+	showCmd.Short = "Query evidence by hash"
+	showCmd.Args = []cli.Arg{{Name: "hash", Required: true}}
+	showCmd.Usage = `  sekai-cli query customevidence show 8B3E6F0C...`
+	showCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		result, err := evidence.New(client).Evidence(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	evidenceQuery.AddCommand(showCmd)
+
+	return evidenceQuery
+}
+
 // buildQueryDistributorCommand builds the query distributor command group.
 func (a *App) buildQueryDistributorCommand() *cli.Command {
 	distributorQuery := cli.NewCommand("distributor")
@@ -1921,6 +3570,9 @@ func (a *App) buildQueryDistributorCommand() *cli.Command {
 	// fees-treasury
 	feesTreasuryCmd := cli.NewCommand("fees-treasury")
 	feesTreasuryCmd.Short = "Query fees treasury"
+	feesTreasuryCmd.Flags = []cli.Flag{
+		{Name: "human", Bool: true, Usage: "Show amounts using token symbols and decimals, sorted by value"},
+	}
 	feesTreasuryCmd.Run = func(ctx *cli.Context) error {
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -1931,6 +3583,15 @@ func (a *App) buildQueryDistributorCommand() *cli.Command {
 		if err != nil {
 			return err
 		}
+		if ctx.GetFlag("human") == "true" {
+			var treasury struct {
+				Coins []types.DecCoin `json:"coins"`
+			}
+			if err := json.Unmarshal(result, &treasury); err != nil {
+				return fmt.Errorf("failed to parse fees treasury: %w", err)
+			}
+			return a.printOutput(ctx, a.humanizeCoins(client, treasury.Coins))
+		}
 		return a.printOutput(ctx, result)
 	}
 	distributorQuery.AddCommand(feesTreasuryCmd)
@@ -1986,10 +3647,50 @@ func (a *App) buildQueryDistributorCommand() *cli.Command {
 		if err != nil {
 			return err
 		}
-		return a.printOutput(ctx, result)
+		return a.printOutput(ctx, a.withMonikers(ctx, client, result))
 	}
 	distributorQuery.AddCommand(snapshotPerfCmd)
 
+	// performance-all
+	perfAllCmd := cli.NewCommand("performance-all")
+	perfAllCmd.Aliases = []string{"validator-performance-all"}
+	perfAllCmd.Short = "Query snapshot period performance for all active validators"
+	perfAllCmd.AddFlag(cli.Flag{Name: "sort-by", Usage: "Sort by field (" + strings.Join(distributor.PerformanceSortKeys, ", ") + ")"})
+	perfAllCmd.AddFlag(cli.Flag{Name: "order", Usage: "Sort order (asc, desc)", Default: "asc"})
+	perfAllCmd.Usage = `  sekai-cli query distributor performance-all --sort-by performance --order desc
+  sekai-cli query distributor performance-all -o table --columns moniker,performance
+
+Validators missing from the snapshot are listed with an error and sorted last.`
+	perfAllCmd.Run = func(ctx *cli.Context) error {
+		sortBy := ctx.GetFlag("sort-by")
+		order := ctx.GetFlag("order")
+		if order != "asc" && order != "desc" {
+			return fmt.Errorf("invalid order %q (valid: asc, desc)", order)
+		}
+		if sortBy != "" && !slices.Contains(distributor.PerformanceSortKeys, sortBy) {
+			return fmt.Errorf("invalid sort key %q (valid: %s)", sortBy, strings.Join(distributor.PerformanceSortKeys, ", "))
+		}
+		if sortBy == "" && ctx.IsSet("order") {
+			return cli.Usagef("--order requires --sort-by")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		distributorMod := distributor.New(client)
+		perfs, err := distributorMod.AllSnapshotPerformance(context.Background())
+		if err != nil {
+			return err
+		}
+		if sortBy != "" {
+			if err := distributor.SortPerformance(perfs, sortBy, order == "desc"); err != nil {
+				return err
+			}
+		}
+		return a.printOutput(ctx, a.withMonikers(ctx, client, perfs))
+	}
+	distributorQuery.AddCommand(perfAllCmd)
+
 	// year-start-snapshot
 	yearStartCmd := cli.NewCommand("year-start-snapshot")
 	yearStartCmd.Short = "Query year start snapshot"
@@ -2321,9 +4022,60 @@ func (a *App) buildQueryCustodyCommand() *cli.Command {
 	}
 	custodyQuery.AddCommand(limitsCmd)
 
+	// summary
+	summaryCmd := cli.NewCommand("summary")
+	summaryCmd.Short = "Query all custody settings of an address at once"
+	summaryCmd.Args = []cli.Arg{{Name: "address", Required: true}}
+	summaryCmd.Usage = `  sekai-cli query custody summary kira1...
+
+Queries get, custodians, whitelist and limits concurrently. Settings that are
+not configured are shown empty and listed under "unconfigured".`
+	summaryCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		custodyMod := custody.New(client)
+		result, err := custodyMod.Summary(context.Background(), ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	custodyQuery.AddCommand(summaryCmd)
+
 	return custodyQuery
 }
 
+// buildQueryEthereumCommand builds the query ethereum command group.
+func (a *App) buildQueryEthereumCommand() *cli.Command {
+	ethereumQuery := cli.NewCommand("ethereum")
+	ethereumQuery.Short = "Ethereum query commands"
+	ethereumQuery.Long = `Query the state of the ethereum module.
+
+Pending Cosmos <-> Ethereum change requests of an address are queried with
+'sekai-cli query bridge get_cosmos_ethereum' and 'get_ethereum_cosmos'.`
+
+	// state
+	stateCmd := cli.NewCommand("state")
+	stateCmd.Short = "Query the ethereum module state"
+	stateCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		ethereumMod := ethereum.New(client)
+		result, err := ethereumMod.State(context.Background())
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	ethereumQuery.AddCommand(stateCmd)
+
+	return ethereumQuery
+}
+
 // buildQueryBridgeCommand builds the query bridge command group.
 func (a *App) buildQueryBridgeCommand() *cli.Command {
 	bridgeQuery := cli.NewCommand("bridge")
@@ -2426,13 +4178,34 @@ func (a *App) buildQueryLayer2Command() *cli.Command {
 			return err
 		}
 		layer2Mod := layer2.New(client)
-		result, err := layer2Mod.TransferDapps(context.Background())
+		result, err := layer2Mod.TransferDapps(context.Background())
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	layer2Query.AddCommand(transferDappsCmd)
+
+	// dapp
+	dappCmd := cli.NewCommand("dapp")
+	dappCmd.Short = "Query a dapp's registration, execution registrar and transfer configuration"
+	dappCmd.Args = []cli.Arg{{Name: "dapp-name", Required: true}}
+	dappCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("dapp name required")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		layer2Mod := layer2.New(client)
+		result, err := layer2Mod.Dapp(context.Background(), ctx.Args[0])
 		if err != nil {
 			return err
 		}
 		return a.printOutput(ctx, result)
 	}
-	layer2Query.AddCommand(transferDappsCmd)
+	layer2Query.AddCommand(dappCmd)
 
 	return layer2Query
 }
@@ -2529,6 +4302,246 @@ func (a *App) buildQueryRecoveryCommand() *cli.Command {
 	return recoveryQuery
 }
 
+// buildTxRecoveryCommand builds the tx recovery command group.
+func (a *App) buildTxRecoveryCommand() *cli.Command {
+	recoveryTx := cli.NewCommand("recovery")
+	recoveryTx.Short = "Recovery transaction commands"
+	recoveryTx.Long = `Register a recovery secret for an account and rotate the account to a new
+address with its proof, and issue, burn and hold the recovery (RR) tokens of
+validators.`
+
+	txOpts := func(ctx *cli.Context) *recovery.TxOptions {
+		return &recovery.TxOptions{
+			Fees:          ctx.GetFlag("fees"),
+			Gas:           ctx.GetFlag("gas"),
+			Memo:          ctx.GetFlag("memo"),
+			BroadcastMode: ctx.GetFlag("broadcast-mode"),
+		}
+	}
+
+	// register-recovery-secret
+	registerSecretCmd := cli.NewCommand("register-recovery-secret")
+	registerSecretCmd.Short = "Register the recovery secret of the --from account"
+	registerSecretCmd.Long = `Register the recovery secret of the --from account. The challenge is the
+hash of the secret; nonce and proof show the secret is known without
+revealing it. The same proof is given later to rotate-recovery-address.`
+	registerSecretCmd.Usage = `  sekai-cli tx recovery register-recovery-secret <challenge> <nonce> <proof> --from genesis`
+	registerSecretCmd.Args = []cli.Arg{
+		{Name: "challenge", Required: true, Description: "Hash of the recovery secret"},
+		{Name: "nonce", Required: true, Description: "Nonce of the proof"},
+		{Name: "proof", Required: true, Description: "Proof of the recovery secret"},
+	}
+	cli.AddTxFlags(registerSecretCmd)
+	registerSecretCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 3 {
+			return fmt.Errorf("challenge, nonce and proof required")
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).RegisterRecoverySecret(context.Background(), from, ctx.Args[0], ctx.Args[1], ctx.Args[2], txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(registerSecretCmd)
+
+	// rotate-recovery-address
+	rotateAddressCmd := cli.NewCommand("rotate-recovery-address")
+	rotateAddressCmd.Short = "Rotate an account to a recovery address"
+	rotateAddressCmd.Long = `Move an account, with its balances and roles, to the recovery address,
+given the proof of the recovery secret registered for the account. The fee
+is paid by the --from account, which need not be the account rotated.`
+	rotateAddressCmd.Usage = `  sekai-cli tx recovery rotate-recovery-address kira1old... kira1new... <proof> --from kira1new...`
+	rotateAddressCmd.Args = []cli.Arg{
+		{Name: "address", Required: true, Description: "Account to rotate (key name or address)", Complete: cli.CompleteKeys},
+		{Name: "recovery", Required: true, Description: "Recovery address (key name or address)", Complete: cli.CompleteKeys},
+		{Name: "proof", Required: true, Description: "Proof of the recovery secret"},
+	}
+	cli.AddTxFlags(rotateAddressCmd)
+	rotateAddressCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 3 {
+			return fmt.Errorf("address, recovery and proof required")
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		addrs, err := a.resolveAddresses(client, ctx.Args[0], ctx.Args[1])
+		if err != nil {
+			return err
+		}
+		if addrs[0] == addrs[1] {
+			return cli.Usagef("recovery address must differ from the account rotated")
+		}
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", from),
+			fmt.Sprintf("Rotate:   %s", addrs[0]),
+			fmt.Sprintf("To:       %s", addrs[1]),
+		); err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).RotateRecoveryAddress(context.Background(), from, addrs[0], addrs[1], ctx.Args[2], txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(rotateAddressCmd)
+
+	// issue-recovery-tokens
+	issueTokensCmd := cli.NewCommand("issue-recovery-tokens")
+	issueTokensCmd.Short = "Issue the recovery tokens of the --from validator"
+	cli.AddTxFlags(issueTokensCmd)
+	issueTokensCmd.Run = func(ctx *cli.Context) error {
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).IssueRecoveryTokens(context.Background(), from, txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(issueTokensCmd)
+
+	// burn-recovery-tokens
+	burnTokensCmd := cli.NewCommand("burn-recovery-tokens")
+	burnTokensCmd.Short = "Burn recovery tokens for the validator's stake"
+	burnTokensCmd.Usage = `  sekai-cli tx recovery burn-recovery-tokens 1000rr/moniker --from genesis`
+	burnTokensCmd.Args = []cli.Arg{{Name: "rr-coin", Required: true, Description: "Amount of recovery tokens to burn"}}
+	cli.AddTxFlags(burnTokensCmd)
+	burnTokensCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("rr-coin required")
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		if _, err := types.ParseCoin(ctx.Args[0]); err != nil {
+			return cli.Usagef("invalid rr-coin: %v", err)
+		}
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", from),
+			fmt.Sprintf("Burn:     %s", ctx.Args[0]),
+		); err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).BurnRecoveryTokens(context.Background(), from, ctx.Args[0], txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(burnTokensCmd)
+
+	// register-rrtoken-holder
+	registerHolderCmd := cli.NewCommand("register-rrtoken-holder")
+	registerHolderCmd.Short = "Register the --from account as a recovery token holder"
+	cli.AddTxFlags(registerHolderCmd)
+	registerHolderCmd.Run = func(ctx *cli.Context) error {
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).RegisterRRTokenHolder(context.Background(), from, txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(registerHolderCmd)
+
+	// claim-rrholder-rewards
+	claimRewardsCmd := cli.NewCommand("claim-rrholder-rewards")
+	claimRewardsCmd.Short = "Claim the recovery token holder rewards of the --from account"
+	cli.AddTxFlags(claimRewardsCmd)
+	claimRewardsCmd.Run = func(ctx *cli.Context) error {
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).ClaimRRHolderRewards(context.Background(), from, txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(claimRewardsCmd)
+
+	// rotate-validator-by-half-rr-token-holder
+	rotateValidatorCmd := cli.NewCommand("rotate-validator-by-half-rr-token-holder")
+	rotateValidatorCmd.Short = "Rotate a validator as the holder of over half its recovery tokens"
+	rotateValidatorCmd.Args = []cli.Arg{
+		{Name: "address", Required: true, Description: "Validator account to rotate (key name or address)", Complete: cli.CompleteKeys},
+		{Name: "recovery", Required: true, Description: "Recovery address (key name or address)", Complete: cli.CompleteKeys},
+	}
+	cli.AddTxFlags(rotateValidatorCmd)
+	rotateValidatorCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return fmt.Errorf("address and recovery required")
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		addrs, err := a.resolveAddresses(client, ctx.Args[0], ctx.Args[1])
+		if err != nil {
+			return err
+		}
+		if addrs[0] == addrs[1] {
+			return cli.Usagef("recovery address must differ from the validator rotated")
+		}
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", from),
+			fmt.Sprintf("Rotate:   %s", addrs[0]),
+			fmt.Sprintf("To:       %s", addrs[1]),
+		); err != nil {
+			return err
+		}
+		resp, err := recovery.New(client).RotateValidatorByHalfRRTokenHolder(context.Background(), from, addrs[0], addrs[1], txOpts(ctx))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	recoveryTx.AddCommand(rotateValidatorCmd)
+
+	return recoveryTx
+}
+
 // buildTxCommand builds the tx command group.
 func (a *App) buildTxCommand() *cli.Command {
 	txCmd := cli.NewCommand("tx")
@@ -2542,14 +4555,24 @@ func (a *App) buildTxCommand() *cli.Command {
 	sendCmd := cli.NewCommand("send")
 	sendCmd.Short = "Send tokens"
 	sendCmd.Args = []cli.Arg{
-		{Name: "from", Required: true},
-		{Name: "to", Required: true},
-		{Name: "amount", Required: true},
+		{Name: "from", Complete: cli.CompleteKeys},
+		{Name: "to", Description: "Recipient address, key name or address book alias"},
+		{Name: "amount"},
+	}
+	sendCmd.Flags = []cli.Flag{
+		{Name: "interactive", Usage: "Prompt step by step for sender, recipient and amount"},
 	}
 	cli.AddTxFlags(sendCmd)
 	sendCmd.Run = func(ctx *cli.Context) error {
+		if ctx.GetFlag("interactive") == "true" {
+			client, err := a.getClient(ctx)
+			if err != nil {
+				return err
+			}
+			return a.runSendWizard(ctx, client)
+		}
 		if len(ctx.Args) < 3 {
-			return fmt.Errorf("from, to, and amount required")
+			return fmt.Errorf("from, to, and amount required (or use --interactive)")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -2569,7 +4592,20 @@ func (a *App) buildTxCommand() *cli.Command {
 			BroadcastMode: ctx.GetFlag("broadcast-mode"),
 		}
 
-		resp, err := bankMod.Send(context.Background(), ctx.Args[0], ctx.Args[1], coins, opts)
+		to, err := a.resolveAddresses(client, ctx.Args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", ctx.Args[0]),
+			fmt.Sprintf("To:       %s", to[0]),
+			fmt.Sprintf("Amount:   %s", ctx.Args[2]),
+		); err != nil {
+			return err
+		}
+
+		resp, err := bankMod.Send(context.Background(), ctx.Args[0], to[0], coins, opts)
 		if err != nil {
 			return err
 		}
@@ -2580,15 +4616,27 @@ func (a *App) buildTxCommand() *cli.Command {
 	// multi-send
 	multiSendCmd := cli.NewCommand("multi-send")
 	multiSendCmd.Short = "Send tokens to multiple recipients"
-	multiSendCmd.Long = "Send funds from one account to two or more accounts. By default, sends the amount to each address. Using --split, the amount is split equally between addresses."
+	multiSendCmd.Long = `Send funds from one account to two or more accounts. By default, sends the amount to each address. Using --split, the amount is split equally between addresses.
+
+With --recipients-file, each recipient gets its own amount from a CSV file of
+"address,amount" rows (quote amounts with several denoms). Blank lines, lines
+starting with # and an "address,amount" header are skipped.`
+	multiSendCmd.Usage = `  sekai-cli tx bank multi-send genesis kira1... kira1... 100ukex
+  sekai-cli tx bank multi-send genesis --recipients-file recipients.csv --fees 100ukex`
 	multiSendCmd.Args = []cli.Arg{
-		{Name: "from", Required: true, Description: "Sender key name"},
-		{Name: "to...", Required: true, Description: "Recipient addresses (space-separated)"},
-		{Name: "amount", Required: true, Description: "Amount to send (e.g., 100ukex)"},
-	}
-	multiSendCmd.Flags = append(multiSendCmd.Flags, cli.Flag{Name: "split", Usage: "Split amount equally between recipients"})
+		{Name: "from", Required: true, Description: "Sender key name", Complete: cli.CompleteKeys},
+		{Name: "to...", Description: "Recipient addresses, key names or address book aliases (space-separated; omit with --recipients-file)"},
+		{Name: "amount", Description: "Amount to send (e.g., 100ukex; omit with --recipients-file)"},
+	}
+	multiSendCmd.Flags = append(multiSendCmd.Flags,
+		cli.Flag{Name: "split", Usage: "Split amount equally between recipients"},
+		cli.Flag{Name: "recipients-file", Usage: "CSV file of address,amount rows, one per recipient (- for stdin)"},
+	)
 	cli.AddTxFlags(multiSendCmd)
 	multiSendCmd.Run = func(ctx *cli.Context) error {
+		if path := ctx.GetFlag("recipients-file"); path != "" {
+			return a.multiSendFromFile(ctx, path)
+		}
 		if len(ctx.Args) < 3 {
 			return fmt.Errorf("from, at least one recipient, and amount required")
 		}
@@ -2606,6 +4654,10 @@ func (a *App) buildTxCommand() *cli.Command {
 		if len(toAddresses) < 1 {
 			return fmt.Errorf("at least one recipient address required")
 		}
+		toAddresses, err = a.resolveAddresses(client, toAddresses...)
+		if err != nil {
+			return err
+		}
 
 		coins, err := types.ParseCoins(amount)
 		if err != nil {
@@ -2655,7 +4707,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2690,7 +4742,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2698,6 +4750,13 @@ func (a *App) buildTxCommand() *cli.Command {
 			Memo:          ctx.GetFlag("memo"),
 			BroadcastMode: ctx.GetFlag("broadcast-mode"),
 		}
+		if err := a.confirmTx(ctx,
+			fmt.Sprintf("From:     %s", from),
+			fmt.Sprintf("To:       %s (undelegate)", ctx.Args[0]),
+			fmt.Sprintf("Amount:   %s", ctx.Args[1]),
+		); err != nil {
+			return err
+		}
 		resp, err := msMod.Undelegate(context.Background(), from, ctx.Args[0], ctx.Args[1], opts)
 		if err != nil {
 			return err
@@ -2724,7 +4783,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2740,6 +4799,65 @@ func (a *App) buildTxCommand() *cli.Command {
 	}
 	multistakingTx.AddCommand(claimRewardsCmd)
 
+	// claim-all
+	claimAllCmd := cli.NewCommand("claim-all")
+	claimAllCmd.Short = "Claim rewards from every pool the signer has delegated to"
+	claimAllCmd.Long = `Claim the rewards of every staking pool the --from account has delegated
+to. sekaid claims one pool per transaction, so the claims are broadcast one
+after another, signing again with the expected sequence on a mismatch. A
+failed claim does not stop the others; a summary per pool is printed at the
+end and the command fails if any claim failed.`
+	cli.AddTxFlags(claimAllCmd)
+	claimAllCmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		delegator := from
+		if !strings.HasPrefix(from, "kira1") {
+			info, err := keys.New(client).Show(context.Background(), from)
+			if err != nil {
+				return err
+			}
+			delegator = info.Address
+		}
+		opts := &multistaking.TxOptions{
+			Fees:          ctx.GetFlag("fees"),
+			Gas:           ctx.GetFlag("gas"),
+			Memo:          ctx.GetFlag("memo"),
+			BroadcastMode: ctx.GetFlag("broadcast-mode"),
+		}
+		msMod := multistaking.New(client)
+		result, err := msMod.ClaimAll(context.Background(), from, delegator, opts, func(c multistaking.PoolClaim) {
+			if c.Error != "" {
+				ctx.Errorf("Pool %s (%s): failed: %s\n", c.Pool, c.Validator, c.Error)
+			} else {
+				ctx.Errorf("Pool %s (%s): claimed in %s\n", c.Pool, c.Validator, c.TxHash)
+			}
+		})
+		if err != nil && result == nil {
+			return err
+		}
+		if len(result.Claims) == 0 && err == nil {
+			ctx.Errorf("%s has no delegations; nothing to claim\n", delegator)
+		}
+		if printErr := a.printOutput(ctx, result); printErr != nil {
+			return printErr
+		}
+		if err != nil {
+			return err
+		}
+		if result.Failed > 0 {
+			return fmt.Errorf("%d of %d claims failed", result.Failed, len(result.Claims))
+		}
+		return nil
+	}
+	multistakingTx.AddCommand(claimAllCmd)
+
 	// claim-undelegation
 	claimUndelegationCmd := cli.NewCommand("claim-undelegation")
 	claimUndelegationCmd.Short = "Claim a matured undelegation"
@@ -2758,7 +4876,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2786,7 +4904,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2814,7 +4932,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &multistaking.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -2849,7 +4967,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		allDenom := ctx.Args[0] == "true"
 		opts := &multistaking.TxOptions{
@@ -2888,7 +5006,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		msMod := multistaking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		poolOpts := &multistaking.UpsertStakingPoolOpts{
 			Enabled:    ctx.GetFlag("enabled") == "true" || ctx.GetFlag("enabled") == "",
@@ -2937,7 +5055,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		voteOption := 0
 		if _, err := fmt.Sscanf(ctx.Args[1], "%d", &voteOption); err != nil {
@@ -2975,7 +5093,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3009,7 +5127,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3043,7 +5161,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3083,7 +5201,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(0))
 		if err != nil {
@@ -3128,7 +5246,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(0))
 		if err != nil {
@@ -3173,7 +5291,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(0))
 		if err != nil {
@@ -3218,7 +5336,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(0))
 		if err != nil {
@@ -3271,7 +5389,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalCreateRoleOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3312,7 +5430,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalRoleOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3352,7 +5470,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(1))
 		if err != nil {
@@ -3396,7 +5514,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(1))
 		if err != nil {
@@ -3440,7 +5558,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(1))
 		if err != nil {
@@ -3484,7 +5602,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetArg(1))
 		if err != nil {
@@ -3529,7 +5647,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalOtherOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3552,13 +5670,16 @@ func (a *App) buildTxCommand() *cli.Command {
 	// proposal set-proposal-durations
 	propSetProposalDurationsCmd := cli.NewCommand("set-proposal-durations")
 	propSetProposalDurationsCmd.Short = "Create proposal to set proposal durations"
+	propSetProposalDurationsCmd.Usage = `  sekai-cli tx customgov proposal set-proposal-durations --set SetNetworkProperty=48h --set UpsertDataRegistry=600 --title "..." --description "..."
+  sekai-cli tx customgov proposal set-proposal-durations SetNetworkProperty,UpsertDataRegistry 172800,600 --title "..." --description "..."`
 	propSetProposalDurationsCmd.Args = []cli.Arg{
-		{Name: "proposal-types", Required: true},
-		{Name: "durations", Required: true},
+		{Name: "proposal-types", Description: "Comma-separated proposal types (omit when using --set)"},
+		{Name: "durations", Description: "Comma-separated durations in seconds (omit when using --set)"},
 	}
 	propSetProposalDurationsCmd.Flags = []cli.Flag{
 		{Name: "title", Usage: "Proposal title", Required: true},
 		{Name: "description", Usage: "Proposal description", Required: true},
+		{Name: "set", Usage: "Proposal duration as <type>=<duration>, e.g. SetNetworkProperty=48h", Repeatable: true},
 	}
 	cli.AddTxFlags(propSetProposalDurationsCmd)
 	propSetProposalDurationsCmd.Run = func(ctx *cli.Context) error {
@@ -3569,7 +5690,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalOtherOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3581,7 +5702,19 @@ func (a *App) buildTxCommand() *cli.Command {
 			Memo:          ctx.GetFlag("memo"),
 			BroadcastMode: ctx.GetFlag("broadcast-mode"),
 		}
-		resp, err := govMod.ProposalSetProposalDurations(context.Background(), from, ctx.GetArg(0), ctx.GetArg(1), propOpts, txOpts)
+		proposalTypes, durations := ctx.GetArg(0), ctx.GetArg(1)
+		if sets := ctx.GetFlagValues("set"); len(sets) > 0 {
+			if len(ctx.Args) > 0 {
+				return fmt.Errorf("use either --set or positional proposal-types/durations, not both")
+			}
+			proposalTypes, durations, err = parseProposalDurationSets(sets)
+			if err != nil {
+				return err
+			}
+		} else if proposalTypes == "" || durations == "" {
+			return fmt.Errorf("proposal-types and durations required (or use --set <type>=<duration>)")
+		}
+		resp, err := govMod.ProposalSetProposalDurations(context.Background(), from, proposalTypes, durations, propOpts, txOpts)
 		if err != nil {
 			return err
 		}
@@ -3612,7 +5745,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalOtherOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3636,13 +5769,14 @@ func (a *App) buildTxCommand() *cli.Command {
 	propSetExecutionFeesCmd := cli.NewCommand("set-execution-fees")
 	propSetExecutionFeesCmd.Short = "Create proposal to set execution fees"
 	propSetExecutionFeesCmd.Flags = []cli.Flag{
-		{Name: "title", Usage: "Proposal title", Required: true},
-		{Name: "description", Usage: "Proposal description", Required: true},
-		{Name: "tx-types", Usage: "Transaction types (comma-separated)", Required: true},
-		{Name: "execution-fees", Usage: "Execution fees (comma-separated)", Required: true},
-		{Name: "failure-fees", Usage: "Failure fees (comma-separated)", Required: true},
-		{Name: "timeouts", Usage: "Timeouts (comma-separated)", Required: true},
-		{Name: "default-params", Usage: "Default parameters (comma-separated)", Required: true},
+		{Name: "title", Usage: "Proposal title"},
+		{Name: "description", Usage: "Proposal description"},
+		{Name: "tx-types", Usage: "Transaction types (comma-separated)"},
+		{Name: "execution-fees", Usage: "Execution fees (comma-separated)"},
+		{Name: "failure-fees", Usage: "Failure fees (comma-separated)"},
+		{Name: "timeouts", Usage: "Timeouts (comma-separated)"},
+		{Name: "default-params", Usage: "Default parameters (comma-separated)"},
+		fromFileFlag,
 	}
 	cli.AddTxFlags(propSetExecutionFeesCmd)
 	propSetExecutionFeesCmd.Run = func(ctx *cli.Context) error {
@@ -3653,16 +5787,11 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
-		propOpts := &gov.ProposalSetExecutionFeesOpts{
-			Title:         ctx.GetFlag("title"),
-			Description:   ctx.GetFlag("description"),
-			TxTypes:       ctx.GetFlag("tx-types"),
-			ExecutionFees: ctx.GetFlag("execution-fees"),
-			FailureFees:   ctx.GetFlag("failure-fees"),
-			Timeouts:      ctx.GetFlag("timeouts"),
-			DefaultParams: ctx.GetFlag("default-params"),
+		propOpts := &gov.ProposalSetExecutionFeesOpts{}
+		if err := loadOpts(ctx, propOpts, "title", "description", "tx-types", "execution-fees", "failure-fees", "timeouts", "default-params"); err != nil {
+			return err
 		}
 		txOpts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3697,7 +5826,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalOtherOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3733,7 +5862,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &gov.ProposalOtherOpts{
 			Title:       ctx.GetFlag("title"),
@@ -3755,6 +5884,95 @@ func (a *App) buildTxCommand() *cli.Command {
 
 	govTx.AddCommand(proposalTx)
 
+	// submit-proposal
+	submitProposalCmd := cli.NewCommand("submit-proposal")
+	submitProposalCmd.Short = "Submit a proposal of any type from a JSON file"
+	submitProposalCmd.Long = `Submit a proposal of any type, including types without a dedicated command,
+from a JSON file with a title, an optional description and the proposal
+content with its type URL:
+
+  {
+    "title": "Raise min fee",
+    "description": "...",
+    "content": {
+      "@type": "/kira.gov.SetNetworkPropertyProposal",
+      "network_property": "MIN_TX_FEE",
+      "value": {"value": "200"}
+    }
+  }
+
+The proposal is signed with --from, broadcast and waited for (up to
+--wait-timeout), and the new proposal ID is printed. Signing needs the
+keyring in the container, so --rest is not supported.`
+	submitProposalCmd.Flags = []cli.Flag{
+		{Name: "file", Usage: "Proposal JSON file (- for stdin)", Required: true},
+	}
+	cli.AddTxFlags(submitProposalCmd)
+	submitProposalCmd.Run = func(ctx *cli.Context) error {
+		data, err := readProposalFile(ctx, ctx.GetFlag("file"))
+		if err != nil {
+			return err
+		}
+		proposal, err := gov.ParseProposalFile(data)
+		if err != nil {
+			return err
+		}
+
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		govMod := gov.New(client)
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		fees, err := a.txFees(ctx, a.loadCache(ctx))
+		if err != nil {
+			return err
+		}
+		opts := &gov.TxOptions{
+			Fees:          fees,
+			Gas:           ctx.GetFlag("gas"),
+			Memo:          ctx.GetFlag("memo"),
+			BroadcastMode: ctx.GetFlag("broadcast-mode"),
+		}
+		resp, err := govMod.SubmitProposal(context.Background(), from, proposal, opts)
+		if err != nil {
+			return err
+		}
+		if a.capture != nil {
+			return a.printOutput(ctx, resp)
+		}
+
+		// The proposal ID is only known once the transaction is included
+		timeout, err := parseDuration(ctx.GetFlag("wait-timeout"))
+		if err != nil || timeout <= 0 {
+			return cli.Usagef("invalid --wait-timeout: %s", ctx.GetFlag("wait-timeout"))
+		}
+		ctx.Errorf("Waiting for tx %s to be included (timeout %s)...\n", resp.TxHash, timeout)
+		result, err := txs.New(client).Wait(context.Background(), resp.TxHash, &txs.WaitOptions{Timeout: timeout})
+		if errors.Is(err, txs.ErrNotConfirmed) {
+			if printErr := a.printTxResponse(ctx, resp); printErr != nil {
+				return printErr
+			}
+			return fmt.Errorf("transaction %s not yet confirmed after %s; find the proposal ID with 'sekai-cli query tx %s'", resp.TxHash, timeout, resp.TxHash)
+		}
+		if err != nil {
+			return err
+		}
+		if !result.Success() {
+			return &txFailedError{hash: result.TxHash, code: result.Code, rawLog: result.RawLog}
+		}
+		return a.printOutput(ctx, &submittedProposal{
+			TxHash:      result.TxHash,
+			Height:      result.Height,
+			ProposalID:  gov.ProposalIDFromEvents(result.Events),
+			ContentType: proposal.ContentType(),
+		})
+	}
+	govTx.AddCommand(submitProposalCmd)
+
 	// councilor subcommand
 	councilorTx := cli.NewCommand("councilor")
 	councilorTx.Short = "Councilor transaction commands"
@@ -3774,7 +5992,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3802,7 +6020,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3830,7 +6048,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3858,7 +6076,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -3895,7 +6113,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		permission := 0
 		if _, err := fmt.Sscanf(ctx.GetFlag("permission"), "%d", &permission); err != nil {
@@ -3931,7 +6149,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		permission := 0
 		if _, err := fmt.Sscanf(ctx.GetFlag("permission"), "%d", &permission); err != nil {
@@ -3967,7 +6185,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetFlag("permission"))
 		if err != nil {
@@ -4003,7 +6221,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		perm, err := strconv.Atoi(ctx.GetFlag("permission"))
 		if err != nil {
@@ -4045,7 +6263,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4077,7 +6295,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleID := 0
 		if _, err := fmt.Sscanf(ctx.GetFlag("role"), "%d", &roleID); err != nil {
@@ -4113,7 +6331,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleID := 0
 		if _, err := fmt.Sscanf(ctx.GetFlag("role"), "%d", &roleID); err != nil {
@@ -4149,7 +6367,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleSID := ctx.GetArg(0)
 		perm, err := strconv.Atoi(ctx.GetArg(1))
@@ -4186,7 +6404,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleSID := ctx.GetArg(0)
 		perm, err := strconv.Atoi(ctx.GetArg(1))
@@ -4223,7 +6441,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleSID := ctx.GetArg(0)
 		perm, err := strconv.Atoi(ctx.GetArg(1))
@@ -4260,7 +6478,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		roleSID := ctx.GetArg(0)
 		perm, err := strconv.Atoi(ctx.GetArg(1))
@@ -4310,7 +6528,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		pollOpts := &gov.PollCreateOpts{
 			Title:       ctx.GetFlag("title"),
@@ -4357,7 +6575,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4390,7 +6608,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		infosJSON := ctx.GetFlag("infos-json")
 		txOpts := &gov.TxOptions{
@@ -4422,7 +6640,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		keys := ctx.GetFlag("keys")
 		txOpts := &gov.TxOptions{
@@ -4456,7 +6674,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		txOpts := &gov.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4490,7 +6708,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		requestID := ctx.GetArg(0)
 		approve := ctx.GetFlag("approve") != "false"
@@ -4523,7 +6741,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		requestID := ctx.GetArg(0)
 		txOpts := &gov.TxOptions{
@@ -4558,7 +6776,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		properties := make(map[string]string)
 		if v := ctx.GetFlag("min_tx_fee"); v != "" {
@@ -4606,7 +6824,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		govMod := gov.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		var executionFee, failureFee, timeout, defaultParams uint64
 		fmt.Sscanf(ctx.GetFlag("execution_fee"), "%d", &executionFee)
@@ -4649,7 +6867,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		stakingMod := staking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		seatOpts := &staking.ClaimValidatorSeatOpts{
 			Moniker: ctx.GetFlag("moniker"),
@@ -4692,7 +6910,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		stakingMod := staking.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &staking.ProposalUnjailValidatorOpts{
 			Title:       ctx.GetFlag("title"),
@@ -4728,7 +6946,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	claimPoolCmd.Run = func(ctx *cli.Context) error {
 		poolName := ctx.GetFlag("name")
 		if poolName == "" {
-			return fmt.Errorf("--name flag required")
+			return cli.Usagef("--name flag required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -4737,7 +6955,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &spending.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4764,11 +6982,11 @@ func (a *App) buildTxCommand() *cli.Command {
 	depositPoolCmd.Run = func(ctx *cli.Context) error {
 		poolName := ctx.GetFlag("name")
 		if poolName == "" {
-			return fmt.Errorf("--name flag required")
+			return cli.Usagef("--name flag required")
 		}
 		amount := ctx.GetFlag("amount")
 		if amount == "" {
-			return fmt.Errorf("--amount flag required")
+			return cli.Usagef("--amount flag required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -4777,7 +6995,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &spending.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4816,7 +7034,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	createSpendingPoolCmd.Run = func(ctx *cli.Context) error {
 		name := ctx.GetFlag("name")
 		if name == "" {
-			return fmt.Errorf("--name flag required")
+			return cli.Usagef("--name flag required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -4825,7 +7043,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		var claimStart, claimEnd, claimExpiry, votePeriod, voteEnactment, dynamicRatePeriod uint64
 		if v := ctx.GetFlag("claim-start"); v != "" {
@@ -4884,7 +7102,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	registerBeneficiaryCmd.Run = func(ctx *cli.Context) error {
 		name := ctx.GetFlag("name")
 		if name == "" {
-			return fmt.Errorf("--name flag required")
+			return cli.Usagef("--name flag required")
 		}
 		client, err := a.getClient(ctx)
 		if err != nil {
@@ -4893,7 +7111,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		txOpts := &spending.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -4926,7 +7144,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &spending.ProposalSpendingPoolDistributionOpts{
 			Name:        ctx.GetFlag("name"),
@@ -4966,7 +7184,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &spending.ProposalSpendingPoolWithdrawOpts{
 			Name:                ctx.GetFlag("name"),
@@ -4993,9 +7211,9 @@ func (a *App) buildTxCommand() *cli.Command {
 	proposalUpdatePoolCmd := cli.NewCommand("proposal-update-spending-pool")
 	proposalUpdatePoolCmd.Short = "Create a proposal to update spending pool"
 	proposalUpdatePoolCmd.Flags = []cli.Flag{
-		{Name: "name", Usage: "Pool name", Required: true},
-		{Name: "title", Usage: "Proposal title", Required: true},
-		{Name: "description", Usage: "Proposal description", Required: true},
+		{Name: "name", Usage: "Pool name"},
+		{Name: "title", Usage: "Proposal title"},
+		{Name: "description", Usage: "Proposal description"},
 		{Name: "claim-start", Usage: "Claim start timestamp"},
 		{Name: "claim-end", Usage: "Claim end timestamp"},
 		{Name: "rates", Usage: "Reward rates"},
@@ -5010,6 +7228,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		{Name: "beneficiary-role-weights", Usage: "Beneficiary role weights"},
 		{Name: "dynamic-rate", Usage: "Enable dynamic rate"},
 		{Name: "dynamic-rate-period", Usage: "Dynamic rate period"},
+		fromFileFlag,
 	}
 	cli.AddTxFlags(proposalUpdatePoolCmd)
 	proposalUpdatePoolCmd.Run = func(ctx *cli.Context) error {
@@ -5020,31 +7239,11 @@ func (a *App) buildTxCommand() *cli.Command {
 		spendingMod := spending.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
-		}
-		claimStart, _ := strconv.ParseInt(ctx.GetFlag("claim-start"), 10, 32)
-		claimEnd, _ := strconv.ParseInt(ctx.GetFlag("claim-end"), 10, 32)
-		voteQuorum, _ := strconv.ParseInt(ctx.GetFlag("vote-quorum"), 10, 32)
-		votePeriod, _ := strconv.ParseInt(ctx.GetFlag("vote-period"), 10, 32)
-		voteEnactment, _ := strconv.ParseInt(ctx.GetFlag("vote-enactment"), 10, 32)
-		propOpts := &spending.ProposalUpdateSpendingPoolOpts{
-			Name:                      ctx.GetFlag("name"),
-			Title:                     ctx.GetFlag("title"),
-			Description:               ctx.GetFlag("description"),
-			ClaimStart:                int32(claimStart),
-			ClaimEnd:                  int32(claimEnd),
-			Rates:                     ctx.GetFlag("rates"),
-			VoteQuorum:                int32(voteQuorum),
-			VotePeriod:                int32(votePeriod),
-			VoteEnactment:             int32(voteEnactment),
-			OwnerAccounts:             ctx.GetFlag("owner-accounts"),
-			OwnerRoles:                ctx.GetFlag("owner-roles"),
-			BeneficiaryAccounts:       ctx.GetFlag("beneficiary-accounts"),
-			BeneficiaryRoles:          ctx.GetFlag("beneficiary-roles"),
-			BeneficiaryAccountWeights: ctx.GetFlag("beneficiary-account-weights"),
-			BeneficiaryRoleWeights:    ctx.GetFlag("beneficiary-role-weights"),
-			DynamicRate:               ctx.GetFlag("dynamic-rate") == "true",
-			DynamicRatePeriod:         ctx.GetFlag("dynamic-rate-period"),
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		propOpts := &spending.ProposalUpdateSpendingPoolOpts{}
+		if err := loadOpts(ctx, propOpts, "name", "title", "description"); err != nil {
+			return err
 		}
 		txOpts := &spending.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5085,7 +7284,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5120,7 +7319,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5156,7 +7355,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5190,7 +7389,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5225,7 +7424,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		disabled := ctx.Args[1] == "true"
 		opts := &basket.TxOptions{
@@ -5261,7 +7460,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		disabled := ctx.Args[1] == "true"
 		opts := &basket.TxOptions{
@@ -5297,7 +7496,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		disabled := ctx.Args[1] == "true"
 		opts := &basket.TxOptions{
@@ -5322,8 +7521,8 @@ func (a *App) buildTxCommand() *cli.Command {
 		{Name: "basket-description", Usage: "Basket description"},
 		{Name: "basket-tokens", Usage: "Comma-separated list of tokens in basket"},
 		{Name: "tokens-cap", Usage: "Tokens cap"},
-		{Name: "title", Usage: "Proposal title", Required: true},
-		{Name: "description", Usage: "Proposal description", Required: true},
+		{Name: "title", Usage: "Proposal title"},
+		{Name: "description", Usage: "Proposal description"},
 		{Name: "mints-min", Usage: "Minimum mints"},
 		{Name: "mints-max", Usage: "Maximum mints"},
 		{Name: "mints-disabled", Usage: "Disable mints"},
@@ -5336,6 +7535,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		{Name: "swap-fee", Usage: "Swap fee"},
 		{Name: "slippage-fee-min", Usage: "Minimum slippage fee"},
 		{Name: "limits-period", Usage: "Limits period"},
+		fromFileFlag,
 	}
 	cli.AddTxFlags(proposalCreateBasketCmd)
 	proposalCreateBasketCmd.Run = func(ctx *cli.Context) error {
@@ -5346,31 +7546,11 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
-		}
-		propOpts := &basket.ProposalCreateBasketOpts{
-			BasketSuffix:      ctx.GetFlag("basket-suffix"),
-			BasketDescription: ctx.GetFlag("basket-description"),
-			BasketTokens:      ctx.GetFlag("basket-tokens"),
-			TokensCap:         ctx.GetFlag("tokens-cap"),
-			Title:             ctx.GetFlag("title"),
-			Description:       ctx.GetFlag("description"),
-			MintsMin:          ctx.GetFlag("mints-min"),
-			MintsMax:          ctx.GetFlag("mints-max"),
-			MintsDisabled:     ctx.GetFlag("mints-disabled") == "true",
-			BurnsMin:          ctx.GetFlag("burns-min"),
-			BurnsMax:          ctx.GetFlag("burns-max"),
-			BurnsDisabled:     ctx.GetFlag("burns-disabled") == "true",
-			SwapsMin:          ctx.GetFlag("swaps-min"),
-			SwapsMax:          ctx.GetFlag("swaps-max"),
-			SwapsDisabled:     ctx.GetFlag("swaps-disabled") == "true",
-			SwapFee:           ctx.GetFlag("swap-fee"),
-			SlippageFeeMin:    ctx.GetFlag("slippage-fee-min"),
-		}
-		if ctx.GetFlag("limits-period") != "" {
-			var limitsPeriod uint64
-			fmt.Sscanf(ctx.GetFlag("limits-period"), "%d", &limitsPeriod)
-			propOpts.LimitsPeriod = limitsPeriod
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		propOpts := &basket.ProposalCreateBasketOpts{}
+		if err := loadOpts(ctx, propOpts, "title", "description"); err != nil {
+			return err
 		}
 		txOpts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5390,13 +7570,13 @@ func (a *App) buildTxCommand() *cli.Command {
 	proposalEditBasketCmd := cli.NewCommand("proposal-edit-basket")
 	proposalEditBasketCmd.Short = "Create proposal to edit an existing basket"
 	proposalEditBasketCmd.Flags = []cli.Flag{
-		{Name: "basket-id", Usage: "Basket ID to edit", Required: true},
+		{Name: "basket-id", Usage: "Basket ID to edit"},
 		{Name: "basket-suffix", Usage: "Basket suffix"},
 		{Name: "basket-description", Usage: "Basket description"},
 		{Name: "basket-tokens", Usage: "Comma-separated list of tokens in basket"},
 		{Name: "tokens-cap", Usage: "Tokens cap"},
-		{Name: "title", Usage: "Proposal title", Required: true},
-		{Name: "description", Usage: "Proposal description", Required: true},
+		{Name: "title", Usage: "Proposal title"},
+		{Name: "description", Usage: "Proposal description"},
 		{Name: "mints-min", Usage: "Minimum mints"},
 		{Name: "mints-max", Usage: "Maximum mints"},
 		{Name: "mints-disabled", Usage: "Disable mints"},
@@ -5409,6 +7589,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		{Name: "swap-fee", Usage: "Swap fee"},
 		{Name: "slippage-fee-min", Usage: "Minimum slippage fee"},
 		{Name: "limits-period", Usage: "Limits period"},
+		fromFileFlag,
 	}
 	cli.AddTxFlags(proposalEditBasketCmd)
 	proposalEditBasketCmd.Run = func(ctx *cli.Context) error {
@@ -5419,34 +7600,11 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
-		}
-		var basketID uint64
-		fmt.Sscanf(ctx.GetFlag("basket-id"), "%d", &basketID)
-		propOpts := &basket.ProposalEditBasketOpts{
-			BasketID:          basketID,
-			BasketSuffix:      ctx.GetFlag("basket-suffix"),
-			BasketDescription: ctx.GetFlag("basket-description"),
-			BasketTokens:      ctx.GetFlag("basket-tokens"),
-			TokensCap:         ctx.GetFlag("tokens-cap"),
-			Title:             ctx.GetFlag("title"),
-			Description:       ctx.GetFlag("description"),
-			MintsMin:          ctx.GetFlag("mints-min"),
-			MintsMax:          ctx.GetFlag("mints-max"),
-			MintsDisabled:     ctx.GetFlag("mints-disabled") == "true",
-			BurnsMin:          ctx.GetFlag("burns-min"),
-			BurnsMax:          ctx.GetFlag("burns-max"),
-			BurnsDisabled:     ctx.GetFlag("burns-disabled") == "true",
-			SwapsMin:          ctx.GetFlag("swaps-min"),
-			SwapsMax:          ctx.GetFlag("swaps-max"),
-			SwapsDisabled:     ctx.GetFlag("swaps-disabled") == "true",
-			SwapFee:           ctx.GetFlag("swap-fee"),
-			SlippageFeeMin:    ctx.GetFlag("slippage-fee-min"),
-		}
-		if ctx.GetFlag("limits-period") != "" {
-			var limitsPeriod uint64
-			fmt.Sscanf(ctx.GetFlag("limits-period"), "%d", &limitsPeriod)
-			propOpts.LimitsPeriod = limitsPeriod
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		propOpts := &basket.ProposalEditBasketOpts{}
+		if err := loadOpts(ctx, propOpts, "basket-id", "title", "description"); err != nil {
+			return err
 		}
 		txOpts := &basket.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5485,7 +7643,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		basketMod := basket.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &basket.ProposalWithdrawSurplusOpts{
 			Title:       ctx.GetFlag("title"),
@@ -5527,7 +7685,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &collectives.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5559,7 +7717,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &collectives.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5591,7 +7749,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &collectives.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5625,7 +7783,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		var locking uint64
 		if ctx.GetFlag("locking") != "" {
@@ -5665,7 +7823,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &collectives.ProposalCollectiveUpdateOpts{
 			Title:                 ctx.GetFlag("title"),
@@ -5705,7 +7863,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &collectives.ProposalRemoveCollectiveOpts{
 			Title:          ctx.GetFlag("title"),
@@ -5745,7 +7903,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		collectivesMod := collectives.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		propOpts := &collectives.ProposalSendDonationOpts{
 			Title:          ctx.GetFlag("title"),
@@ -5778,7 +7936,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	upsertRateCmd := cli.NewCommand("upsert-rate")
 	upsertRateCmd.Short = "Upsert token rate"
 	upsertRateCmd.Flags = []cli.Flag{
-		{Name: "denom", Usage: "Token denomination", Required: true},
+		{Name: "denom", Usage: "Token denomination", Required: true, Complete: cli.CompleteDenoms},
 		{Name: "fee-rate", Usage: "Fee rate"},
 		{Name: "fee-payments", Usage: "Allow fee payments"},
 		{Name: "decimals", Usage: "Token decimals"},
@@ -5793,7 +7951,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		tokensMod := tokens.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &tokens.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5824,7 +7982,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	propUpsertRateCmd := cli.NewCommand("proposal-upsert-rate")
 	propUpsertRateCmd.Short = "Create proposal to upsert token rate"
 	propUpsertRateCmd.Flags = []cli.Flag{
-		{Name: "denom", Usage: "Token denomination", Required: true},
+		{Name: "denom", Usage: "Token denomination", Complete: cli.CompleteDenoms},
 		{Name: "decimals", Usage: "Max decimal places"},
 		{Name: "fee-rate", Usage: "Fee rate (max decimal 9, max value 10^10)"},
 		{Name: "fee-payments", Usage: "Use registry as fee payment (bool)"},
@@ -5846,8 +8004,9 @@ func (a *App) buildTxCommand() *cli.Command {
 		{Name: "token-rate", Usage: "Token rate"},
 		{Name: "token-type", Usage: "Token type"},
 		{Name: "minting-fee", Usage: "Minting fee"},
-		{Name: "title", Usage: "Proposal title", Required: true},
-		{Name: "description", Usage: "Proposal description", Required: true},
+		{Name: "title", Usage: "Proposal title"},
+		{Name: "description", Usage: "Proposal description"},
+		fromFileFlag,
 	}
 	cli.AddTxFlags(propUpsertRateCmd)
 	propUpsertRateCmd.Run = func(ctx *cli.Context) error {
@@ -5858,7 +8017,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		tokensMod := tokens.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		txOpts := &tokens.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5866,35 +8025,9 @@ func (a *App) buildTxCommand() *cli.Command {
 			Memo:          ctx.GetFlag("memo"),
 			BroadcastMode: ctx.GetFlag("broadcast-mode"),
 		}
-		propOpts := &tokens.ProposalUpsertRateOpts{
-			Denom:             ctx.GetFlag("denom"),
-			FeeRate:           ctx.GetFlag("fee-rate"),
-			FeePayments:       ctx.GetFlag("fee-payments") == "true",
-			Name:              ctx.GetFlag("name"),
-			Symbol:            ctx.GetFlag("symbol"),
-			Icon:              ctx.GetFlag("icon"),
-			Website:           ctx.GetFlag("website"),
-			Social:            ctx.GetFlag("social"),
-			StakeToken:        ctx.GetFlag("stake-token") == "true",
-			StakeCap:          ctx.GetFlag("stake-cap"),
-			StakeMin:          ctx.GetFlag("stake-min"),
-			Supply:            ctx.GetFlag("supply"),
-			SupplyCap:         ctx.GetFlag("supply-cap"),
-			NftHash:           ctx.GetFlag("nft-hash"),
-			NftMetadata:       ctx.GetFlag("nft-metadata"),
-			Owner:             ctx.GetFlag("owner"),
-			OwnerEditDisabled: ctx.GetFlag("owner-edit-disabled") == "true",
-			Invalidated:       ctx.GetFlag("invalidated") == "true",
-			TokenRate:         ctx.GetFlag("token-rate"),
-			TokenType:         ctx.GetFlag("token-type"),
-			MintingFee:        ctx.GetFlag("minting-fee"),
-			Title:             ctx.GetFlag("title"),
-			Description:       ctx.GetFlag("description"),
-		}
-		if decimals := ctx.GetFlag("decimals"); decimals != "" {
-			var d uint32
-			fmt.Sscanf(decimals, "%d", &d)
-			propOpts.Decimals = d
+		propOpts := &tokens.ProposalUpsertRateOpts{}
+		if err := loadOpts(ctx, propOpts, "denom", "title", "description"); err != nil {
+			return err
 		}
 		resp, err := tokensMod.ProposalUpsertRate(context.Background(), from, propOpts, txOpts)
 		if err != nil {
@@ -5923,7 +8056,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		tokensMod := tokens.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		txOpts := &tokens.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -5984,7 +8117,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		ubiMod := ubi.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &ubi.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6034,7 +8167,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		ubiMod := ubi.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &ubi.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6082,7 +8215,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		upgradeMod := upgrade.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &upgrade.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6127,7 +8260,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		upgradeMod := upgrade.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &upgrade.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6168,7 +8301,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		bridgeMod := bridge.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &bridge.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6201,7 +8334,7 @@ func (a *App) buildTxCommand() *cli.Command {
 		bridgeMod := bridge.New(client)
 		from := a.getFromFlag(ctx)
 		if from == "" {
-			return fmt.Errorf("--from flag required (run 'sekai-cli init' to set default)")
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
 		}
 		opts := &bridge.TxOptions{
 			Fees:          ctx.GetFlag("fees"),
@@ -6217,6 +8350,7 @@ func (a *App) buildTxCommand() *cli.Command {
 	}
 	bridgeTx.AddCommand(changeEthCosmosCmd)
 	txCmd.AddCommand(bridgeTx)
+	txCmd.AddCommand(a.buildTxRecoveryCommand())
 
 	return txCmd
 }
@@ -6225,10 +8359,24 @@ func (a *App) buildTxCommand() *cli.Command {
 func (a *App) buildVersionCommand() *cli.Command {
 	cmd := cli.NewCommand("version")
 	cmd.Short = "Print version information"
+	cmd.Long = `Print the sekai-cli version.
+
+With --check, also query the sekaid version of the node and compare it with
+the range of sekaid versions this CLI is written against. A version outside
+the range is reported with a warning: commands may pass flags or expect
+output that the node's sekaid does not have.`
+	cmd.AddFlag(cli.Flag{Name: "check", Usage: "Query the node's sekaid version and check that it is supported"})
 
 	cmd.Run = func(ctx *cli.Context) error {
-		ctx.Printf("sekai-cli version %s\n", sdk.Version())
-		return nil
+		if ctx.GetFlag("check") != "true" {
+			ctx.Printf("sekai-cli version %s\n", sdk.Version())
+			return nil
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		return a.checkNodeVersion(ctx, client)
 	}
 
 	return cmd
@@ -6291,30 +8439,37 @@ func (a *App) buildInitCommand() *cli.Command {
 querying network properties, and caching keys. This eliminates the need to
 specify --chain-id, --fees, and --from flags for every command.
 
+The cache holds one entry per chain ID, so running init against another
+container adds its network without replacing the others. The initialized
+network becomes the active one; see 'sekai-cli cache list'.
+
+Running init against a network that is already cached is safe: network
+properties and keys are refreshed and merged into the cache, keeping the
+default key, and the changes are printed. Use --force to start over.
+
 Run 'sekai-cli sync' to refresh the cache after network changes.`
 
 	cmd.AddFlag(cli.Flag{Name: "container", Usage: "Container name (auto-detects if not provided)"})
 	cmd.AddFlag(cli.Flag{Name: "default-key", Usage: "Set default signing key"})
-	cmd.AddFlag(cli.Flag{Name: "force", Short: "f", Usage: "Overwrite existing cache"})
+	cmd.AddFlag(cli.Flag{Name: "force", Short: "f", Usage: "Overwrite the cached network instead of merging into it"})
+	cmd.AddFlag(cli.Flag{Name: "step-timeout", Usage: "Maximum time for each network query", Default: defaultStepTimeout.String()})
 
 	cmd.Run = func(ctx *cli.Context) error {
-		// Check if cache already exists
-		if cache.Exists() && ctx.GetFlag("force") == "" {
-			ctx.Printf("Cache already exists at %s\n", cache.DefaultCachePath())
-			ctx.Printf("Use --force to overwrite or 'sekai-cli sync' to refresh.\n")
-			return nil
+		p, stop, err := newProgress(ctx)
+		if err != nil {
+			return err
 		}
+		defer stop()
 
 		// Get container
-		container := ctx.GetFlag("container")
-		if container == "" {
-			container = a.config.Container
-		}
+		profile := a.activeProfile()
+		container := getStringOrDefault(a.setting(ctx, "container", profile.Container), a.config.Container)
 
 		// Try to auto-detect if not specified
+		runtime := a.getRuntime(ctx)
 		if container == "" || container == "sekai-node" {
 			ctx.Printf("Detecting container...\n")
-			detected, err := docker.FindSekaiContainer()
+			detected, err := runtime.FindSekaiContainer()
 			if err != nil {
 				return fmt.Errorf("no container specified and auto-detection failed: %w", err)
 			}
@@ -6323,20 +8478,22 @@ Run 'sekai-cli sync' to refresh the cache after network changes.`
 		}
 
 		// Verify container is running
-		if !docker.IsContainerRunning(container) {
+		if !runtime.IsContainerRunning(container) {
 			return fmt.Errorf("container '%s' is not running", container)
 		}
 
 		// Create docker client
-		home := a.config.Home
+		home := getStringOrDefault(profile.Home, a.config.Home)
 		if home == "" || home == "/.sekaid" {
 			home = "/sekai" // Default for sekai containers
 		}
-		keyringBackend := a.config.KeyringBackend
+		keyringBackend := getStringOrDefault(profile.KeyringBackend, a.config.KeyringBackend)
 		if keyringBackend == "" {
 			keyringBackend = "test"
 		}
 		client, err := docker.NewClient(container,
+			docker.WithRuntime(runtime.Name),
+			docker.WithHost(runtime.Host),
 			docker.WithKeyringBackend(keyringBackend),
 			docker.WithHome(home),
 		)
@@ -6345,33 +8502,84 @@ Run 'sekai-cli sync' to refresh the cache after network changes.`
 		}
 		defer client.Close()
 
-		// Query status for chain ID
-		ctx.Printf("Querying node status...\n")
-		statusResp, err := client.Status(context.Background())
-		if err != nil {
-			return fmt.Errorf("failed to query status: %w", err)
+		// Query status for chain ID. Nothing is saved until every step
+		// has succeeded, so a failed or interrupted init leaves the cache
+		// as it was.
+		var statusResp *sdk.StatusResponse
+		if err := p.step("Querying node status", func(stepCtx context.Context) (err error) {
+			statusResp, err = client.Status(stepCtx)
+			if err != nil {
+				return fmt.Errorf("failed to query status: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// A network that is already cached is merged into, unless forced
+		chainID := statusResp.NodeInfo.Network
+		var existing *cache.Cache
+		if cache.HasNetwork(chainID) && ctx.GetFlag("force") == "" {
+			existing, err = cache.LoadNetwork(chainID)
+			if err != nil {
+				return err
+			}
+			ctx.Printf("Network %s is already cached, merging (use --force to overwrite)...\n", chainID)
 		}
 
 		// Query network properties
-		ctx.Printf("Querying network properties...\n")
-		govMod := gov.New(client)
-		props, err := govMod.NetworkProperties(context.Background())
-		if err != nil {
-			return fmt.Errorf("failed to query network properties: %w", err)
+		var props *gov.NetworkProperties
+		var maxMemo string
+		if err := p.step("Querying network properties", func(stepCtx context.Context) (err error) {
+			props, err = gov.New(client).NetworkProperties(stepCtx)
+			if err != nil {
+				return fmt.Errorf("failed to query network properties: %w", err)
+			}
+			maxMemo = cachedMaxMemo(stepCtx, client)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// Query token rates
+		var denoms []string
+		var feeRates map[string]string
+		if err := p.step("Querying token rates", func(stepCtx context.Context) error {
+			denoms, feeRates = cachedDenoms(stepCtx, client)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		// Query validator monikers
+		var monikers map[string]string
+		if err := p.step("Querying validator monikers", func(stepCtx context.Context) error {
+			monikers = cachedMonikers(stepCtx, client)
+			return nil
+		}); err != nil {
+			return err
 		}
 
 		// Query keys
-		ctx.Printf("Querying keys...\n")
-		keysList, err := client.Keys().List(context.Background())
-		if err != nil {
-			return fmt.Errorf("failed to list keys: %w", err)
+		var keysList []sdk.KeyInfo
+		if err := p.step("Querying keys", func(stepCtx context.Context) (err error) {
+			keysList, err = client.Keys().List(stepCtx)
+			if err != nil {
+				return fmt.Errorf("failed to list keys: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return err
 		}
 
 		// Build cache
 		c := cache.New()
 		c.Container = container
+		c.Denoms = denoms
+		c.FeeRates = feeRates
+		c.Monikers = monikers
 		c.Network = cache.NetworkCache{
-			ChainID:                  statusResp.NodeInfo.Network,
+			ChainID:                  chainID,
 			Moniker:                  statusResp.NodeInfo.Moniker,
 			MinTxFee:                 props.MinTxFee,
 			MaxTxFee:                 props.MaxTxFee,
@@ -6384,6 +8592,7 @@ Run 'sekai-cli sync' to refresh the cache after network changes.`
 			UnjailMaxTime:            props.UnjailMaxTime,
 			UnstakingPeriod:          props.UnstakingPeriod,
 			MaxDelegators:            props.MaxDelegators,
+			MaxMemoCharacters:        maxMemo,
 		}
 
 		// Cache keys
@@ -6405,15 +8614,40 @@ Run 'sekai-cli sync' to refresh the cache after network changes.`
 			c.DefaultKey = c.Keys[0].Name
 		}
 
+		// Merge into the cached network, keeping the user's default key
+		// unless one was given
+		if existing != nil {
+			changes := existing.Merge(c)
+			if defaultKey != "" && existing.DefaultKey != defaultKey {
+				changes = append(changes, cache.Change{Field: "default_key", Old: existing.DefaultKey, New: defaultKey})
+				existing.DefaultKey = defaultKey
+			}
+			c = existing
+
+			if len(changes) == 0 {
+				ctx.Printf("\nNo changes, the cache is up to date.\n")
+			} else {
+				ctx.Printf("\nChanges:\n")
+				for _, ch := range changes {
+					ctx.Printf("  %s\n", ch)
+				}
+			}
+		}
+
 		// Save cache
 		if err := c.Save(); err != nil {
 			return fmt.Errorf("failed to save cache: %w", err)
 		}
 
-		// Also update config with detected values
-		a.config.Container = container
-		a.config.ChainID = c.Network.ChainID
-		a.config.Home = "/sekai" // Always use /sekai to avoid /.sekaid ghost directory
+		// Also update config (or the active profile) with detected values
+		if a.profile != "" && a.profile != config.DefaultProfile {
+			profile.Container = container
+			profile.ChainID = c.Network.ChainID
+		} else {
+			a.config.Container = container
+			a.config.ChainID = c.Network.ChainID
+			a.config.Home = "/sekai" // Always use /sekai to avoid /.sekaid ghost directory
+		}
 		if err := a.config.Save(config.DefaultConfigPath()); err != nil {
 			ctx.Printf("Warning: failed to save config: %v\n", err)
 		}
@@ -6443,8 +8677,15 @@ Use this after network properties have been changed via governance proposals.`
 
 	cmd.AddFlag(cli.Flag{Name: "keys-only", Usage: "Only refresh keys"})
 	cmd.AddFlag(cli.Flag{Name: "network-only", Usage: "Only refresh network properties"})
+	cmd.AddFlag(cli.Flag{Name: "step-timeout", Usage: "Maximum time for each network query", Default: defaultStepTimeout.String()})
 
 	cmd.Run = func(ctx *cli.Context) error {
+		p, stop, err := newProgress(ctx)
+		if err != nil {
+			return err
+		}
+		defer stop()
+
 		// Load existing cache
 		c, err := cache.Load()
 		if err != nil {
@@ -6455,7 +8696,8 @@ Use this after network properties have been changed via governance proposals.`
 		networkOnly := ctx.GetFlag("network-only") != ""
 
 		// Verify container is still running
-		if !docker.IsContainerRunning(c.Container) {
+		runtime := a.getRuntime(ctx)
+		if !runtime.IsContainerRunning(c.Container) {
 			return fmt.Errorf("container '%s' is not running", c.Container)
 		}
 
@@ -6469,6 +8711,8 @@ Use this after network properties have been changed via governance proposals.`
 			keyringBackend = "test"
 		}
 		client, err := docker.NewClient(c.Container,
+			docker.WithRuntime(runtime.Name),
+			docker.WithHost(runtime.Host),
 			docker.WithKeyringBackend(keyringBackend),
 			docker.WithHome(home),
 		)
@@ -6479,18 +8723,43 @@ Use this after network properties have been changed via governance proposals.`
 
 		ctx.Printf("Syncing from container: %s\n", c.Container)
 
-		// Sync network properties
+		// Sync network properties. Nothing is saved until every step has
+		// succeeded, so a failed or interrupted sync leaves the cache as
+		// it was.
 		if !keysOnly {
-			ctx.Printf("Refreshing network properties...\n")
-			statusResp, err := client.Status(context.Background())
-			if err != nil {
-				return fmt.Errorf("failed to query status: %w", err)
+			var statusResp *sdk.StatusResponse
+			var props *gov.NetworkProperties
+			var maxMemo string
+			if err := p.step("Refreshing network properties", func(stepCtx context.Context) (err error) {
+				statusResp, err = client.Status(stepCtx)
+				if err != nil {
+					return fmt.Errorf("failed to query status: %w", err)
+				}
+				props, err = gov.New(client).NetworkProperties(stepCtx)
+				if err != nil {
+					return fmt.Errorf("failed to query network properties: %w", err)
+				}
+				maxMemo = cachedMaxMemo(stepCtx, client)
+				return nil
+			}); err != nil {
+				return err
 			}
 
-			govMod := gov.New(client)
-			props, err := govMod.NetworkProperties(context.Background())
-			if err != nil {
-				return fmt.Errorf("failed to query network properties: %w", err)
+			var denoms []string
+			var feeRates map[string]string
+			if err := p.step("Refreshing token rates", func(stepCtx context.Context) error {
+				denoms, feeRates = cachedDenoms(stepCtx, client)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			var monikers map[string]string
+			if err := p.step("Refreshing validator monikers", func(stepCtx context.Context) error {
+				monikers = cachedMonikers(stepCtx, client)
+				return nil
+			}); err != nil {
+				return err
 			}
 
 			// Track changes
@@ -6511,6 +8780,15 @@ Use this after network properties have been changed via governance proposals.`
 				UnjailMaxTime:            props.UnjailMaxTime,
 				UnstakingPeriod:          props.UnstakingPeriod,
 				MaxDelegators:            props.MaxDelegators,
+				MaxMemoCharacters:        maxMemo,
+			}
+			c.CachedAt = time.Now()
+			c.Denoms = denoms
+			if len(feeRates) > 0 {
+				c.FeeRates = feeRates
+			}
+			if monikers != nil {
+				c.Monikers = monikers
 			}
 
 			// Report changes
@@ -6524,10 +8802,15 @@ Use this after network properties have been changed via governance proposals.`
 
 		// Sync keys
 		if !networkOnly {
-			ctx.Printf("Refreshing keys...\n")
-			keysList, err := client.Keys().List(context.Background())
-			if err != nil {
-				return fmt.Errorf("failed to list keys: %w", err)
+			var keysList []sdk.KeyInfo
+			if err := p.step("Refreshing keys", func(stepCtx context.Context) (err error) {
+				keysList, err = client.Keys().List(stepCtx)
+				if err != nil {
+					return fmt.Errorf("failed to list keys: %w", err)
+				}
+				return nil
+			}); err != nil {
+				return err
 			}
 
 			oldKeyCount := len(c.Keys)
@@ -6561,12 +8844,20 @@ Use this after network properties have been changed via governance proposals.`
 			return fmt.Errorf("failed to save cache: %w", err)
 		}
 
-		// Also update config with synced values
-		a.config.Container = c.Container
-		if c.Network.ChainID != "" {
-			a.config.ChainID = c.Network.ChainID
+		// Also update config (or the active profile) with synced values
+		if a.profile != "" && a.profile != config.DefaultProfile {
+			profile := a.activeProfile()
+			profile.Container = c.Container
+			if c.Network.ChainID != "" {
+				profile.ChainID = c.Network.ChainID
+			}
+		} else {
+			a.config.Container = c.Container
+			if c.Network.ChainID != "" {
+				a.config.ChainID = c.Network.ChainID
+			}
+			a.config.Home = "/sekai" // Always use /sekai to avoid /.sekaid ghost directory
 		}
-		a.config.Home = "/sekai" // Always use /sekai to avoid /.sekaid ghost directory
 		if err := a.config.Save(config.DefaultConfigPath()); err != nil {
 			ctx.Printf("Warning: failed to save config: %v\n", err)
 		}
@@ -6596,9 +8887,93 @@ func (a *App) buildCacheCommand() *cli.Command {
 	}
 	cacheCmd.AddCommand(showCmd)
 
+	// cache status
+	statusCmd := cli.NewCommand("status")
+	statusCmd.Short = "Show cache age and whether it is stale"
+	statusCmd.Long = `Show when the network properties were cached and whether the cache is
+older than the maximum age (--max-cache-age, config cache_ttl, or 24h).
+Commands keep using a stale cache but warn; run 'sekai-cli sync' to refresh.`
+	statusCmd.Run = func(ctx *cli.Context) error {
+		c, err := cache.Load()
+		if err != nil {
+			return err
+		}
+		maxAge, err := a.cacheMaxAge(ctx)
+		if err != nil {
+			return err
+		}
+		status := struct {
+			Path     string `json:"path"`
+			ChainID  string `json:"chain_id"`
+			CachedAt string `json:"cached_at"`
+			Age      string `json:"age"`
+			MaxAge   string `json:"max_age"`
+			Stale    bool   `json:"stale"`
+		}{
+			Path:     c.Path(),
+			ChainID:  c.GetChainID(),
+			CachedAt: c.NetworkFetchedAt().Format(time.RFC3339),
+			Age:      c.Age().Round(time.Second).String(),
+			MaxAge:   formatCacheAge(maxAge),
+			Stale:    c.IsStale(maxAge),
+		}
+		if maxAge == 0 {
+			status.MaxAge = "none"
+		}
+		return a.printOutput(ctx, status)
+	}
+	cacheCmd.AddCommand(statusCmd)
+
+	// cache list
+	listCmd := cli.NewCommand("list")
+	listCmd.Short = "List cached networks"
+	listCmd.Run = func(ctx *cli.Context) error {
+		caches, active, err := cache.List()
+		if err != nil {
+			return err
+		}
+		type cachedNetwork struct {
+			ChainID    string `json:"chain_id"`
+			Container  string `json:"container"`
+			Keys       int    `json:"keys"`
+			DefaultKey string `json:"default_key,omitempty"`
+			Age        string `json:"age"`
+			Active     bool   `json:"active"`
+		}
+		networks := make([]cachedNetwork, 0, len(caches))
+		for _, c := range caches {
+			networks = append(networks, cachedNetwork{
+				ChainID:    c.GetChainID(),
+				Container:  c.Container,
+				Keys:       len(c.Keys),
+				DefaultKey: c.DefaultKey,
+				Age:        c.FormatAge(),
+				Active:     c.GetChainID() == active,
+			})
+		}
+		return a.printOutput(ctx, networks)
+	}
+	cacheCmd.AddCommand(listCmd)
+
+	// cache use
+	useCmd := cli.NewCommand("use")
+	useCmd.Short = "Set the active cached network"
+	useCmd.Long = `Set the cached network used when --chain-id is not given. Running
+'sekai-cli init' or 'sekai-cli sync' also makes that network active.`
+	useCmd.Args = []cli.Arg{{Name: "chain-id", Required: true, Description: "Chain ID of a cached network"}}
+	useCmd.Run = func(ctx *cli.Context) error {
+		chainID := ctx.GetArg(0)
+		if err := cache.Use(chainID); err != nil {
+			return err
+		}
+		ctx.Printf("Active network set to: %s\n", chainID)
+		return nil
+	}
+	cacheCmd.AddCommand(useCmd)
+
 	// cache clear
 	clearCmd := cli.NewCommand("clear")
-	clearCmd.Short = "Clear cached configuration"
+	clearCmd.Short = "Clear cached configuration of all networks"
 	clearCmd.Run = func(ctx *cli.Context) error {
 		if !cache.Exists() {
 			ctx.Printf("No cache file exists.\n")
@@ -6668,6 +9043,158 @@ func (a *App) buildConfigCommand() *cli.Command {
 	}
 	configCmd.AddCommand(initCmd)
 
+	// config list-profiles
+	listProfilesCmd := cli.NewCommand("list-profiles")
+	listProfilesCmd.Short = "List config profiles"
+	listProfilesCmd.Run = func(ctx *cli.Context) error {
+		type profileInfo struct {
+			Name      string `json:"name"`
+			Active    bool   `json:"active"`
+			ChainID   string `json:"chain_id,omitempty"`
+			Container string `json:"container,omitempty"`
+			Node      string `json:"node,omitempty"`
+			RESTURL   string `json:"rest_url,omitempty"`
+		}
+		active := getStringOrDefault(a.profile, config.DefaultProfile)
+		var profiles []profileInfo
+		for _, name := range a.config.ProfileNames() {
+			p, err := a.config.Profile(name)
+			if err != nil {
+				return err
+			}
+			info := profileInfo{
+				Name:      name,
+				Active:    name == active,
+				ChainID:   getStringOrDefault(p.ChainID, a.config.ChainID),
+				Container: getStringOrDefault(p.Container, a.config.Container),
+				Node:      getStringOrDefault(p.Node, a.config.Node),
+			}
+			if p.UseREST || p.RESTURL != "" {
+				info.RESTURL = getStringOrDefault(p.RESTURL, a.config.RESTURL)
+			}
+			profiles = append(profiles, info)
+		}
+		return a.printOutput(ctx, profiles)
+	}
+	configCmd.AddCommand(listProfilesCmd)
+
+	// config use
+	useCmd := cli.NewCommand("use")
+	useCmd.Short = "Set the active config profile"
+	useCmd.Long = `Set the profile used when --profile is not given. Profiles are defined
+in the JSON config file; empty values fall back to the top-level settings,
+which form the "default" profile:
+
+  {
+    "container": "sekin-sekai-1",
+    "chain_id": "localnet-1",
+    "profiles": {
+      "testnet": {"chain_id": "testnet-1", "rest_url": "https://testnet.example:1317", "use_rest": true},
+      "devnet": {"container": "devnet-sekai-1", "chain_id": "devnet-1"}
+    }
+  }
+
+Each profile has its own cache, so run 'sekai-cli init' once per profile.`
+	useCmd.Args = []cli.Arg{{Name: "name", Required: true, Description: "Profile name"}}
+	useCmd.Run = func(ctx *cli.Context) error {
+		// Update the file as written, without environment overrides
+		path := getStringOrDefault(a.config.Path(), config.DefaultConfigPath())
+		fileConfig := config.Default()
+		if _, err := os.Stat(path); err == nil {
+			if err := fileConfig.LoadFromFile(path); err != nil {
+				return err
+			}
+		}
+		if err := fileConfig.UseProfile(ctx.Args[0]); err != nil {
+			return err
+		}
+		if err := fileConfig.Save(path); err != nil {
+			return err
+		}
+		ctx.Printf("Active profile set to %s in %s\n", ctx.Args[0], path)
+		return nil
+	}
+	configCmd.AddCommand(useCmd)
+
+	// config get
+	getCmd := cli.NewCommand("get")
+	getCmd.Short = "Print a configuration value"
+	getCmd.Long = `Print the value of a configuration key, including environment overrides.
+With --profile, print the value the profile uses: its own, or the top-level
+value it falls back to.
+
+Keys: ` + strings.Join(config.Keys(), ", ")
+	getCmd.Usage = `  sekai-cli config get chain-id
+  sekai-cli config get node --profile testnet`
+	getCmd.Args = []cli.Arg{{Name: "key", Required: true}}
+	getCmd.Run = func(ctx *cli.Context) error {
+		value, err := a.config.Get(ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		if a.profile != "" && a.profile != config.DefaultProfile {
+			if own, err := a.activeProfile().Get(ctx.Args[0]); err == nil && own != "" && own != "false" {
+				value = own
+			}
+		}
+		ctx.Printf("%s\n", value)
+		return nil
+	}
+	configCmd.AddCommand(getCmd)
+
+	// config set
+	setCmd := cli.NewCommand("set")
+	setCmd.Short = "Set a configuration value"
+	setCmd.Long = `Validate a value and save it to the config file. With --profile, the value is
+saved to that profile instead; an empty value makes the profile fall back to
+the top-level setting.
+
+Keys: ` + strings.Join(config.Keys(), ", ")
+	setCmd.Usage = `  sekai-cli config set node tcp://host:26657
+  sekai-cli config set chain-id testnet-1 --profile testnet`
+	setCmd.Args = []cli.Arg{
+		{Name: "key", Required: true},
+		{Name: "value", Description: "New value (may be \"\" with --profile)"},
+	}
+	setCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return fmt.Errorf("key and value required")
+		}
+		key, value := ctx.Args[0], ctx.Args[1]
+
+		// Update the file as written, without environment overrides
+		path := getStringOrDefault(a.config.Path(), config.DefaultConfigPath())
+		fileConfig := config.Default()
+		if _, err := os.Stat(path); err == nil {
+			if err := fileConfig.LoadFromFile(path); err != nil {
+				return err
+			}
+		}
+
+		target := "config"
+		if name := ctx.GetFlag("profile"); name != "" && name != config.DefaultProfile {
+			profile, err := fileConfig.Profile(name)
+			if err != nil {
+				return err
+			}
+			if err := profile.Set(key, value); err != nil {
+				return err
+			}
+			target = "profile " + name
+		} else if err := fileConfig.Set(key, value); err != nil {
+			return err
+		}
+
+		if err := fileConfig.Save(path); err != nil {
+			return err
+		}
+		ctx.Printf("Set %s = %s in %s (%s)\n", key, value, target, path)
+		return nil
+	}
+	configCmd.AddCommand(setCmd)
+
+	configCmd.AddCommand(a.buildConfigAddressCommand())
+
 	return configCmd
 }
 
@@ -6694,27 +9221,62 @@ Example scenario file:
       params:
         from: alice
         to: bob
-        amount: "{{ amount }}"`
+        amount: "{{ amount }}"
+
+Use for_each to repeat a step for every item of a list variable (a YAML list
+or comma-separated string); the current item is available as {{ item }}:
+    - name: Pay recipients
+      module: bank
+      action: send
+      for_each: recipients
+      params:
+        from: alice
+        to: "{{ item }}"
+        amount: "{{ amount }}"
+
+Use register to capture a value from a step's result for later steps. For
+transactions, .txhash, .height, .code and .attributes.<event key> are available:
+    - name: Propose role
+      module: gov
+      action: proposal-assign-role
+      params:
+        from: alice
+        address: kira1...
+        role: validator
+      register: proposal_id
+      register_path: .attributes.proposal_id
+
+Use --step (repeatable), --from-step and --to-step with run to execute only some
+steps, e.g. when debugging. Unselected steps do not run and depends_on entries
+naming them are ignored. A selected step that uses the output or register of
+an unselected step fails unless the value is set with --var:
+  sekai-cli scenario run setup.yaml --step "Vote" --var proposal_id=42`
 
 	// run subcommand
 	runCmd := cli.NewCommand("run")
 	runCmd.Short = "Execute a scenario file"
-	runCmd.Args = []cli.Arg{
-		{Name: "file", Required: true, Description: "Path to the scenario YAML file"},
-	}
+	runCmd.Args = []cli.Arg{scenarioSourceArg}
 	runCmd.Flags = []cli.Flag{
-		{Name: "var", Usage: "Override variable (can be repeated): --var key=value"},
+		{Name: "var", Usage: "Override variable (can be repeated): --var key=value", Repeatable: true},
+		{Name: "env-file", Usage: "Load variable overrides from a dotenv file of KEY=value lines; --var takes precedence"},
 		{Name: "dry-run", Usage: "Show what would be executed without running"},
 		{Name: "verbose", Usage: "Show detailed output"},
 		{Name: "continue-on-error", Usage: "Continue executing even if a step fails"},
+		{Name: "step", Usage: "Run only the named step (can be repeated): --step name", Repeatable: true},
+		{Name: "from-step", Usage: "Run the steps from this named step on (with --to-step, a range)"},
+		{Name: "to-step", Usage: "Run the steps up to and including this named step"},
 		{Name: "tx-timeout", Usage: "Timeout for transaction confirmation (default: 60s)"},
+		{Name: "max-parallel", Usage: "Maximum number of independent steps to run concurrently", Default: "1"},
+		{Name: "report", Usage: "Write a machine-readable execution report to this file"},
+		{Name: "report-format", Usage: "Report format (json, yaml)", Default: "json"},
+		{Name: "sequence-retry", Usage: "On an account sequence mismatch, sign again once with the expected sequence (--sequence-retry=false to disable)", Default: "true"},
+		{Name: "sign-mode", Usage: "Signing mode of transaction steps (direct, amino-json)", Default: "direct"},
 	}
+	runCmd.Flags = append(runCmd.Flags, scenarioSourceFlags...)
 	cli.AddGlobalFlags(runCmd)
 	runCmd.Run = func(ctx *cli.Context) error {
-		filePath := ctx.Args[0]
-
 		// Load scenario
-		scenario, err := scenarios.LoadFromFile(filePath)
+		scenario, err := loadScenario(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to load scenario: %w", err)
 		}
@@ -6725,17 +9287,27 @@ Example scenario file:
 			return err
 		}
 
-		// Parse CLI variable overrides
+		// Variables from the scenario are overridden by --env-file, which
+		// is overridden by --var
 		varOverrides := make(map[string]string)
-		if varFlag := ctx.GetFlag("var"); varFlag != "" {
-			// Handle multiple --var flags
-			vars := strings.Split(varFlag, ",")
-			for _, v := range vars {
-				parts := strings.SplitN(v, "=", 2)
-				if len(parts) == 2 {
-					varOverrides[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
-				}
+		if envFile := ctx.GetFlag("env-file"); envFile != "" {
+			envVars, err := scenarios.ParseEnvFile(envFile)
+			if err != nil {
+				return err
 			}
+			for k, v := range envVars {
+				varOverrides[k] = v
+			}
+		}
+
+		// Parse CLI variable overrides; values may contain commas
+		// (e.g. a for_each list), so each --var is one key=value pair.
+		cliVars, err := scenarios.ParseCLIVars(ctx.GetFlagValues("var"))
+		if err != nil {
+			return err
+		}
+		for k, v := range cliVars {
+			varOverrides[k] = v
 		}
 
 		// Build executor options
@@ -6744,6 +9316,22 @@ Example scenario file:
 		opts.Verbose = ctx.GetFlag("verbose") == "true"
 		opts.ContinueOnError = ctx.GetFlag("continue-on-error") == "true"
 		opts.Variables = varOverrides
+		opts.AddressBook = a.config.Addresses
+		opts.Steps = ctx.GetFlagValues("step")
+		opts.FromStep = ctx.GetFlag("from-step")
+		opts.ToStep = ctx.GetFlag("to-step")
+
+		reportPath := ctx.GetFlag("report")
+		reportFormat := ctx.GetFlag("report-format")
+		if reportFormat != scenarios.ReportFormatJSON && reportFormat != scenarios.ReportFormatYAML {
+			return cli.Usagef("invalid --report-format: %s (must be json or yaml)", reportFormat)
+		}
+
+		maxParallel, err := strconv.Atoi(ctx.GetFlag("max-parallel"))
+		if err != nil || maxParallel < 1 {
+			return cli.Usagef("invalid --max-parallel: %s", ctx.GetFlag("max-parallel"))
+		}
+		opts.MaxParallel = maxParallel
 
 		if timeout := ctx.GetFlag("tx-timeout"); timeout != "" {
 			// Parse duration
@@ -6759,6 +9347,14 @@ Example scenario file:
 			return err
 		}
 
+		// Write the report before reporting failure so CI can inspect it
+		if reportPath != "" {
+			if err := scenarios.WriteReport(reportPath, reportFormat, result); err != nil {
+				return err
+			}
+			ctx.Errorf("Report written to %s\n", reportPath)
+		}
+
 		// Output result
 		if !result.Success {
 			return fmt.Errorf("scenario failed: %s", result.Error)
@@ -6771,13 +9367,10 @@ Example scenario file:
 	// validate subcommand
 	validateCmd := cli.NewCommand("validate")
 	validateCmd.Short = "Validate a scenario file without executing"
-	validateCmd.Args = []cli.Arg{
-		{Name: "file", Required: true, Description: "Path to the scenario YAML file"},
-	}
+	validateCmd.Args = []cli.Arg{scenarioSourceArg}
+	validateCmd.Flags = append(validateCmd.Flags, scenarioSourceFlags...)
 	validateCmd.Run = func(ctx *cli.Context) error {
-		filePath := ctx.Args[0]
-
-		scenario, err := scenarios.LoadFromFile(filePath)
+		scenario, err := loadScenario(ctx)
 		if err != nil {
 			return fmt.Errorf("validation failed: %w", err)
 		}
@@ -6788,16 +9381,89 @@ Example scenario file:
 	}
 	scenarioCmd.AddCommand(validateCmd)
 
+	// lint subcommand
+	lintCmd := cli.NewCommand("lint")
+	lintCmd.Short = "Check a scenario for unknown actions, missing params and undefined variables"
+	lintCmd.Long = `Check a scenario without a node: every step's action must be mapped for its
+module and have its required params, every referenced variable must be
+defined by the scenario, --var, --env-file or an earlier step, and no step
+may be unreachable behind a step that cannot succeed. Issues are listed by
+step number; the command fails if any of them is an error.`
+	lintCmd.Args = []cli.Arg{scenarioSourceArg}
+	lintCmd.Flags = []cli.Flag{
+		{Name: "var", Usage: "Variable to be set at run time (can be repeated): --var key=value", Repeatable: true},
+		{Name: "env-file", Usage: "Dotenv file of KEY=value lines to be loaded at run time"},
+	}
+	lintCmd.Flags = append(lintCmd.Flags, scenarioSourceFlags...)
+	lintCmd.Run = func(ctx *cli.Context) error {
+		scenario, err := loadScenario(ctx)
+		if err != nil {
+			return fmt.Errorf("lint failed: %w", err)
+		}
+
+		vars := make(map[string]string)
+		if envFile := ctx.GetFlag("env-file"); envFile != "" {
+			if vars, err = scenarios.ParseEnvFile(envFile); err != nil {
+				return err
+			}
+		}
+		cliVars, err := scenarios.ParseCLIVars(ctx.GetFlagValues("var"))
+		if err != nil {
+			return err
+		}
+		for k, v := range cliVars {
+			vars[k] = v
+		}
+
+		issues := scenarios.Lint(scenario, vars)
+		if len(issues) == 0 {
+			ctx.Printf("No issues found in %d steps\n", len(scenario.Steps))
+			return nil
+		}
+		if err := a.printOutput(ctx, issues); err != nil {
+			return err
+		}
+		errorCount := 0
+		for _, issue := range issues {
+			if issue.Severity == scenarios.SeverityError {
+				errorCount++
+			}
+		}
+		if errorCount > 0 {
+			return fmt.Errorf("scenario has %d errors and %d warnings", errorCount, len(issues)-errorCount)
+		}
+		return nil
+	}
+	scenarioCmd.AddCommand(lintCmd)
+
+	// schema subcommand
+	schemaCmd := cli.NewCommand("schema")
+	schemaCmd.Short = "Print the JSON Schema of scenario files"
+	schemaCmd.Long = `Print a JSON Schema of scenario files for editor completion and validation:
+the scenario and step fields, the modules, the actions of each module and
+the params they require. It is generated from the scenario types and the
+action mapper, so regenerate it after upgrading sekai-cli.`
+	schemaCmd.Usage = `  sekai-cli scenario schema > scenario.schema.json
+
+For VS Code with the YAML extension, add to settings.json:
+  "yaml.schemas": {"./scenario.schema.json": "scenarios/*.yaml"}`
+	schemaCmd.Run = func(ctx *cli.Context) error {
+		b, err := json.MarshalIndent(scenarios.Schema(), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode schema: %w", err)
+		}
+		ctx.Printf("%s\n", b)
+		return nil
+	}
+	scenarioCmd.AddCommand(schemaCmd)
+
 	// show subcommand
 	showCmd := cli.NewCommand("show")
 	showCmd.Short = "Show scenario details"
-	showCmd.Args = []cli.Arg{
-		{Name: "file", Required: true, Description: "Path to the scenario YAML file"},
-	}
+	showCmd.Args = []cli.Arg{scenarioSourceArg}
+	showCmd.Flags = append(showCmd.Flags, scenarioSourceFlags...)
 	showCmd.Run = func(ctx *cli.Context) error {
-		filePath := ctx.Args[0]
-
-		scenario, err := scenarios.LoadFromFile(filePath)
+		scenario, err := loadScenario(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to load scenario: %w", err)
 		}
@@ -6810,11 +9476,155 @@ Example scenario file:
 	return scenarioCmd
 }
 
+// scenarioSourceArg is the scenario argument of the scenario commands. It
+// is optional with --from-stdin.
+var scenarioSourceArg = cli.Arg{
+	Name:        "file",
+	Description: "Path to the scenario YAML file, - for stdin, or an https:// URL",
+}
+
+// scenarioSourceFlags select and verify where a scenario is loaded from.
+var scenarioSourceFlags = []cli.Flag{
+	{Name: "from-stdin", Usage: "Read the scenario YAML from stdin (same as file -)"},
+	{Name: "sha256", Usage: "Expected SHA-256 checksum (hex) of the scenario"},
+	{Name: "insecure", Usage: "Allow fetching the scenario over plain http://"},
+}
+
+// loadScenario loads the scenario named by the command's file argument
+// or --from-stdin.
+func loadScenario(ctx *cli.Context) (*scenarios.Scenario, error) {
+	source := ctx.GetArg(0)
+	if ctx.GetFlag("from-stdin") == "true" {
+		if source != "" && source != "-" {
+			return nil, cli.Usagef("--from-stdin cannot be used with a scenario file")
+		}
+		source = "-"
+	}
+	if source == "" {
+		return nil, fmt.Errorf("scenario file required (or --from-stdin)")
+	}
+	return scenarios.LoadSource(context.Background(), source, scenarios.SourceOptions{
+		Stdin:    ctx.Stdin,
+		SHA256:   ctx.GetFlag("sha256"),
+		Insecure: ctx.GetFlag("insecure") == "true",
+	})
+}
+
 // parseDuration parses a duration string like "60s", "5m", etc.
 func parseDuration(s string) (time.Duration, error) {
 	return time.ParseDuration(s)
 }
 
+// parseDurationSeconds parses a duration given either as plain seconds ("600")
+// or in friendly form ("48h", "30m", "7d") and returns whole seconds.
+func parseDurationSeconds(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n <= 0 {
+			return 0, fmt.Errorf("duration must be positive: %s", s)
+		}
+		return n, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		return n * 24 * 60 * 60, nil
+	}
+	d, err := parseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %s", s)
+	}
+	if d < time.Second {
+		return 0, fmt.Errorf("duration must be at least 1s: %s", s)
+	}
+	return int64(d / time.Second), nil
+}
+
+// parseProposalDurationSets converts repeated <type>=<duration> values into
+// the aligned comma-separated proposal-types and durations arguments.
+func parseProposalDurationSets(sets []string) (string, string, error) {
+	var proposalTypes, durations []string
+	seen := make(map[string]bool)
+	for _, set := range sets {
+		name, value, ok := strings.Cut(set, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.TrimSpace(value) == "" {
+			return "", "", cli.Usagef("invalid --set %q: expected <type>=<duration>", set)
+		}
+		if !gov.IsKnownProposalType(name) {
+			return "", "", fmt.Errorf("unknown proposal type %q (known types: %s)", name, strings.Join(gov.ProposalTypes, ", "))
+		}
+		if seen[name] {
+			return "", "", fmt.Errorf("proposal type %q set more than once", name)
+		}
+		seen[name] = true
+		seconds, err := parseDurationSeconds(value)
+		if err != nil {
+			return "", "", cli.Usagef("invalid --set %q: %w", set, err)
+		}
+		proposalTypes = append(proposalTypes, name)
+		durations = append(durations, strconv.FormatInt(seconds, 10))
+	}
+	return strings.Join(proposalTypes, ","), strings.Join(durations, ","), nil
+}
+
+// humanCoin is a coin amount together with its human-readable form.
+type humanCoin struct {
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"`
+	Display string `json:"display"`
+}
+
+// humanizeCoins formats coins using token symbols and decimals, sorted by
+// display value (largest first). Denoms without token info are shown as-is.
+func (a *App) humanizeCoins(client sdk.Client, coins []types.DecCoin) []humanCoin {
+	registry, err := tokens.New(client).DenomRegistry(context.Background())
+	if err != nil {
+		registry = types.DenomRegistry{}
+	}
+
+	values := make(map[string]*big.Rat, len(coins))
+	result := make([]humanCoin, 0, len(coins))
+	for _, c := range coins {
+		value, err := registry.DisplayAmount(c.Denom, c.Amount)
+		if err != nil {
+			value = new(big.Rat)
+		}
+		values[c.Denom] = value
+		result = append(result, humanCoin{
+			Denom:   c.Denom,
+			Amount:  c.Amount,
+			Display: registry.Humanize(c.Denom, c.Amount),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return values[result[i].Denom].Cmp(values[result[j].Denom]) > 0
+	})
+	return result
+}
+
+// denomResolver resolves denoms for --humanize from the network's token
+// rates, queried once and kept for later commands. Denoms without a
+// symbol or decimals to show are left raw, as are all denoms if the
+// rates cannot be queried.
+func (a *App) denomResolver(ctx *cli.Context) output.DenomResolver {
+	return func(denom string) (types.DenomInfo, bool) {
+		if a.denoms == nil {
+			a.denoms = types.DenomRegistry{}
+			if client, err := a.getClient(ctx); err == nil {
+				if registry, err := tokens.New(client).DenomRegistry(context.Background()); err == nil {
+					a.denoms = registry
+				}
+			}
+		}
+		info, ok := a.denoms[denom]
+		return info, ok && (info.Decimals > 0 || info.Symbol != "" && info.Symbol != denom)
+	}
+}
+
 // Helper functions
 
 func getStringOrDefault(value, defaultValue string) string {
@@ -6850,7 +9660,8 @@ func RunCLI() {
 	defer app.Close()
 
 	if err := app.Run(os.Args[1:]); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		app.PrintError(err)
+		app.Close()
+		os.Exit(ExitCode(err))
 	}
 }
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cache"
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/tokens"
+)
+
+// completeCommand is the hidden command the generated shell completions
+// call to complete flag and argument values:
+//
+//	sekai-cli __complete <preceding words...> <current word>
+//
+// It prints one candidate per line. It only reads the cache, so it is fast
+// and works offline; without a cache it prints nothing.
+const completeCommand = "__complete"
+
+// completeValues prints the value completions for the words of a partial
+// command line.
+func (a *App) completeValues(w io.Writer, words []string) {
+	if len(words) == 0 {
+		return
+	}
+	args, word := words[:len(words)-1], words[len(words)-1]
+
+	// Select the cache the completed command would use
+	name := getStringOrDefault(wordFlag(args, "profile"), a.config.ActiveProfile)
+	chainID := wordFlag(args, "chain-id")
+	if profile, err := a.config.Profile(name); err == nil && chainID == "" {
+		chainID = profile.ChainID
+	}
+	cache.SetProfile(name)
+	cache.SelectChainID(chainID)
+
+	for _, v := range cli.CompleteValues(a.root, args, word, cachedValues) {
+		fmt.Fprintln(w, v)
+	}
+}
+
+// wordFlag returns the value of the last --name flag among words.
+func wordFlag(words []string, name string) string {
+	value := ""
+	for i, w := range words {
+		switch {
+		case w == "--"+name && i+1 < len(words):
+			value = words[i+1]
+		case strings.HasPrefix(w, "--"+name+"="):
+			value = strings.TrimPrefix(w, "--"+name+"=")
+		}
+	}
+	return value
+}
+
+// cachedValues returns the cached values of a completion source.
+func cachedValues(source string) []string {
+	c, err := cache.Load()
+	if err != nil {
+		return nil
+	}
+	switch source {
+	case cli.CompleteKeys:
+		return c.KeyNames()
+	case cli.CompleteDenoms:
+		return c.Denoms
+	}
+	return nil
+}
+
+// cachedDenoms returns the sorted denoms with a token rate and the fee rates
+// of the denoms enabled for fee payments, for the cache. It is best-effort:
+// completion works without denoms, and fees in ukex without fee rates.
+func cachedDenoms(ctx context.Context, client sdk.Client) ([]string, map[string]string) {
+	rates, err := tokens.New(client).AllRates(ctx)
+	if err != nil {
+		return nil, nil
+	}
+	var denoms []string
+	feeRates := map[string]string{}
+	for _, r := range rates.Data {
+		d := strings.TrimSpace(r.Data.Denom)
+		if d == "" {
+			continue
+		}
+		denoms = append(denoms, d)
+		if r.Data.FeeEnabled && r.Data.FeeRate != "" {
+			feeRates[d] = r.Data.FeeRate
+		}
+	}
+	sort.Strings(denoms)
+	return denoms, feeRates
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// confirmTx asks the user to confirm a transaction before it is broadcast.
+// The given summary lines are followed by the fee and chain ID the
+// transaction will use. It is a no-op with --yes.
+func (a *App) confirmTx(ctx *cli.Context, summary ...string) error {
+	if ctx.GetFlag("yes") == "true" {
+		return nil
+	}
+
+	cachedData := a.loadCache(ctx)
+
+	fees, err := a.txFees(ctx, cachedData)
+	if err != nil {
+		return err
+	}
+
+	chainID := a.setting(ctx, "chain-id", a.activeProfile().ChainID)
+	if chainID == "" && cachedData != nil {
+		chainID = cachedData.GetChainID()
+	}
+	if chainID == "" {
+		chainID = a.config.ChainID
+	}
+
+	summary = append(summary,
+		fmt.Sprintf("Fee:      %s", fees),
+		fmt.Sprintf("Chain ID: %s", chainID),
+	)
+	return ctx.Confirm("Broadcast transaction?", summary...)
+}
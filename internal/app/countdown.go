@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/upgrade"
+)
+
+// countdownFlag is the --countdown flag of the upgrade plan queries.
+var countdownFlag = cli.Flag{Name: "countdown", Bool: true, Usage: "Show the time and blocks left until the plan's upgrade time and height, from the node's latest block"}
+
+// printUpgradeCountdown prints the countdown to the plan returned by
+// queryPlan for --countdown. The plan and the node status are queried
+// together.
+func (a *App) printUpgradeCountdown(ctx *cli.Context, client sdk.Client, queryPlan func(context.Context) (*upgrade.Plan, error)) error {
+	type statusResult struct {
+		status *sdk.StatusResponse
+		err    error
+	}
+	statusCh := make(chan statusResult, 1)
+	go func() {
+		status, err := client.Status(context.Background())
+		statusCh <- statusResult{status, err}
+	}()
+
+	plan, err := queryPlan(context.Background())
+	res := <-statusCh
+	if err != nil {
+		return err
+	}
+	if res.err != nil {
+		return fmt.Errorf("failed to query status: %w", res.err)
+	}
+	countdown, err := upgrade.NewCountdown(plan, res.status)
+	if err != nil {
+		return err
+	}
+	if countdown.CatchingUp {
+		ctx.Errorf("Warning: the node is catching up; the countdown is from its latest block %d, behind the network\n", countdown.LatestHeight)
+	}
+	return a.printOutput(ctx, countdown)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// errorOutput is a failed command's error as printed with --output json.
+type errorOutput struct {
+	// Error is the kind of error (see sdk.ErrorKind), or "error" if it is
+	// not classified
+	Error string `json:"error"`
+
+	// Message is the full error message
+	Message string `json:"message"`
+
+	// Needed and Available are the amounts of an insufficient funds or
+	// fees failure
+	Needed    string `json:"needed,omitempty"`
+	Available string `json:"available,omitempty"`
+}
+
+// PrintError prints the error of a failed command. With --output json it
+// is written to stdout as an object, so scripts reading the output get it
+// too; otherwise it is printed to stderr as text.
+func (a *App) PrintError(err error) {
+	a.writeError(os.Stdout, os.Stderr, err)
+}
+
+// writeError writes err to stdout as JSON or to stderr as text, depending
+// on the output format of the command that failed.
+func (a *App) writeError(stdout, stderr io.Writer, err error) {
+	if a.outputFormat != "json" {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return
+	}
+	out := errorOutput{Error: sdk.ErrorKind(err), Message: err.Error()}
+	if out.Error == "" {
+		out.Error = "error"
+	}
+	var funds *sdk.InsufficientFundsError
+	if errors.As(err, &funds) {
+		out.Needed, out.Available = funds.Needed, funds.Available
+	}
+	data, jerr := json.MarshalIndent(out, "", "  ")
+	if jerr != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return
+	}
+	fmt.Fprintln(stdout, string(data))
+}
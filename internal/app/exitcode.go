@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Exit codes of sekai-cli, so that scripts can tell why a command failed.
+const (
+	ExitOK         = 0 // success
+	ExitError      = 1 // any other failure
+	ExitUsage      = 2 // unknown command or flag, missing argument, invalid flag value
+	ExitConnection = 3 // the node or container could not be reached, or timed out
+	ExitTxFailed   = 4 // a transaction was rejected or failed with a nonzero code
+	ExitNotFound   = 5 // the key, transaction or queried object does not exist
+)
+
+// ExitCode returns the exit code for the error of a failed command.
+func ExitCode(err error) int {
+	var usage *cli.UsageError
+	var txErr *sdk.TxError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &usage):
+		return ExitUsage
+	case errors.As(err, &txErr) && txErr.Code != 0, errors.Is(err, sdk.ErrTxFailed):
+		return ExitTxFailed
+	case sdk.IsConnection(err), errors.Is(err, sdk.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return ExitConnection
+	case sdk.IsNotFound(err):
+		return ExitNotFound
+	default:
+		return ExitError
+	}
+}
+
+// txFailedError is a transaction that was included in a block but failed.
+type txFailedError struct {
+	hash   string
+	code   uint32
+	rawLog string
+}
+
+func (e *txFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed with code %d: %s", e.hash, e.code, e.rawLog)
+}
+
+// Is matches sdk.ErrTxFailed.
+func (e *txFailedError) Is(target error) bool {
+	return target == sdk.ErrTxFailed
+}
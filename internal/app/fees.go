@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cache"
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/tokens"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// checkFees resolves the fees of a tx command and checks them before
+// anything is signed, so a missing or malformed fee fails locally instead
+// of after a round-trip to the node. Without fees from a flag, profile,
+// cache or config it fails, unless --fees-auto-if-empty queries the
+// network minimum fee, which is then used as --fees.
+func (a *App) checkFees(ctx *cli.Context) error {
+	if !hasFlag(ctx.Command, "fees") {
+		return nil
+	}
+	cachedData := a.loadCache(ctx)
+	fees, err := a.txFees(ctx, cachedData)
+	if err != nil {
+		return err
+	}
+	if fees == "" {
+		if ctx.GetFlag("fees-auto-if-empty") != "true" {
+			return fmt.Errorf("no transaction fees set: pass --fees or --fees-auto-if-empty, set fees in the config, or run 'sekai-cli init' to cache the network minimum fee")
+		}
+		if fees, err = a.networkMinFee(ctx); err != nil {
+			return err
+		}
+		ctx.Flags["fees"] = fees
+	}
+	return validateFees(fees, cachedData)
+}
+
+// networkMinFee queries the network minimum fee, scaled by
+// --fee-multiplier and paid in --fee-denom.
+func (a *App) networkMinFee(ctx *cli.Context) (string, error) {
+	multiplier, err := feeMultiplier(ctx)
+	if err != nil {
+		return "", err
+	}
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	props, err := gov.New(client).NetworkProperties(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("failed to query the network minimum fee: %w", err)
+	}
+	if props.MinTxFee == "" {
+		return "", fmt.Errorf("the network reports no minimum fee; pass --fees")
+	}
+	fee := scaleFee(props.MinTxFee, props.MaxTxFee, multiplier)
+	denom := getStringOrDefault(ctx.GetFlag("fee-denom"), "ukex")
+	if denom == "ukex" {
+		return fee + denom, nil
+	}
+	rate, err := tokens.New(client).Rate(context.Background(), denom)
+	if err != nil {
+		return "", fmt.Errorf("failed to query the fee rate of --fee-denom %s: %w", denom, err)
+	}
+	if !rate.FeeEnabled {
+		return "", cli.Usagef("--fee-denom %s is not enabled for fee payments", denom)
+	}
+	return convertFee(fee, denom, rate.FeeRate)
+}
+
+// convertFee converts the ukex fee amount to denom at its token fee rate,
+// the ukex value of one unit of denom, rounding up so the fee is never
+// underpaid. A denom other than ukex without a known rate is rejected.
+func convertFee(amount, denom, feeRate string) (string, error) {
+	if denom == "ukex" {
+		return amount + denom, nil
+	}
+	if feeRate == "" {
+		return "", fmt.Errorf("no fee rate known for --fee-denom %s: run 'sekai-cli sync' to cache the token rates, or pass --fees", denom)
+	}
+	rate, ok := new(big.Rat).SetString(feeRate)
+	if !ok || rate.Sign() <= 0 {
+		return "", fmt.Errorf("invalid fee rate %q for --fee-denom %s", feeRate, denom)
+	}
+	ukex, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid network minimum fee %q", amount)
+	}
+	q := new(big.Rat).Quo(new(big.Rat).SetInt(ukex), rate)
+	fee, rem := new(big.Int).QuoRem(q.Num(), q.Denom(), new(big.Int))
+	if rem.Sign() > 0 {
+		fee.Add(fee, big.NewInt(1))
+	}
+	return fee.String() + denom, nil
+}
+
+// validateFees checks that fees parse as coins and, when the cache knows
+// the denoms with a token rate, that the network accepts each fee denom.
+func validateFees(fees string, cachedData *cache.Cache) error {
+	coins, err := types.ParseCoins(fees)
+	if err != nil {
+		return fmt.Errorf("invalid fees %q: %w", fees, err)
+	}
+	if cachedData == nil || len(cachedData.Denoms) == 0 {
+		return nil
+	}
+	for _, coin := range coins {
+		if !slices.Contains(cachedData.Denoms, coin.Denom) {
+			return fmt.Errorf("invalid fees %q: the network has no token rate for %s, so it cannot pay fees (known denoms: %s)", fees, coin.Denom, strings.Join(cachedData.Denoms, ", "))
+		}
+	}
+	return nil
+}
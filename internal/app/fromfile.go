@@ -0,0 +1,128 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// fromFileFlag lets complex proposal commands read their fields from a
+// JSON file instead of a dozen flags.
+var fromFileFlag = cli.Flag{
+	Name:  "from-file",
+	Usage: "Read proposal fields from a JSON file (keys as in --help with underscores); flags override them",
+}
+
+// loadOpts fills opts, a pointer to a struct with json tags, from the
+// --from-file JSON and then from the flags given on the command line, so
+// flags override individual fields. The flag of a field is its JSON name
+// with dashes, e.g. "tx_types" is --tx-types. required names flags that
+// must be set by either the file or the command line.
+func loadOpts(ctx *cli.Context, opts interface{}, required ...string) error {
+	path := ctx.GetFlag("from-file")
+	if path != "" {
+		if err := readOptsFile(path, opts); err != nil {
+			return err
+		}
+	}
+
+	v := reflect.ValueOf(opts).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := optsFlagName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		value := ctx.GetFlag(name)
+		if value == "" || (path != "" && !ctx.IsSet(name)) {
+			continue
+		}
+		if err := setOptsField(v.Field(i), value); err != nil {
+			return cli.Usagef("invalid --%s: %w", name, err)
+		}
+	}
+
+	for _, name := range required {
+		for i := 0; i < t.NumField(); i++ {
+			if optsFlagName(t.Field(i)) == name && v.Field(i).IsZero() {
+				if path != "" {
+					return fmt.Errorf("required flag --%s not provided (or set %q in %s)", name, strings.ReplaceAll(name, "-", "_"), path)
+				}
+				return fmt.Errorf("required flag --%s not provided", name)
+			}
+		}
+	}
+	return nil
+}
+
+// readOptsFile decodes a JSON options file into opts, naming the field
+// that failed to parse.
+func readOptsFile(path string, opts interface{}) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cli.Usagef("--from-file %s does not exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(opts)
+
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &typeErr):
+		return fmt.Errorf("%s: field %q must be a %s, not a %s", path, typeErr.Field, typeErr.Type, typeErr.Value)
+	case errors.As(err, &syntaxErr):
+		line := bytes.Count(data[:syntaxErr.Offset], []byte("\n")) + 1
+		return fmt.Errorf("%s: invalid JSON on line %d: %w", path, line, err)
+	default:
+		return fmt.Errorf("%s: %s", path, strings.TrimPrefix(err.Error(), "json: "))
+	}
+}
+
+// optsFlagName returns the flag of an options field, or "" if it has no
+// json tag.
+func optsFlagName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return ""
+	}
+	return strings.ReplaceAll(name, "_", "-")
+}
+
+// setOptsField parses a flag value for the type of an options field.
+func setOptsField(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		v.SetBool(value == "true")
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a non-negative integer", value)
+		}
+		v.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
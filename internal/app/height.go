@@ -0,0 +1,50 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// addHeightSupport adds the query flags (--height, --field, --count-total)
+// to every runnable command under cmd and wraps its Run to validate the
+// height and explain heights the node cannot answer. getClient passes the
+// height to the backend; printOutput handles --field and --count-total.
+func (a *App) addHeightSupport(cmd *cli.Command) {
+	for _, sub := range cmd.SubCommands {
+		a.addHeightSupport(sub)
+	}
+	if cmd.Run == nil {
+		return
+	}
+
+	cli.AddQueryFlags(cmd)
+	run := cmd.Run
+	cmd.Run = func(ctx *cli.Context) error {
+		height, err := queryHeight(ctx)
+		if err != nil {
+			return err
+		}
+		err = run(ctx)
+		if height > 0 && errors.Is(err, sdk.ErrHeightUnavailable) {
+			return fmt.Errorf("%w: %d is pruned on this node or beyond its latest block; query an archive node for older state", sdk.ErrHeightUnavailable, height)
+		}
+		return err
+	}
+}
+
+// queryHeight returns the --height of a query, or 0 for the latest block.
+func queryHeight(ctx *cli.Context) (int64, error) {
+	value := ctx.GetFlag("height")
+	if value == "" {
+		return 0, nil
+	}
+	height, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || height <= 0 {
+		return 0, cli.Usagef("invalid --height: %s (must be a positive block height)", value)
+	}
+	return height, nil
+}
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/output"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// printHolders prints the accounts holding at least --min of a denom,
+// largest first, for "query bank balances --all-accounts". The denom is
+// --denom or that of a --min coin. Progress goes to stderr, redrawn in
+// place on a terminal.
+func (a *App) printHolders(ctx *cli.Context, client sdk.Client) error {
+	min, denom, err := holdersMin(ctx.GetFlag("min"), ctx.GetFlag("denom"))
+	if err != nil {
+		return err
+	}
+	top := 0
+	if v := ctx.GetFlag("top"); v != "" {
+		top, err = strconv.Atoi(v)
+		if err != nil || top < 0 {
+			return cli.Usagef("invalid --top: %s", v)
+		}
+	}
+
+	stream, err := a.newJSONStream(ctx, "top")
+	if err != nil {
+		return err
+	}
+
+	tty := output.IsTerminal(ctx.Stderr)
+	progress := func(scanned, found int) {
+		if tty {
+			ctx.Errorf("\r\x1b[KScanned %d accounts, %d holders", scanned, found)
+		} else {
+			ctx.Errorf("Scanned %d accounts, %d holders\n", scanned, found)
+		}
+	}
+	var holders []bank.Holder
+	if stream != nil {
+		err = streamHolders(client, denom, min, stream, progress)
+	} else {
+		holders, err = bank.New(client).Holders(context.Background(), denom, &bank.HoldersOptions{
+			Min:      min,
+			Top:      top,
+			Progress: progress,
+		})
+	}
+	if tty {
+		ctx.Errorf("\r\x1b[K")
+	}
+	if err != nil {
+		return err
+	}
+	if stream != nil {
+		return stream.Close()
+	}
+	return a.printOutput(ctx, holders)
+}
+
+// streamHolders writes the owners of denom holding at least min to stream
+// page by page, in the order the node returns them.
+func streamHolders(client sdk.Client, denom, min string, stream *jsonStream, progress func(scanned, found int)) error {
+	least := new(big.Int)
+	if min != "" {
+		least.SetString(min, 10)
+	}
+	scanned := 0
+	pagination := &sdk.Pagination{Limit: bank.DefaultHoldersPageSize}
+	return bank.New(client).DenomOwnerPages(context.Background(), denom, pagination, func(page *bank.DenomOwnersResponse) error {
+		for _, owner := range page.DenomOwners {
+			scanned++
+			amount, ok := new(big.Int).SetString(owner.Balance.Amount, 10)
+			if !ok {
+				return fmt.Errorf("invalid balance of %s: %s", owner.Address, owner.Balance.Amount)
+			}
+			if amount.Cmp(least) < 0 {
+				continue
+			}
+			if err := stream.Write(bank.Holder{Address: owner.Address, Amount: owner.Balance.Amount}); err != nil {
+				return err
+			}
+		}
+		progress(scanned, stream.Count())
+		return nil
+	})
+}
+
+// holdersMin returns the minimum amount and the denom of a holder report
+// from --min, a plain amount or a coin such as 1000000ukex, and --denom.
+// Both must name the same denom if both do.
+func holdersMin(min, denom string) (string, string, error) {
+	if min == "" || isAmount(min) {
+		if denom == "" {
+			return "", "", cli.Usagef("--denom required with --all-accounts (or give --min as a coin, e.g. 1000000ukex)")
+		}
+		return min, denom, nil
+	}
+	coin, err := types.ParseCoin(min)
+	if err != nil {
+		return "", "", cli.Usagef("invalid --min: %s", min)
+	}
+	if denom != "" && coin.Denom != denom {
+		return "", "", cli.Usagef("--min %s is not in --denom %s", min, denom)
+	}
+	return coin.Amount, coin.Denom, nil
+}
+
+// isAmount reports whether s is a non-negative integer amount.
+func isAmount(s string) bool {
+	n, ok := new(big.Int).SetString(s, 10)
+	return ok && n.Sign() >= 0
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/auth"
+)
+
+// loadMemo reads --memo-file into the memo of a tx command and checks the
+// memo against the network's limit, if cached, so an oversized memo fails
+// before signing instead of at broadcast.
+func (a *App) loadMemo(ctx *cli.Context) error {
+	path := ctx.GetFlag("memo-file")
+	if path != "" {
+		if ctx.IsSet("memo") {
+			return cli.Usagef("--memo and --memo-file cannot be used together")
+		}
+		memo, err := readMemoFile(ctx, path)
+		if err != nil {
+			return err
+		}
+		ctx.Flags["memo"] = memo
+	}
+
+	memo := ctx.GetFlag("memo")
+	if memo == "" {
+		return nil
+	}
+	cachedData := a.loadCache(ctx)
+	if cachedData == nil {
+		return nil
+	}
+	limit, err := strconv.Atoi(cachedData.GetMaxMemoCharacters())
+	if err != nil || limit <= 0 {
+		return nil
+	}
+	if len(memo) > limit {
+		return fmt.Errorf("memo is %d characters long; the network allows at most %d (auth max_memo_characters)", len(memo), limit)
+	}
+	return nil
+}
+
+// readMemoFile reads a memo from path, or from stdin for "-". A single
+// trailing newline, as editors add, is dropped.
+func readMemoFile(ctx *cli.Context, path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(ctx.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read --memo-file: %w", err)
+	}
+	memo := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+	if memo == "" {
+		return "", cli.Usagef("--memo-file %s is empty", path)
+	}
+	return memo, nil
+}
+
+// cachedMaxMemo returns the auth module's memo length limit for the cache.
+// It is best-effort: memos are then only checked by the node.
+func cachedMaxMemo(ctx context.Context, client sdk.Client) string {
+	params, err := auth.New(client).Params(ctx)
+	if err != nil {
+		return ""
+	}
+	return params.MaxMemoCharacters
+}
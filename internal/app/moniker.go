@@ -0,0 +1,95 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/staking"
+)
+
+// cachedMonikers returns the validator monikers by address to cache, or
+// nil if the validators cannot be queried.
+func cachedMonikers(ctx context.Context, client sdk.Client) map[string]string {
+	monikers, err := staking.New(client).Monikers(ctx)
+	if err != nil || len(monikers) == 0 {
+		return nil
+	}
+	return monikers
+}
+
+// validatorMonikers returns the validator monikers by address: from the
+// cache, refreshed by init and sync, or else queried once and kept for
+// later commands.
+func (a *App) validatorMonikers(ctx *cli.Context, client sdk.Client) map[string]string {
+	if a.monikers != nil {
+		return a.monikers
+	}
+	if cachedData := a.loadCache(ctx); cachedData != nil && len(cachedData.Monikers) > 0 {
+		a.monikers = cachedData.Monikers
+		return a.monikers
+	}
+	a.monikers = cachedMonikers(context.Background(), client)
+	if a.monikers == nil {
+		a.monikers = map[string]string{}
+	}
+	return a.monikers
+}
+
+// withMonikers adds a "moniker" field to every object in data that holds
+// the address of a known validator and has no moniker yet, so results that
+// only list addresses show who they are. With --no-enrich, or if no
+// monikers are known, data is returned unchanged.
+func (a *App) withMonikers(ctx *cli.Context, client sdk.Client, data interface{}) interface{} {
+	if ctx.GetFlag("no-enrich") == "true" {
+		return data
+	}
+	monikers := a.validatorMonikers(ctx, client)
+	if len(monikers) == 0 {
+		return data
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var root interface{}
+	if err := dec.Decode(&root); err != nil {
+		return data
+	}
+	addMonikers(root, monikers)
+	return root
+}
+
+// addMonikers adds monikers to the objects of a decoded JSON value.
+func addMonikers(v interface{}, monikers map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if _, ok := v["moniker"]; !ok {
+			// The first address field by name wins, so the result is stable
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if addr, ok := v[key].(string); ok && monikers[addr] != "" {
+					v["moniker"] = monikers[addr]
+					break
+				}
+			}
+		}
+		for _, field := range v {
+			addMonikers(field, monikers)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			addMonikers(elem, monikers)
+		}
+	}
+}
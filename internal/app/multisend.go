@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+)
+
+// multiSendFromFile runs "tx bank multi-send" with per-recipient amounts
+// read from a CSV file, or stdin for "-".
+func (a *App) multiSendFromFile(ctx *cli.Context, path string) error {
+	if len(ctx.Args) != 1 {
+		return fmt.Errorf("with --recipients-file, pass only the sender")
+	}
+	if ctx.GetFlag("split") == "true" {
+		return cli.Usagef("--split cannot be used with --recipients-file")
+	}
+
+	var r io.Reader = ctx.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to read --recipients-file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	recipients, err := bank.ParseRecipientsCSV(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	total, err := bank.SumRecipients(recipients)
+	if err != nil {
+		return err
+	}
+
+	from := ctx.Args[0]
+	if err := a.confirmTx(ctx,
+		fmt.Sprintf("From:     %s", from),
+		fmt.Sprintf("To:       %d recipients from %s", len(recipients), path),
+		fmt.Sprintf("Total:    %s", total),
+	); err != nil {
+		return err
+	}
+
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := bank.New(client).MultiSend(context.Background(), from, nil, nil, &bank.MultiSendOptions{
+		SendOptions: bank.SendOptions{
+			Fees:          ctx.GetFlag("fees"),
+			Gas:           ctx.GetFlag("gas"),
+			Memo:          ctx.GetFlag("memo"),
+			BroadcastMode: ctx.GetFlag("broadcast-mode"),
+		},
+		Recipients: recipients,
+	})
+	if err != nil {
+		return err
+	}
+	return a.printOutput(ctx, resp)
+}
@@ -0,0 +1,320 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// generateClient wraps a client so that transactions are built unsigned
+// instead of broadcast. The generated transaction JSON is kept in tx.
+type generateClient struct {
+	sdk.Client
+	tx []byte
+}
+
+// Tx generates the unsigned transaction and returns an empty response.
+func (c *generateClient) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	if c.tx != nil {
+		return nil, cli.Usagef("--generate-only supports commands that build a single transaction")
+	}
+	tx, err := c.GenerateTx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.tx = tx
+	return &sdk.TxResponse{}, nil
+}
+
+// GenerateTx generates the transaction with the wrapped client, if it can
+// build transactions offline.
+func (c *generateClient) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	return otc.GenerateTx(ctx, req)
+}
+
+// SignTx returns the transaction unsigned, for commands that generate,
+// edit, sign and broadcast a transaction in steps.
+func (c *generateClient) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	return tx, nil
+}
+
+// BroadcastTx keeps the transaction instead of broadcasting it.
+func (c *generateClient) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	if c.tx != nil {
+		return nil, cli.Usagef("--generate-only supports commands that build a single transaction")
+	}
+	c.tx = tx
+	return &sdk.TxResponse{}, nil
+}
+
+// addGenerateOnlySupport wraps every command under cmd that has the
+// --generate-only flag so the unsigned transaction is printed instead of
+// being signed and broadcast.
+func (a *App) addGenerateOnlySupport(cmd *cli.Command) {
+	for _, sub := range cmd.SubCommands {
+		a.addGenerateOnlySupport(sub)
+	}
+	if cmd.Run == nil || !hasFlag(cmd, "generate-only") {
+		return
+	}
+
+	run := cmd.Run
+	cmd.Run = func(ctx *cli.Context) error {
+		if ctx.GetFlag("generate-only") != "true" {
+			return run(ctx)
+		}
+
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		gen := &generateClient{Client: client}
+		a.client = gen
+		defer func() { a.client = client }()
+
+		// Nothing is broadcast, so there is nothing to confirm.
+		ctx.Flags["yes"] = "true"
+		a.capture = func(interface{}) {}
+		err = run(ctx)
+		a.capture = nil
+		if err != nil {
+			return err
+		}
+		if gen.tx == nil {
+			return fmt.Errorf("command did not build a transaction")
+		}
+		ctx.Println(strings.TrimSpace(string(gen.tx)))
+		return nil
+	}
+}
+
+// hasFlag reports whether cmd defines the named flag.
+func hasFlag(cmd *cli.Command, name string) bool {
+	for _, f := range cmd.Flags {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// buildTxSignCommand builds "tx sign" for offline signing of a transaction
+// produced with --generate-only.
+func (a *App) buildTxSignCommand() *cli.Command {
+	cmd := cli.NewCommand("sign")
+	cmd.Short = "Sign a transaction generated with --generate-only"
+	cmd.Long = `Sign an unsigned transaction file and print the signed transaction JSON.
+
+The signer's account number and sequence are queried from the node. Pass both
+--account-number and --sequence to sign fully offline.`
+	cmd.Usage = `  sekai-cli tx bank send genesis kira1... 100ukex --generate-only > unsigned.json
+  sekai-cli tx sign unsigned.json --from genesis --output-document signed.json
+  sekai-cli tx sign unsigned.json --from genesis --account-number 0 --sequence 12 --chain-id testnet-1`
+	cmd.Args = []cli.Arg{{Name: "file", Required: true, Description: "Unsigned transaction file (- for stdin)"}}
+	cmd.Flags = []cli.Flag{
+		{Name: "from", Usage: "Name or address of key to sign with", Complete: cli.CompleteKeys},
+		{Name: "account-number", Usage: "Signer account number (queried if not set)"},
+		{Name: "sequence", Usage: "Signer sequence (queried if not set)"},
+		{Name: "output-document", Usage: "Write the signed transaction to this file instead of stdout"},
+		{Name: "chain-id", Usage: "Chain ID"},
+		{Name: "keyring-backend", Usage: "Keyring backend (test, file, os)", Default: "test"},
+		{Name: "sign-mode", Usage: "Signing mode (direct, amino-json)", Default: "direct"},
+	}
+	cmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("transaction file required")
+		}
+		from := a.getFromFlag(ctx)
+		if from == "" {
+			return cli.Usagef("--from flag required (run 'sekai-cli init' to set default)")
+		}
+		tx, err := readTxFile(ctx, ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		otc, ok := client.(sdk.OfflineTxClient)
+		if !ok {
+			return fmt.Errorf("this client cannot sign transactions: %w", sdk.ErrNotSupported)
+		}
+		signed, err := otc.SignTx(context.Background(), tx, &sdk.SignOptions{
+			Signer:        from,
+			AccountNumber: ctx.GetFlag("account-number"),
+			Sequence:      ctx.GetFlag("sequence"),
+			SignMode:      ctx.GetFlag("sign-mode"),
+		})
+		if err != nil {
+			return err
+		}
+
+		if path := ctx.GetFlag("output-document"); path != "" {
+			if err := os.WriteFile(path, append(signed, '\n'), 0600); err != nil {
+				return fmt.Errorf("failed to write signed transaction: %w", err)
+			}
+			ctx.Errorf("Signed transaction written to %s\n", path)
+			return nil
+		}
+		ctx.Println(strings.TrimSpace(string(signed)))
+		return nil
+	}
+	return cmd
+}
+
+// buildTxBroadcastCommand builds "tx broadcast" for submitting a signed
+// transaction file.
+func (a *App) buildTxBroadcastCommand() *cli.Command {
+	cmd := cli.NewCommand("broadcast")
+	cmd.Short = "Broadcast a signed transaction"
+	cmd.Long = `Broadcast a transaction signed with 'tx sign'.
+
+With --rest the file must contain the base64-encoded transaction bytes,
+because the REST endpoint does not accept signed JSON. Convert it with
+'tx encode'.`
+	cmd.Usage = `  sekai-cli tx broadcast signed.json
+  sekai-cli tx broadcast signed.json --broadcast-mode block`
+	cmd.Args = []cli.Arg{{Name: "file", Required: true, Description: "Signed transaction file (- for stdin)"}}
+	cmd.Flags = []cli.Flag{
+		{Name: "broadcast-mode", Usage: "Broadcast mode (sync, async, block; block waits for inclusion like --wait)", Default: "sync"},
+		{Name: "chain-id", Usage: "Chain ID"},
+		{Name: "result-only", Usage: "Print only the tx hash and result code", Default: "false"},
+		{Name: "wait", Usage: "Wait until the transaction is included in a block and print the result"},
+		{Name: "wait-timeout", Usage: "Maximum time to wait with --wait or --broadcast-mode block", Default: "60s"},
+	}
+	cmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("transaction file required")
+		}
+		tx, err := readTxFile(ctx, ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		otc, ok := client.(sdk.OfflineTxClient)
+		if !ok {
+			return fmt.Errorf("this client cannot broadcast signed transactions: %w", sdk.ErrNotSupported)
+		}
+		resp, err := otc.BroadcastTx(context.Background(), tx, ctx.GetFlag("broadcast-mode"))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, resp)
+	}
+	return cmd
+}
+
+// buildTxEncodeCommand builds "tx encode" for converting a transaction
+// JSON into base64-encoded protobuf bytes.
+func (a *App) buildTxEncodeCommand() *cli.Command {
+	cmd := cli.NewCommand("encode")
+	cmd.Short = "Encode a transaction JSON as base64 protobuf bytes"
+	cmd.Long = `Convert a transaction JSON, as produced by --generate-only or 'tx sign', into
+its base64-encoded protobuf form, e.g. for 'tx broadcast' with --rest.`
+	cmd.Usage = `  sekai-cli tx encode signed.json
+  sekai-cli tx bank send genesis kira1... 100ukex --generate-only | sekai-cli tx encode -`
+	cmd.Args = []cli.Arg{{Name: "file", Required: true, Description: "Transaction JSON file (- for stdin)"}}
+	cmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("transaction file required")
+		}
+		tx, err := readTxFile(ctx, ctx.Args[0])
+		if err != nil {
+			return err
+		}
+		if err := sdk.ValidateTxJSON(tx); err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		codec, ok := client.(sdk.TxCodec)
+		if !ok {
+			return fmt.Errorf("this client cannot encode transactions: %w", sdk.ErrNotSupported)
+		}
+		encoded, err := codec.EncodeTx(context.Background(), tx)
+		if err != nil {
+			return err
+		}
+		ctx.Println(encoded)
+		return nil
+	}
+	return cmd
+}
+
+// buildTxDecodeCommand builds "tx decode" for converting base64-encoded
+// protobuf transaction bytes into JSON.
+func (a *App) buildTxDecodeCommand() *cli.Command {
+	cmd := cli.NewCommand("decode")
+	cmd.Short = "Decode base64 protobuf transaction bytes to JSON"
+	cmd.Long = `Convert a base64-encoded transaction, as printed by 'tx encode' or received
+from other tooling, into its JSON form.`
+	cmd.Usage = `  sekai-cli tx decode CpIBCo8BChwvY29zbW9z...
+  sekai-cli tx decode - < tx.b64`
+	cmd.Args = []cli.Arg{{Name: "tx-bytes", Required: true, Description: "Base64-encoded transaction (- for stdin)"}}
+	cmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 1 {
+			return fmt.Errorf("transaction bytes required")
+		}
+		txBytes := ctx.Args[0]
+		if txBytes == "-" {
+			data, err := readTxFile(ctx, txBytes)
+			if err != nil {
+				return err
+			}
+			txBytes = string(data)
+		}
+		txBytes, err := sdk.ValidateTxBytes(txBytes)
+		if err != nil {
+			return err
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		codec, ok := client.(sdk.TxCodec)
+		if !ok {
+			return fmt.Errorf("this client cannot decode transactions: %w", sdk.ErrNotSupported)
+		}
+		tx, err := codec.DecodeTx(context.Background(), txBytes)
+		if err != nil {
+			return err
+		}
+		ctx.Println(strings.TrimSpace(string(tx)))
+		return nil
+	}
+	return cmd
+}
+
+// readTxFile reads a transaction file, or stdin when path is "-".
+func readTxFile(ctx *cli.Context, path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(ctx.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transaction: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, fmt.Errorf("transaction file is empty")
+	}
+	return data, nil
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/output"
+)
+
+// defaultStepTimeout bounds each network call of init and sync.
+const defaultStepTimeout = 30 * time.Second
+
+// spinnerFrames are drawn in turn while a step runs on a terminal.
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// progress runs the steps of a long command such as init or sync. Each
+// step gets its own timeout, and Ctrl-C cancels the running step. On a
+// terminal a spinner shows the running step; otherwise each step is
+// printed as a line when it starts.
+type progress struct {
+	cli     *cli.Context
+	ctx     context.Context
+	timeout time.Duration
+	tty     bool
+}
+
+// newProgress creates a progress for ctx with the --step-timeout of the
+// command. The returned function stops listening for Ctrl-C.
+func newProgress(ctx *cli.Context) (*progress, func(), error) {
+	timeout := defaultStepTimeout
+	if v := ctx.GetFlag("step-timeout"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, nil, cli.Usagef("invalid --step-timeout: %s", v)
+		}
+		timeout = d
+	}
+	sigCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	p := &progress{
+		cli:     ctx,
+		ctx:     sigCtx,
+		timeout: timeout,
+		tty:     output.IsTerminal(ctx.Stdout),
+	}
+	return p, stop, nil
+}
+
+// step runs fn with a context that is canceled on Ctrl-C or after the step
+// timeout. Errors are returned as is, except that a timeout or interrupt
+// names the step. A step whose fn ignores errors, such as an optional
+// query, still fails when interrupted.
+func (p *progress) step(label string, fn func(context.Context) error) error {
+	stepCtx, cancel := context.WithTimeout(p.ctx, p.timeout)
+	defer cancel()
+
+	done := p.spin(label)
+	err := fn(stepCtx)
+	switch {
+	case p.ctx.Err() != nil:
+		err = fmt.Errorf("interrupted while %s", lowerFirst(label))
+	case err != nil && errors.Is(stepCtx.Err(), context.DeadlineExceeded):
+		err = fmt.Errorf("%s timed out after %s (set --step-timeout to wait longer)", lowerFirst(label), p.timeout)
+	}
+	done(err == nil)
+	return err
+}
+
+// spin shows label as the running step and returns the function that
+// marks it finished.
+func (p *progress) spin(label string) func(ok bool) {
+	if !p.tty {
+		p.cli.Printf("%s...\n", label)
+		return func(bool) {}
+	}
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			p.cli.Printf("\r%s %s...", spinnerFrames[i%len(spinnerFrames)], label)
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return func(ok bool) {
+		close(stop)
+		<-stopped
+		mark := "done"
+		if !ok {
+			mark = "failed"
+		}
+		p.cli.Printf("\r\x1b[K%s... %s\n", label, mark)
+	}
+}
+
+// lowerFirst lower-cases the first letter of a step label for use inside
+// a message.
+func lowerFirst(s string) string {
+	if s == "" || s[0] < 'A' || s[0] > 'Z' {
+		return s
+	}
+	return string(s[0]+'a'-'A') + s[1:]
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// submittedProposal is the result printed by tx customgov submit-proposal.
+type submittedProposal struct {
+	TxHash      string `json:"txhash"`
+	Height      int64  `json:"height,string"`
+	ProposalID  string `json:"proposal_id"`
+	ContentType string `json:"content_type"`
+}
+
+// readProposalFile reads a proposal JSON file, or stdin when path is "-".
+func readProposalFile(ctx *cli.Context, path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(ctx.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proposal: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, fmt.Errorf("proposal file is empty")
+	}
+	return data, nil
+}
@@ -0,0 +1,404 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cache"
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// shellHistorySize is the number of lines kept in the shell history file.
+const shellHistorySize = 1000
+
+// errShellExit ends the shell loop.
+var errShellExit = errors.New("exit")
+
+// buildShellCommand builds the interactive shell command.
+func (a *App) buildShellCommand() *cli.Command {
+	shellCmd := cli.NewCommand("shell")
+	shellCmd.Short = "Start an interactive shell"
+	shellCmd.Long = `Start an interactive shell that runs sekai-cli commands without the
+"sekai-cli" prefix, e.g. "keys list" or "bank balances kira1...".
+
+Session variables are added as flags to every command that accepts them:
+
+  set from alice           Sign transactions with alice
+  set chain-id testnet-1   Use another chain ID
+  set container sekai-2    Talk to another container
+  set                      Show session variables
+  unset from               Clear a session variable
+
+Global flags given when starting the shell (e.g. sekai-cli --container
+sekai-2 shell) become session variables. Flags given on a line take
+precedence over session variables.
+
+The shell supports line editing, history (up/down, kept across sessions)
+and Tab completion of commands and flags. Leave with "exit" or Ctrl-D.`
+	shellCmd.Run = func(ctx *cli.Context) error {
+		return a.runShell(ctx)
+	}
+	return shellCmd
+}
+
+// runShell runs the read-eval-print loop.
+func (a *App) runShell(ctx *cli.Context) error {
+	if a.session == nil {
+		a.session = make(map[string]string)
+	}
+	for _, f := range a.root.Flags {
+		if f.Name != "help" && ctx.IsSet(f.Name) {
+			a.session[f.Name] = ctx.GetFlag(f.Name)
+		}
+	}
+
+	in, ok := ctx.Stdin.(*os.File)
+	if !ok {
+		return fmt.Errorf("shell requires standard input")
+	}
+	interactive := ctx.IsInteractive()
+
+	editor := cli.NewLineEditor(in, ctx.Stderr)
+	editor.MaxHistory = shellHistorySize
+	editor.Complete = a.shellComplete
+	historyPath := shellHistoryPath()
+	if interactive {
+		editor.History = loadShellHistory(historyPath)
+		fmt.Fprintln(ctx.Stderr, `sekai-cli interactive shell. Type "help" for commands, "exit" or Ctrl-D to leave.`)
+	}
+
+	// Keep Ctrl-C from killing the shell; watch mode still sees it
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	go func() {
+		for range interrupts {
+		}
+	}()
+
+	for {
+		if interactive {
+			editor.Prompt = a.shellPrompt()
+		}
+		line, err := editor.ReadLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		editor.AddHistory(line)
+
+		if err := a.runShellLine(ctx, line); err != nil {
+			if err == errShellExit {
+				break
+			}
+			a.writeError(ctx.Stdout, ctx.Stderr, err)
+		}
+	}
+
+	if interactive {
+		if err := saveShellHistory(historyPath, editor.History); err != nil {
+			fmt.Fprintf(ctx.Stderr, "Warning: failed to save shell history: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// runShellLine executes one line of shell input.
+func (a *App) runShellLine(ctx *cli.Context, line string) error {
+	args, err := splitShellLine(line)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 && args[0] == "sekai-cli" {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return errShellExit
+	case "shell":
+		return fmt.Errorf("already in the shell")
+	case "set":
+		return a.shellSet(ctx, args[1:])
+	case "unset":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: unset <name>")
+		}
+		delete(a.session, args[1])
+		a.resetClient()
+		return nil
+	}
+
+	// Clear per-command state left by the previous line
+	a.capture = nil
+	a.cacheWarned = false
+	return a.root.ExecuteContext(&cli.Context{
+		Stdin:  ctx.Stdin,
+		Stdout: ctx.Stdout,
+		Stderr: ctx.Stderr,
+	}, a.sessionArgs(args))
+}
+
+// shellSet shows or sets session variables.
+func (a *App) shellSet(ctx *cli.Context, args []string) error {
+	switch len(args) {
+	case 0:
+		names := sortedKeys(a.session)
+		if len(names) == 0 {
+			ctx.Println("No session variables set")
+		}
+		for _, name := range names {
+			ctx.Printf("%s = %s\n", name, a.session[name])
+		}
+		return nil
+	case 2:
+		name := strings.TrimPrefix(args[0], "--")
+		if !a.isSessionVariable(name) {
+			return fmt.Errorf("unknown session variable %q (use a global or transaction flag name, e.g. from, chain-id, container)", name)
+		}
+		a.session[name] = args[1]
+		a.resetClient()
+		return nil
+	default:
+		return fmt.Errorf("usage: set <name> <value>")
+	}
+}
+
+// isSessionVariable reports whether name is a global or transaction flag.
+func (a *App) isSessionVariable(name string) bool {
+	if name == "help" {
+		return false
+	}
+	for _, f := range a.root.Flags {
+		if f.Name == name {
+			return true
+		}
+	}
+	for _, f := range cli.TxFlags() {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionArgs adds session variables as flags to args, skipping flags the
+// target command does not accept and flags given explicitly on the line.
+func (a *App) sessionArgs(args []string) []string {
+	target := a.root.Find(args)
+
+	accepts := make(map[string]bool)
+	shorts := make(map[string]string)
+	for cmd := target; cmd != nil; cmd = cmd.Parent() {
+		for _, f := range cmd.Flags {
+			accepts[f.Name] = true
+			if f.Short != "" && shorts[f.Short] == "" {
+				shorts[f.Short] = f.Name
+			}
+		}
+	}
+
+	given := make(map[string]bool)
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "--") {
+			given[strings.SplitN(arg[2:], "=", 2)[0]] = true
+		} else if strings.HasPrefix(arg, "-") {
+			for _, r := range arg[1:] {
+				given[shorts[string(r)]] = true
+			}
+		}
+	}
+
+	var extra []string
+	for _, name := range sortedKeys(a.session) {
+		if accepts[name] && !given[name] {
+			extra = append(extra, "--"+name+"="+a.session[name])
+		}
+	}
+
+	// Flags must come before any "--" end-of-flags marker
+	result := make([]string, 0, len(args)+len(extra))
+	for i, arg := range args {
+		if arg == "--" {
+			result = append(result, extra...)
+			return append(result, args[i:]...)
+		}
+		result = append(result, arg)
+	}
+	return append(result, extra...)
+}
+
+// resetClient closes the client so the next command connects with the
+// current session variables.
+func (a *App) resetClient() {
+	if a.client != nil {
+		a.client.Close()
+	}
+	a.client = nil
+	a.sdk = nil
+	a.denoms = nil
+	a.monikers = nil
+}
+
+// shellPrompt returns the prompt, showing the session signer if set.
+func (a *App) shellPrompt() string {
+	if from := a.session["from"]; from != "" {
+		return fmt.Sprintf("sekai-cli (%s)> ", from)
+	}
+	return "sekai-cli> "
+}
+
+// shellComplete completes the last word of a partial shell line.
+func (a *App) shellComplete(line string) []string {
+	args, err := splitShellLine(line)
+	if err != nil {
+		return nil
+	}
+	word := ""
+	if len(args) > 0 && !strings.HasSuffix(line, " ") {
+		word = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+
+	if len(args) == 1 && (args[0] == "set" || args[0] == "unset") {
+		seen := make(map[string]bool)
+		for _, f := range append(append([]cli.Flag{}, a.root.Flags...), cli.TxFlags()...) {
+			if strings.HasPrefix(f.Name, word) && a.isSessionVariable(f.Name) {
+				seen[f.Name] = true
+			}
+		}
+		return sortedKeys(seen)
+	}
+
+	if values := cli.CompleteValues(a.root, args, word, cachedValues); len(values) > 0 {
+		return values
+	}
+
+	candidates := cli.Complete(a.root, args, word)
+	if len(args) == 0 {
+		for _, builtin := range []string{"exit", "quit", "set", "unset"} {
+			if strings.HasPrefix(builtin, word) {
+				candidates = append(candidates, builtin)
+			}
+		}
+		sort.Strings(candidates)
+	}
+	return candidates
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// splitShellLine splits a line into words, honoring single quotes, double
+// quotes and backslash escapes.
+func splitShellLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\' && quote != '\'':
+			if i+1 < len(runes) {
+				i++
+				word.WriteRune(runes[i])
+				inWord = true
+			}
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// shellHistoryPath returns the shell history file, next to the cache.
+func shellHistoryPath() string {
+	return filepath.Join(filepath.Dir(cache.DefaultCachePath()), "shell_history")
+}
+
+// loadShellHistory reads the history file, ignoring a missing file.
+func loadShellHistory(path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var history []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			history = append(history, line)
+		}
+	}
+	if len(history) > shellHistorySize {
+		history = history[len(history)-shellHistorySize:]
+	}
+	return history
+}
+
+// saveShellHistory writes the history file. It may contain addresses and
+// memos, so it is only readable by the user.
+func saveShellHistory(path string, history []string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data := strings.Join(history, "\n")
+	if data != "" {
+		data += "\n"
+	}
+	return os.WriteFile(path, []byte(data), 0600)
+}
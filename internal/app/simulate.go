@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// simulateClient wraps a client so that transactions are simulated
+// instead of broadcast. The last simulation result is kept in result.
+type simulateClient struct {
+	sdk.Client
+	result *sdk.SimulateResponse
+}
+
+// Tx simulates the transaction and returns an empty response.
+func (c *simulateClient) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	if err := c.simulate(ctx, req); err != nil {
+		return nil, err
+	}
+	return &sdk.TxResponse{}, nil
+}
+
+// GenerateTx simulates the request like Tx and then generates it, for
+// commands that generate, edit, sign and broadcast a transaction in steps.
+func (c *simulateClient) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	if err := c.simulate(ctx, req); err != nil {
+		return nil, err
+	}
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	return otc.GenerateTx(ctx, req)
+}
+
+// simulate simulates req with the wrapped client and keeps the result.
+func (c *simulateClient) simulate(ctx context.Context, req *sdk.TxRequest) error {
+	sim, ok := c.Client.(sdk.TxSimulator)
+	if !ok {
+		return fmt.Errorf("this client cannot simulate transactions: %w", sdk.ErrNotSupported)
+	}
+	result, err := sim.Simulate(ctx, req)
+	if err != nil {
+		return err
+	}
+	c.result = result
+	return nil
+}
+
+// SignTx returns the transaction unsigned; nothing is broadcast.
+func (c *simulateClient) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	return tx, nil
+}
+
+// BroadcastTx returns an empty response instead of broadcasting.
+func (c *simulateClient) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	return &sdk.TxResponse{}, nil
+}
+
+// buildTxSimulateCommand builds "tx simulate". It mirrors every tx command
+// with the same args and flags, but estimates gas instead of broadcasting.
+func (a *App) buildTxSimulateCommand() *cli.Command {
+	cmd := a.buildTxCommand()
+	cmd.Name = "simulate"
+	cmd.Short = "Estimate gas for a transaction without broadcasting"
+	cmd.Long = `Build any transaction exactly as the matching tx command would, simulate it,
+and print the estimated gas plus the gas limit after --gas-adjustment.
+Nothing is broadcast.`
+	cmd.Usage = `  sekai-cli tx simulate bank send genesis kira1... 100ukex
+  sekai-cli tx simulate multistaking delegate kiravaloper1... 1000ukex --from genesis --gas-adjustment 1.5`
+
+	a.addSimulateSupport(cmd)
+	return cmd
+}
+
+// addSimulateSupport wraps every runnable command under cmd so its
+// transaction is simulated and the estimate printed instead.
+func (a *App) addSimulateSupport(cmd *cli.Command) {
+	for _, sub := range cmd.SubCommands {
+		a.addSimulateSupport(sub)
+	}
+	if cmd.Run == nil {
+		return
+	}
+
+	run := cmd.Run
+	cmd.Run = func(ctx *cli.Context) error {
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		sim := &simulateClient{Client: client}
+		a.client = sim
+		defer func() { a.client = client }()
+
+		// Nothing is broadcast, so there is nothing to confirm.
+		ctx.Flags["yes"] = "true"
+		a.capture = func(interface{}) {}
+		err = run(ctx)
+		a.capture = nil
+		if err != nil {
+			return err
+		}
+		if sim.result == nil {
+			return fmt.Errorf("command did not build a transaction to simulate")
+		}
+		return a.printOutput(ctx, sim.result)
+	}
+}
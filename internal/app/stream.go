@@ -0,0 +1,49 @@
+package app
+
+import (
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/output"
+)
+
+// streamFlag is the --stream flag of queries that can print every page of
+// a list as it arrives.
+var streamFlag = cli.Flag{Name: "stream", Bool: true, Usage: "With --output json, query every page and print the results as one JSON array while they arrive, instead of holding them all in memory"}
+
+// jsonStream prints the elements of a list query as a JSON array while
+// its pages arrive, humanized with --humanize.
+type jsonStream struct {
+	*output.JSONArrayWriter
+	resolve output.DenomResolver
+}
+
+// Write appends v to the array.
+func (s *jsonStream) Write(v interface{}) error {
+	if s.resolve != nil {
+		v = output.Humanize(v, s.resolve)
+	}
+	return s.JSONArrayWriter.Write(v)
+}
+
+// newJSONStream returns the stream for --stream, or nil without it.
+// --stream needs --output json, and cannot be combined with the other
+// flags given, whose options need the whole result. A query that fails
+// part way must not Close the stream, so the output is not valid JSON.
+func (a *App) newJSONStream(ctx *cli.Context, conflicts ...string) (*jsonStream, error) {
+	if ctx.GetFlag("stream") != "true" {
+		return nil, nil
+	}
+	formatter, ok := a.getFormatter(ctx).(*output.JSONFormatter)
+	if !ok {
+		return nil, cli.Usagef("--stream requires --output json")
+	}
+	for _, name := range append([]string{"field", "count-total", "watch"}, conflicts...) {
+		if v := ctx.GetFlag(name); v != "" && v != "false" {
+			return nil, cli.Usagef("--stream cannot be combined with --%s", name)
+		}
+	}
+	s := &jsonStream{JSONArrayWriter: output.NewJSONArrayWriter(ctx.Stdout, formatter)}
+	if ctx.GetFlag("humanize") == "true" {
+		s.resolve = a.denomResolver(ctx)
+	}
+	return s, nil
+}
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Default --timeout of a request to the node. Transactions get longer,
+// since signing and gas estimation take extra round trips.
+const (
+	defaultQueryTimeout = 30 * time.Second
+	defaultTxTimeout    = 60 * time.Second
+)
+
+// requestTimeouts returns the timeouts of query and transaction requests:
+// --timeout for both if set, the defaults otherwise. 0 disables them.
+// Commands with their own "timeout" flag, such as gov set-execution-fee,
+// always get the defaults.
+func requestTimeouts(ctx *cli.Context) (query, tx time.Duration, err error) {
+	value := ctx.GetFlag("timeout")
+	if value == "" || hasFlag(ctx.Command, "timeout") {
+		return defaultQueryTimeout, defaultTxTimeout, nil
+	}
+	timeout, err := parseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, 0, cli.Usagef("invalid --timeout: %s", value)
+	}
+	return timeout, timeout, nil
+}
+
+// timeoutClient wraps a client so that every request is canceled when
+// its timeout passes. Waiting for a transaction to be included is not a
+// single request: it polls with queries and is bounded by --wait-timeout.
+type timeoutClient struct {
+	sdk.Client
+	query time.Duration
+	tx    time.Duration
+}
+
+// newTimeoutClient wraps client with the given request timeouts. A zero
+// timeout leaves those requests unbounded.
+func newTimeoutClient(client sdk.Client, query, tx time.Duration) sdk.Client {
+	if query <= 0 && tx <= 0 {
+		return client
+	}
+	return &timeoutClient{Client: client, query: query, tx: tx}
+}
+
+// Query runs the query with the query timeout.
+func (c *timeoutClient) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	ctx, done := withRequestTimeout(ctx, c.query)
+	resp, err := c.Client.Query(ctx, req)
+	return resp, done(err)
+}
+
+// Tx runs the transaction with the transaction timeout.
+func (c *timeoutClient) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	ctx, done := withRequestTimeout(ctx, c.tx)
+	resp, err := c.Client.Tx(ctx, req)
+	return resp, done(err)
+}
+
+// Simulate runs the simulation with the transaction timeout, if the
+// wrapped client can simulate transactions.
+func (c *timeoutClient) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	sim, ok := c.Client.(sdk.TxSimulator)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.tx)
+	resp, err := sim.Simulate(ctx, req)
+	return resp, done(err)
+}
+
+// GenerateTx generates the transaction with the transaction timeout, if
+// the wrapped client can build transactions offline.
+func (c *timeoutClient) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.tx)
+	tx, err := otc.GenerateTx(ctx, req)
+	return tx, done(err)
+}
+
+// SignTx signs the transaction with the transaction timeout, if the
+// wrapped client can sign transactions offline.
+func (c *timeoutClient) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.tx)
+	signed, err := otc.SignTx(ctx, tx, opts)
+	return signed, done(err)
+}
+
+// BroadcastTx broadcasts the transaction with the transaction timeout, if
+// the wrapped client can broadcast signed transactions.
+func (c *timeoutClient) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.tx)
+	resp, err := otc.BroadcastTx(ctx, tx, mode)
+	return resp, done(err)
+}
+
+// EncodeTx encodes the transaction with the query timeout, if the wrapped
+// client can encode transactions.
+func (c *timeoutClient) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	codec, ok := c.Client.(sdk.TxCodec)
+	if !ok {
+		return "", sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.query)
+	encoded, err := codec.EncodeTx(ctx, tx)
+	return encoded, done(err)
+}
+
+// DecodeTx decodes the transaction with the query timeout, if the wrapped
+// client can decode transactions.
+func (c *timeoutClient) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	codec, ok := c.Client.(sdk.TxCodec)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.query)
+	tx, err := codec.DecodeTx(ctx, txBytes)
+	return tx, done(err)
+}
+
+// Status queries the node status with the query timeout.
+func (c *timeoutClient) Status(ctx context.Context) (*sdk.StatusResponse, error) {
+	ctx, done := withRequestTimeout(ctx, c.query)
+	resp, err := c.Client.Status(ctx)
+	return resp, done(err)
+}
+
+// NetInfo returns the node's peers with the query timeout, if the
+// wrapped client can report them.
+func (c *timeoutClient) NetInfo(ctx context.Context) (*sdk.NetInfo, error) {
+	nic, ok := c.Client.(sdk.NetInfoClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.query)
+	info, err := nic.NetInfo(ctx)
+	return info, done(err)
+}
+
+// AppVersion returns the node's sekaid version with the query timeout, if
+// the wrapped client can report it.
+func (c *timeoutClient) AppVersion(ctx context.Context) (string, error) {
+	avc, ok := c.Client.(sdk.AppVersionClient)
+	if !ok {
+		return "", sdk.ErrNotSupported
+	}
+	ctx, done := withRequestTimeout(ctx, c.query)
+	version, err := avc.AppVersion(ctx)
+	return version, done(err)
+}
+
+// withRequestTimeout derives a context that is canceled after timeout,
+// or ctx itself if timeout is 0. done releases the context and replaces
+// the error of a request that ran out of time, which is often just
+// "signal: killed" from an exec'd sekaid, with a clear one.
+func withRequestTimeout(ctx context.Context, timeout time.Duration) (context.Context, func(error) error) {
+	if timeout <= 0 {
+		return ctx, func(err error) error { return err }
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func(err error) error {
+		defer cancel()
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("request timed out after %s (use --timeout to allow longer)", timeout)
+		}
+		return err
+	}
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/tokens"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// buildToolsCommand builds the tools command group.
+func (a *App) buildToolsCommand() *cli.Command {
+	cmd := cli.NewCommand("tools")
+	cmd.Short = "Helpers for working with amounts"
+	cmd.AddCommand(a.buildToolsCoinsCommand())
+	return cmd
+}
+
+// buildToolsCoinsCommand builds "tools coins".
+func (a *App) buildToolsCoinsCommand() *cli.Command {
+	coinsCmd := cli.NewCommand("coins")
+	coinsCmd.Short = "Add, subtract and convert coin amounts"
+
+	addCmd := cli.NewCommand("add")
+	addCmd.Short = "Add coin amounts"
+	addCmd.Long = `Add coin amounts, each a coin list such as 100ukex or 100ukex,5lol. Amounts
+of the same denom are summed; other denoms are kept as separate coins.`
+	addCmd.Usage = `  sekai-cli tools coins add 100ukex 50ukex
+  sekai-cli tools coins add 100ukex,5lol 50ukex 1samolean`
+	addCmd.Args = []cli.Arg{
+		{Name: "coins...", Required: true, Description: "Coin amounts to add (space-separated)"},
+	}
+	addCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return cli.Usagef("at least two amounts required")
+		}
+		coins, err := parseCoinArgs(ctx.Args)
+		if err != nil {
+			return err
+		}
+		sum := types.Coins{}
+		for _, c := range coins {
+			if sum, err = sum.Add(c); err != nil {
+				return err
+			}
+		}
+		return a.printOutput(ctx, sum)
+	}
+	coinsCmd.AddCommand(addCmd)
+
+	subCmd := cli.NewCommand("sub")
+	subCmd.Short = "Subtract coin amounts from the first"
+	subCmd.Long = `Subtract the other coin amounts from the first. Denoms that drop to zero
+are omitted. Subtracting more of a denom than the first amount holds,
+including a denom it does not hold at all, is an error.`
+	subCmd.Usage = `  sekai-cli tools coins sub 100ukex,5lol 30ukex
+  sekai-cli tools coins sub 1000000ukex 200ukex 100ukex`
+	subCmd.Args = []cli.Arg{
+		{Name: "coins", Required: true, Description: "Amount to subtract from"},
+		{Name: "subtract...", Required: true, Description: "Amounts to subtract (space-separated)"},
+	}
+	subCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) < 2 {
+			return cli.Usagef("at least two amounts required")
+		}
+		coins, err := parseCoinArgs(ctx.Args)
+		if err != nil {
+			return err
+		}
+		diff := coins[0]
+		for _, c := range coins[1:] {
+			if diff, err = diff.Sub(c); err != nil {
+				return fmt.Errorf("result would be negative: %w", err)
+			}
+		}
+		return a.printOutput(ctx, diff)
+	}
+	coinsCmd.AddCommand(subCmd)
+
+	convertCmd := cli.NewCommand("convert")
+	convertCmd.Short = "Convert an amount between a token's base denom and symbol"
+	convertCmd.Long = `Convert an amount between the base denom of a token and its symbol, using
+the decimals of the network's token rates. Symbols match case-insensitively,
+so with KEX of 6 decimals on ukex, 1.5kex converts to 1500000ukex.`
+	convertCmd.Usage = `  sekai-cli tools coins convert 1kex --to ukex
+  sekai-cli tools coins convert 2500000ukex --to kex`
+	convertCmd.Args = []cli.Arg{
+		{Name: "coin", Required: true, Description: "Amount to convert, e.g. 1.5kex"},
+	}
+	convertCmd.Flags = []cli.Flag{
+		{Name: "to", Usage: "Denom or symbol to convert to", Required: true},
+	}
+	convertCmd.Run = func(ctx *cli.Context) error {
+		if len(ctx.Args) != 1 {
+			return cli.Usagef("one amount required")
+		}
+		coin, err := types.ParseDecCoin(ctx.Args[0])
+		if err != nil {
+			return cli.Usagef("invalid amount: %v", err)
+		}
+		client, err := a.getClient(ctx)
+		if err != nil {
+			return err
+		}
+		registry, err := tokens.New(client).DenomRegistry(context.Background())
+		if err != nil {
+			return err
+		}
+		result, err := registry.Convert(coin, ctx.GetFlag("to"))
+		if err != nil {
+			return err
+		}
+		return a.printOutput(ctx, result)
+	}
+	coinsCmd.AddCommand(convertCmd)
+
+	return coinsCmd
+}
+
+// parseCoinArgs parses each argument as a coin list.
+func parseCoinArgs(args []string) ([]types.Coins, error) {
+	coins := make([]types.Coins, len(args))
+	for i, arg := range args {
+		c, err := types.ParseCoins(arg)
+		if err != nil {
+			return nil, cli.Usagef("invalid amount %q: %v", arg, err)
+		}
+		coins[i] = c
+	}
+	return coins, nil
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+)
+
+// withTotal adds the number of results to a list result for --count-total.
+// A paginated result gets the total its backend counted; a list that is
+// not paginated, or fits in one page, gets its length, since it holds
+// every item. The list stays where it was: wrappers such as
+// {"validators": [...]} get a "total" field, and a bare list becomes
+// {"items": [...], "total": N}. ok is false if data is not a list or the
+// total is unknown, e.g. a later page from a backend that does not count.
+func withTotal(ctx *cli.Context, data interface{}) (result interface{}, total uint64, ok bool) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return data, 0, false
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		return data, 0, false
+	}
+
+	switch v := parsed.(type) {
+	case []interface{}:
+		if paged(ctx) {
+			return data, 0, false
+		}
+		total = uint64(len(v))
+		return map[string]interface{}{"items": v, "total": total}, total, true
+	case map[string]interface{}:
+		var page struct {
+			Pagination struct {
+				NextKey string `json:"next_key"`
+				Total   string `json:"total"`
+			} `json:"pagination"`
+		}
+		_ = json.Unmarshal(b, &page)
+		if n, err := strconv.ParseUint(page.Pagination.Total, 10, 64); err == nil && n > 0 {
+			total = n
+		} else if list, found := singleList(v); found && page.Pagination.NextKey == "" && !paged(ctx) {
+			total = uint64(len(list))
+		} else {
+			return data, 0, false
+		}
+		v["total"] = total
+		return v, total, true
+	default:
+		return data, 0, false
+	}
+}
+
+// singleList returns the only list field of a response wrapper, like
+// the table output shows it.
+func singleList(v map[string]interface{}) ([]interface{}, bool) {
+	var list []interface{}
+	found := false
+	for _, field := range v {
+		if items, ok := field.([]interface{}); ok {
+			if found {
+				return nil, false
+			}
+			list, found = items, true
+		}
+	}
+	return list, found
+}
+
+// paged reports whether a query asked for a page other than the first,
+// whose length is not the total.
+func paged(ctx *cli.Context) bool {
+	return ctx.GetFlag("offset") != "" || ctx.GetFlag("page-key") != "" || (ctx.GetFlag("page") != "" && ctx.GetFlag("page") != "1")
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// versionCheck is the result of "version --check".
+type versionCheck struct {
+	CLIVersion  string `json:"cli_version"`
+	NodeVersion string `json:"node_version"`
+	Supported   string `json:"supported_node_versions"`
+	Compatible  bool   `json:"compatible"`
+}
+
+// checkNodeVersion prints the CLI version and the node's sekaid version,
+// and warns on stderr if the node's version is outside the supported range
+// or cannot be compared.
+func (a *App) checkNodeVersion(ctx *cli.Context, client sdk.Client) error {
+	avc, ok := client.(sdk.AppVersionClient)
+	if !ok {
+		return fmt.Errorf("this client cannot report the node's sekaid version")
+	}
+	version, err := avc.AppVersion(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to get the node's sekaid version: %w", err)
+	}
+
+	check := versionCheck{
+		CLIVersion:  sdk.Version(),
+		NodeVersion: version,
+		Supported:   sdk.SekaidVersionRange(),
+	}
+	compatible, err := sdk.IsSupportedSekaidVersion(version)
+	check.Compatible = compatible
+	if err := a.printOutput(ctx, check); err != nil {
+		return err
+	}
+
+	switch {
+	case err != nil:
+		ctx.Errorf("Warning: cannot compare sekaid version %q with the supported range %s\n", version, check.Supported)
+	case !compatible:
+		ctx.Errorf("Warning: sekaid %s is outside the supported range %s; some commands may fail or misread output\n", version, check.Supported)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/internal/output"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
+)
+
+// watchMaxErrors is the number of consecutive failed polls after which
+// watch mode gives up.
+const watchMaxErrors = 5
+
+// addWatchSupport adds --watch and --diff to every runnable command under cmd
+// and wraps its Run so the command is polled when --watch is given.
+func (a *App) addWatchSupport(cmd *cli.Command) {
+	for _, sub := range cmd.SubCommands {
+		a.addWatchSupport(sub)
+	}
+	if cmd.Run == nil {
+		return
+	}
+
+	cli.AddWatchFlags(cmd)
+	run := cmd.Run
+	cmd.Run = func(ctx *cli.Context) error {
+		if ctx.GetFlag("watch") == "" {
+			if ctx.GetFlag("diff") == "true" {
+				return cli.Usagef("--diff requires --watch")
+			}
+			return run(ctx)
+		}
+		interval, err := parseDuration(ctx.GetFlag("watch"))
+		if err != nil || interval <= 0 {
+			return cli.Usagef("invalid --watch interval: %s", ctx.GetFlag("watch"))
+		}
+		return a.watch(ctx, run, interval)
+	}
+}
+
+// watch re-runs a command every interval until interrupted, printing each
+// result under a timestamp header. On a terminal the screen is redrawn for
+// each poll; otherwise results are appended so logs stay intact. With
+// --diff, changes from the previous poll are highlighted instead of
+// printing the full result. Watching stops after watchMaxErrors
+// consecutive failures. An interrupt also cancels the queries of a poll
+// in progress.
+func (a *App) watch(ctx *cli.Context, run cli.RunFunc, interval time.Duration) error {
+	sigCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := a.getClient(ctx)
+	if err != nil {
+		return err
+	}
+	a.client = &interruptClient{Client: client, interrupt: sigCtx}
+	defer func() { a.client = client }()
+
+	showDiff := ctx.GetFlag("diff") == "true"
+	redraw := output.IsTerminal(ctx.Stdout)
+	var prev interface{}
+	failures := 0
+
+	for {
+		var data interface{}
+		a.capture = func(d interface{}) { data = d }
+		err := run(ctx)
+		a.capture = nil
+
+		if sigCtx.Err() != nil {
+			return nil
+		}
+		if redraw {
+			ctx.Printf("\x1b[H\x1b[2J")
+		}
+		ctx.Printf("Every %s: %s\n\n", interval, time.Now().Format(time.RFC3339))
+		switch {
+		case err != nil:
+			ctx.Errorf("Error: %v\n", err)
+			if failures++; failures >= watchMaxErrors {
+				return fmt.Errorf("stopped watching after %d consecutive errors: %w", failures, err)
+			}
+		case showDiff && prev != nil:
+			if err := a.printDiff(ctx, prev, data); err != nil {
+				return err
+			}
+		default:
+			if err := a.printOutput(ctx, data); err != nil {
+				return err
+			}
+		}
+		if err == nil {
+			prev = data
+			failures = 0
+		}
+		ctx.Println()
+
+		select {
+		case <-sigCtx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// interruptClient wraps a client so that the queries of a watch poll are
+// canceled when the watch is interrupted, rather than holding up the exit
+// until they complete or time out.
+type interruptClient struct {
+	sdk.Client
+	interrupt context.Context
+}
+
+// Query runs the query until it completes or the watch is interrupted.
+func (c *interruptClient) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	ctx, cancel := c.bind(ctx)
+	defer cancel()
+	return c.Client.Query(ctx, req)
+}
+
+// Status queries the node status until it completes or the watch is
+// interrupted.
+func (c *interruptClient) Status(ctx context.Context) (*sdk.StatusResponse, error) {
+	ctx, cancel := c.bind(ctx)
+	defer cancel()
+	return c.Client.Status(ctx)
+}
+
+// NetInfo returns the node's peers, if the wrapped client can report
+// them, until the watch is interrupted.
+func (c *interruptClient) NetInfo(ctx context.Context) (*sdk.NetInfo, error) {
+	nic, ok := c.Client.(sdk.NetInfoClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	ctx, cancel := c.bind(ctx)
+	defer cancel()
+	return nic.NetInfo(ctx)
+}
+
+// bind derives a context from ctx that is also canceled on interrupt.
+func (c *interruptClient) bind(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(c.interrupt, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// printDiff prints the changes between two polls. Text output shows the full
+// result with changed fields highlighted; json/yaml output lists the changes.
+func (a *App) printDiff(ctx *cli.Context, prev, curr interface{}) error {
+	switch a.getFormatter(ctx).(type) {
+	case *output.TextFormatter:
+		f := &output.DiffFormatter{Color: a.colorEnabled(ctx)}
+		return f.Format(ctx.Stdout, prev, curr)
+	default:
+		changes, err := output.Diff(prev, curr)
+		if err != nil {
+			return err
+		}
+		if changes == nil {
+			changes = []output.Change{}
+		}
+		return a.printOutput(ctx, changes)
+	}
+}
+
+// watchProposalUntilFinal polls a proposal until it reaches a final result,
+// printing each result change to stderr and the final proposal to stdout.
+// A timeout or interrupt is an error naming the last result seen, so
+// pipelines can tell a stalled proposal from a decided one.
+func (a *App) watchProposalUntilFinal(ctx *cli.Context, govMod *gov.Module, proposalID string) error {
+	interval, err := parseDuration(ctx.GetFlag("poll"))
+	if err != nil || interval <= 0 {
+		return cli.Usagef("invalid --poll interval: %s", ctx.GetFlag("poll"))
+	}
+	timeout, err := parseDuration(ctx.GetFlag("watch-timeout"))
+	if err != nil || timeout <= 0 {
+		return cli.Usagef("invalid --watch-timeout: %s", ctx.GetFlag("watch-timeout"))
+	}
+
+	sigCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	proposal, err := govMod.WaitFinal(sigCtx, proposalID, &gov.WaitOptions{
+		Timeout:      timeout,
+		PollInterval: interval,
+		OnChange: func(prev, curr *gov.Proposal) {
+			now := time.Now().Format(time.RFC3339)
+			if prev == nil {
+				ctx.Errorf("%s proposal %s: %s\n", now, proposalID, curr.Result)
+				return
+			}
+			ctx.Errorf("%s proposal %s: %s -> %s\n", now, proposalID, prev.Result, curr.Result)
+			if curr.ExecResult != "" && curr.ExecResult != prev.ExecResult {
+				ctx.Errorf("%s proposal %s: exec result: %s\n", now, proposalID, curr.ExecResult)
+			}
+		},
+	})
+	switch {
+	case err == nil:
+		return a.printOutput(ctx, proposal)
+	case errors.Is(err, gov.ErrNotFinal):
+		return fmt.Errorf("timed out after %s waiting for proposal %s to be final; last result: %s", timeout, proposalID, lastResult(proposal))
+	case sigCtx.Err() != nil:
+		return fmt.Errorf("interrupted waiting for proposal %s; last result: %s", proposalID, lastResult(proposal))
+	default:
+		return err
+	}
+}
+
+// lastResult returns the result of the last proposal seen while waiting.
+func lastResult(p *gov.Proposal) string {
+	if p == nil || p.Result == "" {
+		return "unknown"
+	}
+	return p.Result
+}
@@ -0,0 +1,227 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/internal/cache"
+	"github.com/kiracore/sekai-cli/internal/cli"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// prompter asks questions on stderr and reads answers from stdin,
+// keeping stdout free for the command result.
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// newPrompter creates a prompter bound to the command's streams.
+func newPrompter(ctx *cli.Context) *prompter {
+	return &prompter{
+		in:  bufio.NewReader(ctx.Stdin),
+		out: ctx.Stderr,
+	}
+}
+
+// ask prompts for a free-form value. An empty answer returns def.
+func (p *prompter) ask(label, def string) (string, error) {
+	if def != "" {
+		fmt.Fprintf(p.out, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(p.out, "%s: ", label)
+	}
+	line, err := p.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	answer := strings.TrimSpace(line)
+	if answer == "" {
+		return def, nil
+	}
+	return answer, nil
+}
+
+// choose prompts for one of options. The answer may be the option number
+// or a typed value; a typed value not in options is returned as-is.
+func (p *prompter) choose(label string, options []string, def string) (string, error) {
+	for i, opt := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, opt)
+	}
+	answer, err := p.ask(label, def)
+	if err != nil {
+		return "", err
+	}
+	if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(options) {
+		return options[n-1], nil
+	}
+	return answer, nil
+}
+
+// confirm asks a yes/no question, defaulting to no.
+func (p *prompter) confirm(label string) (bool, error) {
+	answer, err := p.ask(label+" (y/N)", "")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// runSendWizard walks the user through a bank send step by step.
+// Any of from, to and amount already given as positional args are used
+// as-is and not prompted for.
+func (a *App) runSendWizard(ctx *cli.Context, client sdk.Client) error {
+	p := newPrompter(ctx)
+	cached := a.loadCache(ctx)
+
+	// Sender: pick from cached keys
+	from := ctx.GetArg(0)
+	if from == "" {
+		var names []string
+		defaultKey := ""
+		if cached != nil {
+			for _, k := range cached.Keys {
+				names = append(names, k.Name)
+			}
+			defaultKey = cached.GetDefaultKey()
+		}
+		if len(names) > 0 {
+			fmt.Fprintln(p.out, "Select sender key:")
+		}
+		answer, err := p.choose("Sender", names, defaultKey)
+		if err != nil {
+			return err
+		}
+		from = answer
+	}
+	if from == "" {
+		return fmt.Errorf("sender required")
+	}
+
+	// Recipient: an address, or a cached key name or address book alias
+	// resolved to its address
+	to := ctx.GetArg(1)
+	if to == "" {
+		var names []string
+		if cached != nil {
+			for _, k := range cached.Keys {
+				if k.Name != from {
+					names = append(names, k.Name)
+				}
+			}
+		}
+		if len(names) > 0 {
+			fmt.Fprintln(p.out, "Select recipient key or enter an address:")
+		}
+		answer, err := p.choose("Recipient", names, "")
+		if err != nil {
+			return err
+		}
+		to = answer
+	}
+	if cached != nil {
+		if k := cached.GetKeyByName(to); k != nil {
+			to = k.Address
+		}
+	}
+	if address, ok := a.config.LookupAddress(to); ok && !types.IsValidAddress(to) {
+		to = address
+	}
+	if !types.IsValidAddress(to) {
+		return fmt.Errorf("invalid recipient address: %s", to)
+	}
+
+	// Amount: number plus a denom picked from the sender's balances
+	amount := ctx.GetArg(2)
+	if amount == "" {
+		value, err := p.ask("Amount", "")
+		if err != nil {
+			return err
+		}
+		if _, err := types.ParseCoin(value); err == nil {
+			amount = value
+		} else {
+			denoms := []string{sdk.DefaultDenom}
+			if addr := wizardKeyAddress(cached, from); addr != "" {
+				if balances, err := bank.New(client).Balances(context.Background(), addr); err == nil && len(balances) > 0 {
+					denoms = denoms[:0]
+					for _, c := range balances {
+						denoms = append(denoms, c.Denom)
+					}
+				}
+			}
+			fmt.Fprintln(p.out, "Select denom:")
+			denom, err := p.choose("Denom", denoms, denoms[0])
+			if err != nil {
+				return err
+			}
+			amount = value + denom
+		}
+	}
+	coins, err := types.ParseCoins(amount)
+	if err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+
+	// The fee the client pays without --fees, as confirmTx shows it
+	fees, err := a.txFees(ctx, cached)
+	if err != nil {
+		return err
+	}
+
+	// Summary and confirmation
+	fmt.Fprintln(p.out)
+	fmt.Fprintln(p.out, "Transaction summary:")
+	fmt.Fprintf(p.out, "  From:   %s\n", from)
+	fmt.Fprintf(p.out, "  To:     %s\n", to)
+	fmt.Fprintf(p.out, "  Amount: %s\n", coins.String())
+	fmt.Fprintf(p.out, "  Fees:   %s\n", getStringOrDefault(fees, "(default)"))
+	if memo := ctx.GetFlag("memo"); memo != "" {
+		fmt.Fprintf(p.out, "  Memo:   %s\n", memo)
+	}
+	if ctx.GetFlag("yes") != "true" {
+		ok, err := p.confirm("Broadcast transaction?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("transaction cancelled")
+		}
+	}
+
+	opts := &bank.SendOptions{
+		Fees:          ctx.GetFlag("fees"),
+		Gas:           ctx.GetFlag("gas"),
+		Memo:          ctx.GetFlag("memo"),
+		BroadcastMode: ctx.GetFlag("broadcast-mode"),
+	}
+	resp, err := bank.New(client).Send(context.Background(), from, to, coins, opts)
+	if err != nil {
+		return err
+	}
+	return a.printOutput(ctx, resp)
+}
+
+// wizardKeyAddress resolves a key name or address to an address using the cache.
+func wizardKeyAddress(cached *cache.Cache, nameOrAddr string) string {
+	if types.IsValidAddress(nameOrAddr) {
+		return nameOrAddr
+	}
+	if cached != nil {
+		if k := cached.GetKeyByName(nameOrAddr); k != nil {
+			return k.Address
+		}
+	}
+	return ""
+}
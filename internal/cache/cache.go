@@ -8,11 +8,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 )
 
-// Cache holds cached network configuration.
+// Cache holds the cached configuration of one network.
 type Cache struct {
 	// Version is the cache format version for future compatibility.
 	Version int `json:"version"`
@@ -20,6 +21,9 @@ type Cache struct {
 	// LastSync is when the cache was last updated.
 	LastSync time.Time `json:"last_sync"`
 
+	// CachedAt is when the network properties were last fetched.
+	CachedAt time.Time `json:"cached_at,omitempty"`
+
 	// Container is the Docker container name.
 	Container string `json:"container"`
 
@@ -32,8 +36,41 @@ type Cache struct {
 	// DefaultKey is the default signing key name.
 	DefaultKey string `json:"default_key"`
 
+	// Denoms are the denoms with a token rate, used for shell completion.
+	Denoms []string `json:"denoms,omitempty"`
+
+	// FeeRates maps the denoms enabled for fee payments to their token fee
+	// rate, the ukex value of one unit, to convert fees paid in --fee-denom.
+	FeeRates map[string]string `json:"fee_rates,omitempty"`
+
+	// Monikers maps validator account, operator and consensus addresses
+	// to validator monikers, shown next to addresses in query results.
+	Monikers map[string]string `json:"monikers,omitempty"`
+
 	// cachePath is the path where cache was loaded from.
 	cachePath string
+
+	// loadedAs is the chain ID the entry was stored under when loaded.
+	loadedAs string
+}
+
+// storeVersion is the version of the multi-network cache file format.
+const storeVersion = 2
+
+// store is the cache file: one entry per chain ID, plus the last used one.
+type store struct {
+	Version  int               `json:"version"`
+	Active   string            `json:"active"`
+	Networks map[string]*Cache `json:"networks"`
+}
+
+// chainID selects the cached network; empty selects the last used one.
+var chainID string
+
+// SelectChainID selects the cached network to load. An empty ID selects
+// the last used network.
+func SelectChainID(id string) {
+	chainID = id
 }
 
 // NetworkCache contains cached network properties.
@@ -51,6 +88,8 @@ type NetworkCache struct {
 	UnjailMaxTime            string `json:"unjail_max_time"`
 	UnstakingPeriod          string `json:"unstaking_period"`
 	MaxDelegators            string `json:"max_delegators"`
+	// MaxMemoCharacters is the auth module's memo length limit.
+	MaxMemoCharacters string `json:"max_memo_characters,omitempty"`
 }
 
 // KeyCache contains cached key information.
@@ -60,11 +99,17 @@ type KeyCache struct {
 	Type    string `json:"type"`
 }
 
+// DefaultTTL is how long cached network properties are trusted before
+// commands warn that they may be outdated.
+const DefaultTTL = 24 * time.Hour
+
 // New creates a new empty cache.
 func New() *Cache {
+	now := time.Now()
 	return &Cache{
 		Version:  1,
-		LastSync: time.Now(),
+		LastSync: now,
+		CachedAt: now,
 		Keys:     []KeyCache{},
 	}
 }
@@ -75,8 +120,33 @@ func Load() (*Cache, error) {
 	return LoadFromFile(path)
 }
 
-// LoadFromFile loads cache from a specific file.
+// LoadFromFile loads the selected network's cache from a specific file.
 func LoadFromFile(path string) (*Cache, error) {
+	st, err := loadStore(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := chainID
+	if key == "" {
+		key = st.Active
+	}
+	cache, ok := st.Networks[key]
+	if !ok {
+		if chainID != "" {
+			return nil, fmt.Errorf("no cache for chain %s (run 'sekai-cli init' against it first)", chainID)
+		}
+		return nil, fmt.Errorf("no active network in cache (run 'sekai-cli cache use <chain-id>')")
+	}
+
+	cache.cachePath = path
+	cache.loadedAs = key
+	return cache, nil
+}
+
+// loadStore reads the cache file, migrating a single-network cache
+// written by earlier versions.
+func loadStore(path string) (*store, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -85,13 +155,83 @@ func LoadFromFile(path string) (*Cache, error) {
 		return nil, fmt.Errorf("failed to read cache file: %w", err)
 	}
 
-	var cache Cache
-	if err := json.Unmarshal(data, &cache); err != nil {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return nil, fmt.Errorf("failed to parse cache: %w", err)
 	}
 
-	cache.cachePath = path
-	return &cache, nil
+	st := &store{Version: storeVersion, Networks: make(map[string]*Cache)}
+	if _, ok := raw["networks"]; ok {
+		if err := json.Unmarshal(data, st); err != nil {
+			return nil, fmt.Errorf("failed to parse cache: %w", err)
+		}
+		if st.Networks == nil {
+			st.Networks = make(map[string]*Cache)
+		}
+		return st, nil
+	}
+
+	// Single-network cache: becomes the only (and active) entry
+	var legacy Cache
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return nil, fmt.Errorf("failed to parse cache: %w", err)
+	}
+	key := legacy.key()
+	st.Active = key
+	st.Networks[key] = &legacy
+
+	// Best effort: a read-only cache still works from the migrated copy
+	_ = st.save(path)
+	return st, nil
+}
+
+// save writes the cache file.
+func (st *store) save(path string) error {
+	st.Version = storeVersion
+
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	// Ensure directory exists
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Write to a temporary file and rename it over the cache, so an
+	// interrupted write never leaves a truncated cache behind
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+// key returns the chain ID the entry is stored under.
+func (c *Cache) key() string {
+	if c.Network.ChainID != "" {
+		return c.Network.ChainID
+	}
+	if c.Container != "" {
+		return c.Container
+	}
+	return "unknown"
 }
 
 // TryLoad attempts to load cache, returning nil if not found.
@@ -108,32 +248,105 @@ func (c *Cache) Save() error {
 	return c.SaveToFile(DefaultCachePath())
 }
 
-// SaveToFile saves cache to a specific file.
+// SaveToFile saves the network's cache to a specific file, keeping the
+// other networks in it, and makes it the active network.
 func (c *Cache) SaveToFile(path string) error {
 	c.LastSync = time.Now()
 
-	data, err := json.MarshalIndent(c, "", "  ")
+	st, err := loadStore(path)
 	if err != nil {
-		return fmt.Errorf("failed to marshal cache: %w", err)
+		if _, statErr := os.Stat(path); statErr == nil {
+			return err
+		}
+		st = &store{Networks: make(map[string]*Cache)}
 	}
 
-	// Ensure directory exists
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create cache directory: %w", err)
+	// The chain ID changes when a network is reset
+	key := c.key()
+	if c.loadedAs != "" && c.loadedAs != key {
+		delete(st.Networks, c.loadedAs)
 	}
-
-	if err := os.WriteFile(path, data, 0644); err != nil {
-		return fmt.Errorf("failed to write cache file: %w", err)
+	st.Networks[key] = c
+	st.Active = key
+	if err := st.save(path); err != nil {
+		return err
 	}
 
 	c.cachePath = path
+	c.loadedAs = key
 	return nil
 }
 
-// DefaultCachePath returns the default cache file path.
-// Uses XDG_CACHE_HOME (~/.cache/sekai-cli) on Linux.
+// List returns all cached networks sorted by chain ID, and the chain ID of
+// the active one.
+func List() ([]*Cache, string, error) {
+	path := DefaultCachePath()
+	st, err := loadStore(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys := make([]string, 0, len(st.Networks))
+	for key := range st.Networks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	caches := make([]*Cache, len(keys))
+	for i, key := range keys {
+		caches[i] = st.Networks[key]
+		caches[i].cachePath = path
+		caches[i].loadedAs = key
+	}
+	return caches, st.Active, nil
+}
+
+// Use makes a cached network the active one.
+func Use(id string) error {
+	path := DefaultCachePath()
+	st, err := loadStore(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := st.Networks[id]; !ok {
+		return fmt.Errorf("no cache for chain %s (run 'sekai-cli init' against it first)", id)
+	}
+	st.Active = id
+	return st.save(path)
+}
+
+// HasNetwork returns true if the cache file holds an entry for the chain ID.
+func HasNetwork(id string) bool {
+	st, err := loadStore(DefaultCachePath())
+	if err != nil {
+		return false
+	}
+	_, ok := st.Networks[id]
+	return ok
+}
+
+// profile is the config profile whose cache is used; empty for the
+// default profile.
+var profile string
+
+// SetProfile selects the cache of a config profile, so that switching
+// profiles never mixes the chain ID or keys of different networks. The
+// default profile uses the original cache file.
+func SetProfile(name string) {
+	if name == "default" {
+		name = ""
+	}
+	profile = name
+}
+
+// DefaultCachePath returns the default cache file path for the selected
+// profile. Uses XDG_CACHE_HOME (~/.cache/sekai-cli) on Linux.
 func DefaultCachePath() string {
+	file := "cache.json"
+	if profile != "" {
+		file = "cache-" + profile + ".json"
+	}
+
 	cacheHome := os.Getenv("XDG_CACHE_HOME")
 	if cacheHome == "" {
 		if home, err := os.UserHomeDir(); err == nil {
@@ -141,12 +354,13 @@ func DefaultCachePath() string {
 		}
 	}
 	if cacheHome != "" {
-		return filepath.Join(cacheHome, "sekai-cli", "cache.json")
+		return filepath.Join(cacheHome, "sekai-cli", file)
 	}
-	return "./sekai-cli-cache.json"
+	return "./sekai-cli-" + file
 }
 
 // Exists returns true if a cache file exists at the default location.
+// Use HasNetwork to check for a specific network.
 func Exists() bool {
 	_, err := os.Stat(DefaultCachePath())
 	return err == nil
@@ -184,6 +398,23 @@ func (c *Cache) GetMinFee() string {
 	return c.Network.MinTxFee
 }
 
+// GetMaxFee returns the cached maximum transaction fee.
+func (c *Cache) GetMaxFee() string {
+	return c.Network.MaxTxFee
+}
+
+// GetMaxMemoCharacters returns the cached memo length limit, or "" if it
+// is not known.
+func (c *Cache) GetMaxMemoCharacters() string {
+	return c.Network.MaxMemoCharacters
+}
+
+// GetUnjailMaxTime returns the cached unjail window in seconds, or "" if it
+// is not known.
+func (c *Cache) GetUnjailMaxTime() string {
+	return c.Network.UnjailMaxTime
+}
+
 // GetContainer returns the cached container name.
 func (c *Cache) GetContainer() string {
 	return c.Container
@@ -230,14 +461,24 @@ func (c *Cache) KeyNames() []string {
 	return names
 }
 
-// Age returns how long ago the cache was last synced.
+// NetworkFetchedAt returns when the network properties were fetched.
+// Caches written before CachedAt existed fall back to the last sync time.
+func (c *Cache) NetworkFetchedAt() time.Time {
+	if c.CachedAt.IsZero() {
+		return c.LastSync
+	}
+	return c.CachedAt
+}
+
+// Age returns how long ago the network properties were fetched.
 func (c *Cache) Age() time.Duration {
-	return time.Since(c.LastSync)
+	return time.Since(c.NetworkFetchedAt())
 }
 
 // IsStale returns true if the cache is older than the given duration.
+// A zero maxAge never expires.
 func (c *Cache) IsStale(maxAge time.Duration) bool {
-	return c.Age() > maxAge
+	return maxAge > 0 && c.Age() > maxAge
 }
 
 // FormatAge returns a human-readable age string.
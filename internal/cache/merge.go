@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Change is a cached field that Merge changed.
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// String formats the change as "field: old -> new".
+func (ch Change) String() string {
+	switch {
+	case ch.Old == "":
+		return fmt.Sprintf("%s: %s", ch.Field, ch.New)
+	case ch.New == "":
+		return fmt.Sprintf("%s: removed %s", ch.Field, ch.Old)
+	}
+	return fmt.Sprintf("%s: %s -> %s", ch.Field, ch.Old, ch.New)
+}
+
+// LoadNetwork loads the cache entry of a chain ID from the default
+// location, regardless of the selected or active network.
+func LoadNetwork(id string) (*Cache, error) {
+	path := DefaultCachePath()
+	st, err := loadStore(path)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := st.Networks[id]
+	if !ok {
+		return nil, fmt.Errorf("no cache for chain %s (run 'sekai-cli init' against it first)", id)
+	}
+	c.cachePath = path
+	c.loadedAs = id
+	return c, nil
+}
+
+// Merge refreshes c with the container, network properties, keys, denoms
+// and monikers of fresh and returns what changed. The user's default key
+// is kept as long as the key still exists. Network properties, denoms and
+// monikers that fresh could not determine keep their cached values.
+func (c *Cache) Merge(fresh *Cache) []Change {
+	var changes []Change
+	change := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, Change{Field: field, Old: old, New: new})
+		}
+	}
+
+	change("container", c.Container, fresh.Container)
+	c.Container = fresh.Container
+
+	oldNet := reflect.ValueOf(&c.Network).Elem()
+	newNet := reflect.ValueOf(fresh.Network)
+	for i := 0; i < oldNet.NumField(); i++ {
+		old, new := oldNet.Field(i), newNet.Field(i)
+		if new.IsZero() && new.Kind() == reflect.String {
+			continue
+		}
+		name := strings.Split(oldNet.Type().Field(i).Tag.Get("json"), ",")[0]
+		change(name, fmt.Sprint(old.Interface()), fmt.Sprint(new.Interface()))
+		old.Set(new)
+	}
+	c.CachedAt = fresh.NetworkFetchedAt()
+
+	if len(fresh.Denoms) > 0 {
+		if len(fresh.Denoms) != len(c.Denoms) {
+			change("denoms", fmt.Sprint(len(c.Denoms)), fmt.Sprint(len(fresh.Denoms)))
+		}
+		c.Denoms = fresh.Denoms
+	}
+
+	if len(fresh.FeeRates) > 0 {
+		c.FeeRates = fresh.FeeRates
+	}
+
+	if len(fresh.Monikers) > 0 {
+		if len(fresh.Monikers) != len(c.Monikers) {
+			change("monikers", fmt.Sprint(len(c.Monikers)), fmt.Sprint(len(fresh.Monikers)))
+		}
+		c.Monikers = fresh.Monikers
+	}
+
+	for _, k := range fresh.Keys {
+		old := c.GetKeyByName(k.Name)
+		switch {
+		case old == nil:
+			change("key "+k.Name, "", "added "+k.Address)
+		case old.Address != k.Address:
+			change("key "+k.Name, old.Address, k.Address)
+		}
+	}
+	for _, k := range c.Keys {
+		if fresh.GetKeyByName(k.Name) == nil {
+			change("key "+k.Name, k.Address, "")
+		}
+	}
+	c.Keys = append([]KeyCache{}, fresh.Keys...)
+
+	if c.DefaultKey == "" || c.GetKeyByName(c.DefaultKey) == nil {
+		newDefault := fresh.DefaultKey
+		if newDefault == "" && len(c.Keys) > 0 {
+			newDefault = c.Keys[0].Name
+		}
+		change("default_key", c.DefaultKey, newDefault)
+		c.DefaultKey = newDefault
+	}
+
+	if c.Version < fresh.Version {
+		c.Version = fresh.Version
+	}
+	c.LastSync = time.Now()
+	return changes
+}
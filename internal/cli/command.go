@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -36,12 +38,27 @@ type Command struct {
 	// If nil, help is shown when the command is invoked without subcommands.
 	Run RunFunc
 
+	// PreRun runs before Run of this command and of all its subcommands,
+	// outermost command first. An error aborts the command.
+	PreRun RunFunc
+
 	// SubCommands are nested commands.
 	SubCommands []*Command
 
 	// Hidden hides the command from help output.
 	Hidden bool
 
+	// EnvPrefix, set on the root command, makes global flags settable
+	// through environment variables named after the flag, e.g. --chain-id
+	// is read from <EnvPrefix>_CHAIN_ID. Flags given on the command line
+	// take precedence; empty variables are ignored.
+	EnvPrefix string
+
+	// Passthrough passes all arguments after the command name to Run
+	// unparsed, as Context.Args. Used for commands that re-dispatch their
+	// arguments, such as user-defined aliases.
+	Passthrough bool
+
 	// parent is the parent command (set automatically).
 	parent *Command
 }
@@ -56,6 +73,9 @@ type Arg struct {
 
 	// Description is the argument description.
 	Description string
+
+	// Complete names the source of completion values, e.g. "keys".
+	Complete string
 }
 
 // Flag represents a command-line flag.
@@ -77,6 +97,21 @@ type Flag struct {
 
 	// Hidden hides the flag from help output.
 	Hidden bool
+
+	// Repeatable allows the flag to be given multiple times.
+	// All values are available through Context.GetFlagValues.
+	Repeatable bool
+
+	// Complete names the source of completion values, e.g. "denoms".
+	Complete string
+
+	// Bool marks a switch that takes no value, so the argument after it
+	// is not read as its value.
+	Bool bool
+
+	// Env makes a subcommand flag settable through an environment
+	// variable like the flags of the root command (see EnvPrefix).
+	Env bool
 }
 
 // RunFunc is the function signature for command execution.
@@ -102,6 +137,12 @@ type Context struct {
 	// Stderr is the standard error.
 	Stderr io.Writer
 
+	// values holds every value given for repeatable flags, in order.
+	values map[string][]string
+
+	// set records flags given explicitly on the command line.
+	set map[string]bool
+
 	// parent context for accessing parent command data.
 	parent *Context
 }
@@ -122,6 +163,11 @@ func (c *Command) AddCommand(sub *Command) {
 	c.SubCommands = append(c.SubCommands, sub)
 }
 
+// Parent returns the parent command, or nil for the root.
+func (c *Command) Parent() *Command {
+	return c.parent
+}
+
 // AddCommands adds multiple subcommands.
 func (c *Command) AddCommands(subs ...*Command) {
 	for _, sub := range subs {
@@ -152,6 +198,8 @@ func (c *Command) Execute(args []string) error {
 func (c *Command) ExecuteContext(ctx *Context, args []string) error {
 	ctx.Command = c
 	ctx.Flags = make(map[string]string)
+	ctx.values = make(map[string][]string)
+	ctx.set = make(map[string]bool)
 
 	// Set defaults for all flags
 	for _, f := range c.Flags {
@@ -160,6 +208,29 @@ func (c *Command) ExecuteContext(ctx *Context, args []string) error {
 		}
 	}
 
+	// Environment variables override defaults but not the command line
+	for _, f := range c.Flags {
+		env := c.EnvVar(f.Name)
+		if env == "" {
+			continue
+		}
+		if v := os.Getenv(env); v != "" {
+			if c.isBoolFlag(f.Name) {
+				v = strconv.FormatBool(v == "true" || v == "1")
+			}
+			ctx.Flags[f.Name] = v
+			ctx.set[f.Name] = true
+		}
+	}
+
+	if c.Passthrough && c.Run != nil {
+		ctx.Args = args
+		if err := c.runPreRuns(ctx); err != nil {
+			return err
+		}
+		return c.Run(ctx)
+	}
+
 	// Check for subcommand FIRST (before parsing flags)
 	// This ensures "keys --help" goes to keys subcommand, not root
 	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
@@ -228,24 +299,50 @@ func (c *Command) ExecuteContext(ctx *Context, args []string) error {
 	// Validate required flags
 	for _, f := range c.Flags {
 		if f.Required && ctx.Flags[f.Name] == "" {
-			return fmt.Errorf("required flag --%s not provided", f.Name)
+			return Usagef("required flag --%s not provided", f.Name)
 		}
 	}
 
 	// Validate required args
 	for i, a := range c.Args {
 		if a.Required && (i >= len(ctx.Args) || ctx.Args[i] == "") {
-			return fmt.Errorf("required argument <%s> not provided", a.Name)
+			return Usagef("required argument <%s> not provided", a.Name)
 		}
 	}
 
 	// Execute command
 	if c.Run != nil {
+		if err := c.runPreRuns(ctx); err != nil {
+			return err
+		}
 		return c.Run(ctx)
 	}
 
 	// No run function and no subcommand matched - show help
-	return c.showHelp(ctx)
+	if err := c.showHelp(ctx); err != nil {
+		return err
+	}
+	if len(ctx.Args) > 0 {
+		return Usagef("unknown command %q for %q", ctx.Args[0], c.Name)
+	}
+	return nil
+}
+
+// runPreRuns calls the PreRun hooks of the command and its ancestors,
+// outermost first.
+func (c *Command) runPreRuns(ctx *Context) error {
+	var hooks []RunFunc
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		if cmd.PreRun != nil {
+			hooks = append([]RunFunc{cmd.PreRun}, hooks...)
+		}
+	}
+	for _, hook := range hooks {
+		if err := hook(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // parseArgs parses flags and returns remaining positional arguments.
@@ -289,11 +386,11 @@ func (c *Command) parseArgs(ctx *Context, args []string) ([]string, error) {
 				}
 			}
 
-			if !c.hasFlag(name) && name != "help" {
-				return nil, fmt.Errorf("unknown flag: --%s", name)
+			if !c.hasFlag(name) && !c.inheritsFlag(name) && name != "help" {
+				return nil, Usagef("unknown flag: --%s", name)
 			}
 
-			ctx.Flags[name] = value
+			c.setFlag(ctx, name, value)
 			continue
 		}
 
@@ -310,7 +407,7 @@ func (c *Command) parseArgs(ctx *Context, args []string) ([]string, error) {
 						ctx.Flags["help"] = "true"
 						continue
 					}
-					return nil, fmt.Errorf("unknown flag: -%s", string(r))
+					return nil, Usagef("unknown flag: -%s", string(r))
 				}
 
 				value := "true"
@@ -322,7 +419,7 @@ func (c *Command) parseArgs(ctx *Context, args []string) ([]string, error) {
 					}
 				}
 
-				ctx.Flags[name] = value
+				c.setFlag(ctx, name, value)
 			}
 			continue
 		}
@@ -334,6 +431,40 @@ func (c *Command) parseArgs(ctx *Context, args []string) ([]string, error) {
 	return remaining, nil
 }
 
+// setFlag records a parsed flag value, accumulating values of repeatable flags.
+func (c *Command) setFlag(ctx *Context, name, value string) {
+	ctx.Flags[name] = value
+	ctx.set[name] = true
+	if f := c.findFlag(name); f != nil && f.Repeatable {
+		ctx.values[name] = append(ctx.values[name], value)
+	}
+}
+
+// EnvVar returns the environment variable a flag of the command can be
+// set with, or "" if it has none: the flags of the root command (except
+// --help) and flags marked Env have one when the root sets EnvPrefix.
+func (c *Command) EnvVar(name string) string {
+	root := c.Root()
+	if root.EnvPrefix == "" || name == "help" {
+		return ""
+	}
+	f := c.findFlag(name)
+	if f == nil || (!f.Env && !root.hasFlag(name)) {
+		return ""
+	}
+	return root.EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// findFlag returns the flag definition with the given name, or nil.
+func (c *Command) findFlag(name string) *Flag {
+	for i := range c.Flags {
+		if c.Flags[i].Name == name {
+			return &c.Flags[i]
+		}
+	}
+	return nil
+}
+
 // hasFlag checks if the command has a flag with the given name.
 func (c *Command) hasFlag(name string) bool {
 	for _, f := range c.Flags {
@@ -344,18 +475,64 @@ func (c *Command) hasFlag(name string) bool {
 	return false
 }
 
+// inheritsFlag checks if an ancestor command has a flag with the given
+// name. Ancestor (global) flags may also be given after a subcommand.
+func (c *Command) inheritsFlag(name string) bool {
+	for p := c.parent; p != nil; p = p.parent {
+		if p.hasFlag(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // isBoolFlag checks if a flag is boolean (doesn't take a value).
 func (c *Command) isBoolFlag(name string) bool {
 	// Known boolean flags (don't take values)
 	boolFlags := map[string]bool{
-		"help":    true,
-		"force":   true,
-		"yes":     true,
-		"recover": true,
+		"help":               true,
+		"force":              true,
+		"yes":                true,
+		"recover":            true,
+		"result-only":        true,
+		"interactive":        true,
+		"diff":               true,
+		"count-total":        true,
+		"reverse":            true,
+		"generate-only":      true,
+		"staking-only":       true,
+		"fee-payments-only":  true,
+		"sequence-retry":     true,
+		"all-accounts":       true,
+		"check":              true,
+		"wait":               true,
+		"no-color":           true,
+		"all":                true,
+		"address-only":       true,
+		"summary":            true,
+		"list-aliases":       true,
+		"debug":              true,
+		"from-stdin":         true,
+		"insecure":           true,
+		"unarmored-hex":      true,
+		"unsafe":             true,
+		"decode":             true,
+		"watch-until-final":  true,
+		"self":               true,
+		"fees-auto-if-empty": true,
+		"humanize":           true,
+		"no-enrich":          true,
 	}
 	if boolFlags[name] {
 		return true
 	}
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		for _, f := range cmd.Flags {
+			if f.Name == name && f.Bool {
+				return true
+			}
+		}
+	}
 
 	// Flags with non-boolean defaults are not boolean
 	for _, f := range c.Flags {
@@ -370,16 +547,24 @@ func (c *Command) isBoolFlag(name string) bool {
 	return false
 }
 
-// longNameForShort returns the long flag name for a short flag.
+// longNameForShort returns the long flag name for a short flag, looking
+// at ancestor commands if the command itself has no such flag.
 func (c *Command) longNameForShort(short string) string {
-	for _, f := range c.Flags {
-		if f.Short == short {
-			return f.Name
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		for _, f := range cmd.Flags {
+			if f.Short == short {
+				return f.Name
+			}
 		}
 	}
 	return ""
 }
 
+// ShowHelp displays help for the command.
+func (c *Command) ShowHelp(ctx *Context) error {
+	return c.showHelp(ctx)
+}
+
 // showHelp displays help for the command.
 func (c *Command) showHelp(ctx *Context) error {
 	fmt.Fprintln(ctx.Stdout, c.helpText())
@@ -494,8 +679,15 @@ func (c *Command) helpText() string {
 			if f.Default != "" && f.Default != "true" && f.Default != "false" {
 				flagStr += fmt.Sprintf(" (default: %s)", f.Default)
 			}
+			if f.Repeatable {
+				flagStr += " (repeatable)"
+			}
+			usage := f.Usage
+			if env := c.EnvVar(f.Name); env != "" {
+				usage += " [$" + env + "]"
+			}
 
-			sb.WriteString(fmt.Sprintf("%-30s  %s\n", flagStr, f.Usage))
+			sb.WriteString(fmt.Sprintf("%-30s  %s\n", flagStr, usage))
 		}
 	}
 
@@ -534,6 +726,58 @@ func (ctx *Context) GetFlag(name string) string {
 	return ""
 }
 
+// GetFlagValues returns all values given for a repeatable flag, in order.
+// For non-repeatable flags it returns the single value if set.
+func (ctx *Context) GetFlagValues(name string) []string {
+	if v, ok := ctx.values[name]; ok {
+		return v
+	}
+	if v, ok := ctx.Flags[name]; ok && v != "" {
+		return []string{v}
+	}
+	if ctx.parent != nil {
+		return ctx.parent.GetFlagValues(name)
+	}
+	return nil
+}
+
+// IsSet reports whether a flag was given explicitly on the command line
+// (as opposed to holding its default), checking parent contexts.
+func (ctx *Context) IsSet(name string) bool {
+	if ctx.set[name] {
+		return true
+	}
+	if ctx.parent != nil {
+		return ctx.parent.IsSet(name)
+	}
+	return false
+}
+
+// SetFlagArgs returns the flags given explicitly to the command and its
+// ancestors as "--name=value" arguments, outermost command first, so a
+// command line can be re-dispatched with the same global flags.
+func (ctx *Context) SetFlagArgs() []string {
+	var args []string
+	if ctx.parent != nil {
+		args = ctx.parent.SetFlagArgs()
+	}
+	names := make([]string, 0, len(ctx.set))
+	for name := range ctx.set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values, ok := ctx.values[name]
+		if !ok {
+			values = []string{ctx.Flags[name]}
+		}
+		for _, v := range values {
+			args = append(args, "--"+name+"="+v)
+		}
+	}
+	return args
+}
+
 // GetArg gets a positional argument by index.
 func (ctx *Context) GetArg(index int) string {
 	if index < 0 || index >= len(ctx.Args) {
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+)
+
+// Completion sources for Arg.Complete and Flag.Complete.
+const (
+	CompleteKeys   = "keys"
+	CompleteDenoms = "denoms"
+)
+
+// Find returns the deepest command named by the leading words of args,
+// skipping flags and their values. It returns c if no subcommand matches.
+func (c *Command) Find(args []string) *Command {
+	cmd, _ := c.findPositionals(args)
+	return cmd
+}
+
+// findPositionals is Find that also returns the positional arguments given
+// to the command found.
+func (c *Command) findPositionals(args []string) (*Command, []string) {
+	cmd := c
+	var positionals []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if strings.HasPrefix(arg, "-") {
+			name := strings.TrimLeft(arg, "-")
+			if !strings.HasPrefix(arg, "--") {
+				name = cmd.longNameForShort(name)
+			}
+			if !strings.Contains(name, "=") && (cmd.hasFlag(name) || cmd.inheritsFlag(name)) && !cmd.isBoolFlag(name) {
+				i++
+			}
+			continue
+		}
+		if positionals == nil {
+			if sub := cmd.subCommand(arg); sub != nil {
+				cmd = sub
+				continue
+			}
+		}
+		positionals = append(positionals, arg)
+	}
+	return cmd, positionals
+}
+
+// CompleteValues returns the completions of word as the value of a flag or
+// positional argument, after the given preceding words. The flag or
+// argument's Complete source is looked up with values; nothing is returned
+// for flags and arguments without one.
+func CompleteValues(root *Command, args []string, word string, values func(source string) []string) []string {
+	cmd, positionals := root.findPositionals(args)
+
+	var source, prefix string
+	switch {
+	case strings.HasPrefix(word, "--") && strings.Contains(word, "="):
+		idx := strings.Index(word, "=")
+		if f := cmd.lookupFlag(word[2:idx]); f != nil {
+			source, prefix, word = f.Complete, word[:idx+1], word[idx+1:]
+		}
+	case strings.HasPrefix(word, "-"):
+		return nil
+	case len(args) > 0 && strings.HasPrefix(args[len(args)-1], "-") && !strings.Contains(args[len(args)-1], "="):
+		last := args[len(args)-1]
+		name := strings.TrimLeft(last, "-")
+		if !strings.HasPrefix(last, "--") {
+			name = cmd.longNameForShort(name)
+		}
+		if cmd.isBoolFlag(name) {
+			source = positionalSource(cmd, len(positionals))
+		} else if f := cmd.lookupFlag(name); f != nil {
+			source = f.Complete
+		}
+	default:
+		source = positionalSource(cmd, len(positionals))
+	}
+	if source == "" {
+		return nil
+	}
+
+	var matches []string
+	for _, v := range values(source) {
+		if strings.HasPrefix(v, word) {
+			matches = append(matches, prefix+v)
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+// positionalSource returns the completion source of the i-th argument.
+func positionalSource(cmd *Command, i int) string {
+	if i < len(cmd.Args) {
+		return cmd.Args[i].Complete
+	}
+	return ""
+}
+
+// lookupFlag returns the flag with the given name of the command or one of
+// its ancestors, or nil.
+func (c *Command) lookupFlag(name string) *Flag {
+	for cmd := c; cmd != nil; cmd = cmd.parent {
+		for i := range cmd.Flags {
+			if cmd.Flags[i].Name == name {
+				return &cmd.Flags[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Complete returns the completions of word after the given preceding
+// words: flags of the command reached by args if word starts with "-",
+// its subcommands otherwise. Like the generated shell completions, hidden
+// commands and flags are left out.
+func Complete(root *Command, args []string, word string) []string {
+	cmd := root.Find(args)
+
+	var candidates []string
+	if strings.HasPrefix(word, "-") {
+		candidates = collectAllFlags(cmd)
+	} else {
+		for _, sub := range cmd.SubCommands {
+			if !sub.Hidden {
+				candidates = append(candidates, sub.Name)
+				candidates = append(candidates, sub.Aliases...)
+			}
+		}
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			matches = append(matches, candidate)
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+// subCommand returns the direct subcommand with the given name or alias.
+func (c *Command) subCommand(name string) *Command {
+	for _, sub := range c.SubCommands {
+		if sub.Name == name || contains(sub.Aliases, name) {
+			return sub
+		}
+	}
+	return nil
+}
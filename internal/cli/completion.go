@@ -40,6 +40,16 @@ _sekai_cli_completions() {
         fi
     done
 
+    # Complete flag and argument values (key names, denoms) from the cache
+    if [[ "${cur}" != -* ]]; then
+        local values
+        values=$(sekai-cli __complete "${words[@]:1:cword-1}" "${cur}" 2>/dev/null)
+        if [[ -n "$values" ]]; then
+            COMPREPLY=($(compgen -W "${values}" -- "${cur}"))
+            return
+        fi
+    fi
+
     # Handle flag completion
     if [[ "${cur}" == -* ]]; then
         local flags=""
@@ -50,7 +60,7 @@ _sekai_cli_completions() {
 	walkCommandsForBash(root, "", &sb)
 
 	sb.WriteString(`            *)
-                flags="--help --config --output --container --node --chain-id --keyring-backend --home --rest"
+                flags="--help --config --profile --output --container --runtime --docker-host --kube-pod --kube-namespace --kube-container --kube-context --no-color --node --chain-id --keyring-backend --keyring-dir --keyring-passphrase-file --home --rest --max-cache-age"
                 ;;
         esac
         COMPREPLY=($(compgen -W "${flags}" -- "${cur}"))
@@ -185,6 +195,13 @@ func GenerateZshCompletion(root *Command) string {
 # sekai-cli zsh completion script
 # Generated automatically - do not edit
 
+# Complete flag and argument values (key names, denoms) from the cache
+_sekai_cli_values() {
+    local -a values
+    values=(${(f)"$(sekai-cli __complete ${words[1,CURRENT-1]} "${words[CURRENT]}" 2>/dev/null)"})
+    compadd -a values
+}
+
 _sekai-cli() {
     local curcontext="$curcontext" state line
     typeset -A opt_args
@@ -195,11 +212,22 @@ _sekai-cli() {
     global_flags=(
         '(-h --help)'{-h,--help}'[Show help]'
         '(-c --config)'{-c,--config}'[Path to config file]:file:_files'
-        '(-o --output)'{-o,--output}'[Output format (text, json, yaml)]:format:(text json yaml)'
+        '--profile[Config profile to use]:profile:'
+        '(-o --output)'{-o,--output}'[Output format (text, json, yaml, table, csv)]:format:(text json yaml table csv)'
         '--container[Docker container name]:container:'
+        '--runtime[Container runtime]:runtime:(docker podman)'
+        '--docker-host[Container daemon address]:host:'
+        '--kube-pod[Kubernetes pod running sekaid]:pod:'
+        '--kube-namespace[Kubernetes namespace]:namespace:'
+        '--kube-container[Container within the pod]:container:'
+        '--kube-context[Kubeconfig context]:context:'
+        '--no-color[Disable colored output]'
+        '--max-cache-age[Warn when the network cache is older than this]:duration:'
         '--node[Node RPC endpoint]:endpoint:'
         '--chain-id[Chain ID]:chain:'
         '--keyring-backend[Keyring backend]:backend:(test file os)'
+        '--keyring-dir[Keyring directory in the container]:dir:'
+        '--keyring-passphrase-file[File unlocking the file keyring backend]:file:_files'
         '--home[Sekaid home directory]:directory:_directories'
         '--rest[REST API endpoint]:endpoint:'
     )
@@ -266,9 +294,13 @@ func generateZshSubcommand(cmd *Command, sb *strings.Builder, indent int) {
 			sb.WriteString(fmt.Sprintf("%s        '%s:%s'\n", prefix, sub.Name, desc))
 		}
 		sb.WriteString(fmt.Sprintf("%s    )\n", prefix))
-		sb.WriteString(fmt.Sprintf("%s    _describe -t commands '%s subcommands' subcmds\n", prefix, cmd.Name))
+		sb.WriteString(fmt.Sprintf("%s    if (( CURRENT == 2 )); then\n", prefix))
+		sb.WriteString(fmt.Sprintf("%s        _describe -t commands '%s subcommands' subcmds\n", prefix, cmd.Name))
+		sb.WriteString(fmt.Sprintf("%s    else\n", prefix))
+		sb.WriteString(fmt.Sprintf("%s        _sekai_cli_values\n", prefix))
+		sb.WriteString(fmt.Sprintf("%s    fi\n", prefix))
 	} else {
-		sb.WriteString(fmt.Sprintf("%s    _arguments $global_flags\n", prefix))
+		sb.WriteString(fmt.Sprintf("%s    _arguments $global_flags '*: :_sekai_cli_values'\n", prefix))
 	}
 
 	sb.WriteString(fmt.Sprintf("%s    ;;\n", prefix))
@@ -287,14 +319,32 @@ complete -c sekai-cli -f
 # Global flags
 complete -c sekai-cli -s h -l help -d 'Show help'
 complete -c sekai-cli -s c -l config -d 'Path to config file' -r
-complete -c sekai-cli -s o -l output -d 'Output format' -xa 'text json yaml'
+complete -c sekai-cli -l profile -d 'Config profile to use' -r
+complete -c sekai-cli -s o -l output -d 'Output format' -xa 'text json yaml table csv'
 complete -c sekai-cli -l container -d 'Docker container name' -r
+complete -c sekai-cli -l runtime -d 'Container runtime' -xa 'docker podman'
+complete -c sekai-cli -l docker-host -d 'Container daemon address' -r
+complete -c sekai-cli -l kube-pod -d 'Kubernetes pod running sekaid' -r
+complete -c sekai-cli -l kube-namespace -d 'Kubernetes namespace' -r
+complete -c sekai-cli -l kube-container -d 'Container within the pod' -r
+complete -c sekai-cli -l kube-context -d 'Kubeconfig context' -r
+complete -c sekai-cli -l no-color -d 'Disable colored output'
+complete -c sekai-cli -l max-cache-age -d 'Warn when the network cache is older than this' -r
 complete -c sekai-cli -l node -d 'Node RPC endpoint' -r
 complete -c sekai-cli -l chain-id -d 'Chain ID' -r
 complete -c sekai-cli -l keyring-backend -d 'Keyring backend' -xa 'test file os'
+complete -c sekai-cli -l keyring-dir -d 'Keyring directory in the container' -r
+complete -c sekai-cli -l keyring-passphrase-file -d 'File unlocking the file keyring backend' -r
 complete -c sekai-cli -l home -d 'Sekaid home directory' -ra '(__fish_complete_directories)'
 complete -c sekai-cli -l rest -d 'REST API endpoint' -r
 
+# Complete flag and argument values (key names, denoms) from the cache
+function __sekai_cli_values
+    set -l words (commandline -opc)
+    sekai-cli __complete $words[2..-1] (commandline -ct) 2>/dev/null
+end
+complete -c sekai-cli -a '(__sekai_cli_values)'
+
 `)
 
 	// Generate completions for all commands recursively
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrCancelled is returned when the user declines a confirmation prompt.
+var ErrCancelled = errors.New("operation cancelled")
+
+// IsInteractive reports whether the context's stdin is a terminal.
+func (ctx *Context) IsInteractive() bool {
+	f, ok := ctx.Stdin.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	if info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	// /dev/null is a character device too, but nobody can answer a prompt there.
+	if null, err := os.Stat(os.DevNull); err == nil && os.SameFile(info, null) {
+		return false
+	}
+	return true
+}
+
+// Confirm prints the summary lines and asks for y/N confirmation.
+// It returns nil without prompting when --yes is set. When stdin is not a
+// terminal it returns an error, so scripts must opt in with --yes.
+// Prompts are written to stderr to keep stdout clean for results.
+func (ctx *Context) Confirm(prompt string, summary ...string) error {
+	if ctx.GetFlag("yes") == "true" {
+		return nil
+	}
+	if !ctx.IsInteractive() {
+		return Usagef("confirmation required: stdin is not a terminal (use --yes to skip)")
+	}
+
+	for _, line := range summary {
+		fmt.Fprintf(ctx.Stderr, "  %s\n", line)
+	}
+	fmt.Fprintf(ctx.Stderr, "%s (y/N): ", prompt)
+
+	answer, err := bufio.NewReader(ctx.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return ErrCancelled
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return ErrCancelled
+	}
+}
+
+// ReadPassword prompts on stderr and reads a line from stdin without echoing
+// it when stdin is a terminal. Input is read one byte at a time, so the rest
+// of stdin is left unread.
+func (ctx *Context) ReadPassword(prompt string) (string, error) {
+	fmt.Fprint(ctx.Stderr, prompt)
+	if ctx.IsInteractive() {
+		if restore, err := makeRaw(int(ctx.Stdin.(*os.File).Fd())); err == nil {
+			defer fmt.Fprint(ctx.Stderr, "\r\n")
+			defer restore()
+		}
+	}
+
+	var password []byte
+	var b [1]byte
+	for {
+		n, err := ctx.Stdin.Read(b[:])
+		if n == 0 {
+			if err == nil {
+				continue
+			}
+			if len(password) > 0 {
+				return string(password), nil
+			}
+			return "", ErrCancelled
+		}
+		switch b[0] {
+		case '\r', '\n':
+			return string(password), nil
+		case 3, 4: // Ctrl-C, Ctrl-D
+			return "", ErrCancelled
+		case 8, 127: // Backspace
+			if len(password) > 0 {
+				password = password[:len(password)-1]
+			}
+		default:
+			password = append(password, b[0])
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package cli
+
+import "fmt"
+
+// UsageError is an error in how a command was invoked, such as an unknown
+// flag, a missing argument or an invalid flag value, as opposed to a
+// failure while running it.
+type UsageError struct {
+	Err error
+}
+
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// Usagef formats an error like fmt.Errorf and returns it as a UsageError.
+func Usagef(format string, args ...interface{}) error {
+	return &UsageError{Err: fmt.Errorf(format, args...)}
+}
@@ -18,7 +18,7 @@ func GlobalFlags() []Flag {
 		{
 			Name:    "output",
 			Short:   "o",
-			Usage:   "Output format (text, json, yaml)",
+			Usage:   "Output format (text, json, yaml, table, csv)",
 			Default: "text",
 		},
 		{
@@ -54,18 +54,37 @@ func ConnectionFlags() []Flag {
 func TxFlags() []Flag {
 	return []Flag{
 		{
-			Name:  "from",
-			Usage: "Name or address of key to sign with",
+			Name:     "from",
+			Usage:    "Name or address of key to sign with",
+			Complete: CompleteKeys,
+			Env:      true,
 		},
 		{
 			Name:    "fees",
 			Usage:   "Transaction fees",
 			Default: "",
+			Env:     true,
+		},
+		{
+			Name:     "fee-denom",
+			Usage:    "Denom to pay the cached network minimum fee in when --fees is omitted",
+			Default:  "ukex",
+			Complete: CompleteDenoms,
+		},
+		{
+			Name:  "fees-auto-if-empty",
+			Usage: "Query the network minimum fee when no fees are set and none is cached",
+		},
+		{
+			Name:    "fee-multiplier",
+			Usage:   "Multiplier for the cached network minimum fee when --fees is omitted (capped at the maximum fee)",
+			Default: "1",
 		},
 		{
 			Name:    "gas",
-			Usage:   "Gas limit",
+			Usage:   "Gas limit, or \"auto\" to estimate by simulation",
 			Default: "",
+			Env:     true,
 		},
 		{
 			Name:    "gas-adjustment",
@@ -76,10 +95,21 @@ func TxFlags() []Flag {
 			Name:  "memo",
 			Usage: "Transaction memo",
 		},
+		{
+			Name:  "memo-file",
+			Usage: "Read the transaction memo from a file (\"-\" for stdin); excludes --memo",
+		},
 		{
 			Name:    "broadcast-mode",
-			Usage:   "Broadcast mode (sync, async, block)",
+			Usage:   "Broadcast mode (sync, async, block; block waits for inclusion like --wait)",
 			Default: "sync",
+			Env:     true,
+		},
+		{
+			Name:    "sign-mode",
+			Usage:   "Signing mode (direct, amino-json; amino-json is needed by Ledger for some message types)",
+			Default: "direct",
+			Env:     true,
 		},
 		{
 			Name:    "keyring-backend",
@@ -89,9 +119,31 @@ func TxFlags() []Flag {
 		{
 			Name:    "yes",
 			Short:   "y",
-			Usage:   "Skip confirmation prompts",
+			Usage:   "Skip confirmation prompts (required when stdin is not a terminal)",
 			Default: "false",
 		},
+		{
+			Name:  "wait",
+			Usage: "Wait until the transaction is included in a block and print the result",
+		},
+		{
+			Name:    "wait-timeout",
+			Usage:   "Maximum time to wait with --wait or --broadcast-mode block",
+			Default: "60s",
+		},
+		{
+			Name:  "generate-only",
+			Usage: "Print the unsigned transaction JSON instead of signing and broadcasting",
+		},
+		{
+			Name:    "result-only",
+			Usage:   "Print only the tx hash and result code",
+			Default: "false",
+		},
+		{
+			Name:  "sequence-retry",
+			Usage: "On an account sequence mismatch, sign again once with the expected sequence",
+		},
 	}
 }
 
@@ -102,6 +154,28 @@ func QueryFlags() []Flag {
 			Name:  "height",
 			Usage: "Query at specific block height",
 		},
+		{
+			Name:  "field",
+			Usage: "Print only the value at a JSON path, e.g. balances[0].amount or validators[*].address",
+		},
+		{
+			Name:  "count-total",
+			Usage: "Add the total number of results to list output (counted by the node for paginated queries)",
+		},
+	}
+}
+
+// WatchFlags returns flags for re-running queries on an interval.
+func WatchFlags() []Flag {
+	return []Flag{
+		{
+			Name:  "watch",
+			Usage: "Re-run the query every interval (e.g. 5s) until interrupted",
+		},
+		{
+			Name:  "diff",
+			Usage: "With --watch, highlight changes from the previous poll",
+		},
 	}
 }
 
@@ -117,13 +191,17 @@ func PaginationFlags() []Flag {
 			Name:  "offset",
 			Usage: "Number of results to skip",
 		},
+		{
+			Name:  "page",
+			Usage: "Page number to fetch (1-based, uses --limit as page size)",
+		},
 		{
 			Name:  "page-key",
-			Usage: "Pagination key",
+			Usage: "Pagination key (next_key from a previous page)",
 		},
 		{
-			Name:  "count-total",
-			Usage: "Include total count in response",
+			Name:  "reverse",
+			Usage: "Return results in reverse order",
 		},
 	}
 }
@@ -156,6 +234,13 @@ func AddQueryFlags(cmd *Command) {
 	}
 }
 
+// AddWatchFlags adds watch flags to a command.
+func AddWatchFlags(cmd *Command) {
+	for _, f := range WatchFlags() {
+		cmd.AddFlag(f)
+	}
+}
+
 // AddPaginationFlags adds pagination flags to a command.
 func AddPaginationFlags(cmd *Command) {
 	for _, f := range PaginationFlags() {
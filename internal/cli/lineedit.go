@@ -0,0 +1,303 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// LineEditor reads lines from a terminal with basic editing, history and
+// tab completion. When the input is not a terminal it reads plain lines.
+//
+// Supported keys: left/right, Home/End, Ctrl-A/Ctrl-E, Backspace, Delete,
+// Ctrl-U/Ctrl-K (kill to start/end), Ctrl-W (delete word), up/down
+// (history), Tab (complete), Ctrl-C (discard line) and Ctrl-D (end of
+// input on an empty line).
+type LineEditor struct {
+	// Prompt is printed before each line.
+	Prompt string
+
+	// History holds previous lines, oldest first.
+	History []string
+
+	// MaxHistory limits the history size (0 for no limit).
+	MaxHistory int
+
+	// Complete returns completion candidates for the last word of line.
+	// Candidates replace the last word entirely.
+	Complete func(line string) []string
+
+	in    *os.File
+	out   io.Writer
+	plain *bufio.Reader
+}
+
+// NewLineEditor creates a line editor reading from in and echoing to out.
+func NewLineEditor(in *os.File, out io.Writer) *LineEditor {
+	return &LineEditor{in: in, out: out}
+}
+
+// ReadLine reads one line. It returns io.EOF at the end of input or when
+// Ctrl-D is pressed on an empty line.
+func (e *LineEditor) ReadLine() (string, error) {
+	restore, err := makeRaw(int(e.in.Fd()))
+	if err != nil {
+		return e.readPlain()
+	}
+	defer restore()
+
+	line, err := e.edit()
+	fmt.Fprint(e.out, "\r\n")
+	return line, err
+}
+
+// AddHistory appends a line to the history, skipping blanks and
+// immediate repeats.
+func (e *LineEditor) AddHistory(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" || (len(e.History) > 0 && e.History[len(e.History)-1] == line) {
+		return
+	}
+	e.History = append(e.History, line)
+	if e.MaxHistory > 0 && len(e.History) > e.MaxHistory {
+		e.History = e.History[len(e.History)-e.MaxHistory:]
+	}
+}
+
+// readPlain reads a line without editing.
+func (e *LineEditor) readPlain() (string, error) {
+	if e.plain == nil {
+		e.plain = bufio.NewReader(e.in)
+	}
+	fmt.Fprint(e.out, e.Prompt)
+	line, err := e.plain.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// lineState is the buffer being edited.
+type lineState struct {
+	buf []rune
+	pos int
+}
+
+// edit runs the editing loop in raw mode.
+func (e *LineEditor) edit() (string, error) {
+	s := &lineState{}
+	histIndex := len(e.History)
+	saved := ""
+
+	e.refresh(s)
+	for {
+		r, err := e.readRune()
+		if err != nil {
+			return "", err
+		}
+
+		switch r {
+		case '\r', '\n':
+			return string(s.buf), nil
+		case 3: // Ctrl-C
+			fmt.Fprint(e.out, "^C")
+			return "", nil
+		case 4: // Ctrl-D
+			if len(s.buf) == 0 {
+				return "", io.EOF
+			}
+			s.deleteAt(s.pos)
+		case 1: // Ctrl-A
+			s.pos = 0
+		case 5: // Ctrl-E
+			s.pos = len(s.buf)
+		case 2: // Ctrl-B
+			s.left()
+		case 6: // Ctrl-F
+			s.right()
+		case 8, 127: // Backspace
+			if s.pos > 0 {
+				s.pos--
+				s.deleteAt(s.pos)
+			}
+		case 11: // Ctrl-K
+			s.buf = s.buf[:s.pos]
+		case 21: // Ctrl-U
+			s.buf = s.buf[s.pos:]
+			s.pos = 0
+		case 23: // Ctrl-W
+			start := s.pos
+			for start > 0 && s.buf[start-1] == ' ' {
+				start--
+			}
+			for start > 0 && s.buf[start-1] != ' ' {
+				start--
+			}
+			s.buf = append(s.buf[:start], s.buf[s.pos:]...)
+			s.pos = start
+		case 12: // Ctrl-L
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		case '\t':
+			e.complete(s)
+		case 27: // Escape sequence
+			seq := e.readEscape()
+			switch seq {
+			case "[A", "OA": // Up
+				if histIndex > 0 {
+					if histIndex == len(e.History) {
+						saved = string(s.buf)
+					}
+					histIndex--
+					s.set(e.History[histIndex])
+				}
+			case "[B", "OB": // Down
+				if histIndex < len(e.History) {
+					histIndex++
+					if histIndex == len(e.History) {
+						s.set(saved)
+					} else {
+						s.set(e.History[histIndex])
+					}
+				}
+			case "[C", "OC":
+				s.right()
+			case "[D", "OD":
+				s.left()
+			case "[H", "OH", "[1~", "[7~":
+				s.pos = 0
+			case "[F", "OF", "[4~", "[8~":
+				s.pos = len(s.buf)
+			case "[3~": // Delete
+				s.deleteAt(s.pos)
+			}
+		default:
+			if r >= 32 {
+				s.insert(r)
+			}
+		}
+		e.refresh(s)
+	}
+}
+
+// complete applies tab completion to the word before the cursor.
+func (e *LineEditor) complete(s *lineState) {
+	if e.Complete == nil {
+		return
+	}
+	before := string(s.buf[:s.pos])
+	candidates := e.Complete(before)
+	if len(candidates) == 0 {
+		return
+	}
+
+	wordStart := strings.LastIndexByte(before, ' ') + 1
+	word := before[wordStart:]
+
+	replacement := candidates[0]
+	if len(candidates) > 1 {
+		replacement = commonPrefix(candidates)
+		if len(replacement) <= len(word) {
+			// Nothing to add: list the candidates below the line
+			sorted := append([]string(nil), candidates...)
+			sort.Strings(sorted)
+			fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(sorted, "  "))
+			return
+		}
+	} else {
+		replacement += " "
+	}
+
+	rest := s.buf[s.pos:]
+	head := []rune(before[:wordStart] + replacement)
+	s.buf = append(head, rest...)
+	s.pos = len(head)
+}
+
+// refresh redraws the prompt and buffer and positions the cursor.
+func (e *LineEditor) refresh(s *lineState) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", e.Prompt, string(s.buf))
+	if back := len(s.buf) - s.pos; back > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", back)
+	}
+}
+
+// readRune reads one UTF-8 encoded rune without buffering, so that
+// commands run between lines can read the rest of the input themselves.
+func (e *LineEditor) readRune() (rune, error) {
+	var buf [utf8.UTFMax]byte
+	n := 0
+	for {
+		if _, err := e.in.Read(buf[n : n+1]); err != nil {
+			return 0, err
+		}
+		n++
+		if utf8.FullRune(buf[:n]) || n == len(buf) {
+			r, _ := utf8.DecodeRune(buf[:n])
+			return r, nil
+		}
+	}
+}
+
+// readEscape reads the rest of an escape sequence such as "[A" or "[3~".
+func (e *LineEditor) readEscape() string {
+	var seq []rune
+	for len(seq) < 6 {
+		r, err := e.readRune()
+		if err != nil {
+			break
+		}
+		seq = append(seq, r)
+		if len(seq) == 1 && r != '[' && r != 'O' {
+			break
+		}
+		if len(seq) > 1 && (r == '~' || (r >= 'A' && r <= 'Z')) {
+			break
+		}
+	}
+	return string(seq)
+}
+
+func (s *lineState) insert(r rune) {
+	s.buf = append(s.buf, 0)
+	copy(s.buf[s.pos+1:], s.buf[s.pos:])
+	s.buf[s.pos] = r
+	s.pos++
+}
+
+func (s *lineState) deleteAt(i int) {
+	if i < len(s.buf) {
+		s.buf = append(s.buf[:i], s.buf[i+1:]...)
+	}
+}
+
+func (s *lineState) left() {
+	if s.pos > 0 {
+		s.pos--
+	}
+}
+
+func (s *lineState) right() {
+	if s.pos < len(s.buf) {
+		s.pos++
+	}
+}
+
+func (s *lineState) set(line string) {
+	s.buf = []rune(line)
+	s.pos = len(s.buf)
+}
+
+// commonPrefix returns the longest common prefix of words.
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package cli
+
+import "errors"
+
+// makeRaw is not supported on this platform; the line editor falls back to
+// reading plain lines.
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("raw terminal mode not supported")
+}
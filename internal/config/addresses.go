@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// aliasPattern matches address book aliases: a letter followed by
+// letters, digits, '-', '_' or '.'.
+var aliasPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`)
+
+// AddAddress adds an alias to the address book, replacing an existing one
+// with the same name. The address must be an account or validator
+// operator address.
+func (c *Config) AddAddress(alias, address string) error {
+	if !aliasPattern.MatchString(alias) {
+		return fmt.Errorf("invalid alias %q: use a letter followed by letters, digits, '-', '_' or '.'", alias)
+	}
+	if types.GetAddressType(alias) != "unknown" {
+		return fmt.Errorf("invalid alias %q: aliases cannot look like addresses", alias)
+	}
+	if !types.IsValidAddress(address) && !types.IsValidValAddress(address) {
+		return fmt.Errorf("invalid address %q: expected a kira1... or kiravaloper1... address", address)
+	}
+	if c.Addresses == nil {
+		c.Addresses = make(map[string]string)
+	}
+	c.Addresses[alias] = address
+	return nil
+}
+
+// RemoveAddress removes an alias from the address book.
+func (c *Config) RemoveAddress(alias string) error {
+	if _, ok := c.Addresses[alias]; !ok {
+		return fmt.Errorf("no address book entry %q", alias)
+	}
+	delete(c.Addresses, alias)
+	return nil
+}
+
+// LookupAddress returns the address of an address book alias.
+func (c *Config) LookupAddress(alias string) (string, bool) {
+	address, ok := c.Addresses[alias]
+	return address, ok
+}
+
+// AddressAliases returns the address book aliases, sorted.
+func (c *Config) AddressAliases() []string {
+	aliases := make([]string, 0, len(c.Addresses))
+	for alias := range c.Addresses {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -15,15 +16,26 @@ type Config struct {
 	// Container is the Docker container name.
 	Container string `json:"container" yaml:"container"`
 
+	// Runtime is the container runtime binary (docker or podman).
+	Runtime string `json:"runtime,omitempty" yaml:"runtime,omitempty"`
+
+	// DockerHost is the container daemon address (default: DOCKER_HOST).
+	DockerHost string `json:"docker_host,omitempty" yaml:"docker_host,omitempty"`
+
 	// ChainID is the blockchain network identifier.
 	ChainID string `json:"chain_id" yaml:"chain_id"`
 
 	// Home is the sekaid home directory.
 	Home string `json:"home" yaml:"home"`
 
-	// Node is the RPC endpoint URL.
+	// Node is the RPC endpoint URL, or a comma-separated list of endpoints
+	// to fail over between.
 	Node string `json:"node" yaml:"node"`
 
+	// NodeStrategy picks the endpoint when Node or RESTURL lists several:
+	// "first-healthy" (default) or "round-robin".
+	NodeStrategy string `json:"node_strategy,omitempty" yaml:"node_strategy,omitempty"`
+
 	// KeyringBackend is the keyring backend type.
 	KeyringBackend string `json:"keyring_backend" yaml:"keyring_backend"`
 
@@ -51,14 +63,55 @@ type Config struct {
 	// Verbose enables verbose output.
 	Verbose bool `json:"verbose" yaml:"verbose"`
 
+	// CacheTTL is how old the network cache may get before commands warn
+	// that it may be outdated, e.g. "24h" or "7d" ("0" disables warnings).
+	CacheTTL string `json:"cache_ttl,omitempty" yaml:"cache_ttl,omitempty"`
+
+	// Profiles are named sets of connection settings, e.g. one per network.
+	// The top-level settings form the implicit "default" profile.
+	Profiles map[string]*Profile `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+
+	// ActiveProfile is the profile used when --profile is not given.
+	ActiveProfile string `json:"active_profile,omitempty" yaml:"active_profile,omitempty"`
+
+	// Aliases map user-defined command names to command templates, e.g.
+	// "fund": "tx bank send faucet {{.arg0}} 1000000ukex".
+	Aliases map[string]string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+
+	// Addresses is the address book: aliases of addresses without a key in
+	// the keyring, e.g. "treasury": "kira1...". Commands accept an alias
+	// wherever they take a recipient address.
+	Addresses map[string]string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+
 	// configPath is the path where config was loaded from.
 	configPath string
 }
 
+// DefaultProfile is the name of the implicit profile formed by the
+// top-level settings.
+const DefaultProfile = "default"
+
+// Profile holds connection settings for one network. Empty values fall
+// back to the top-level settings.
+type Profile struct {
+	Container      string `json:"container,omitempty" yaml:"container,omitempty"`
+	Runtime        string `json:"runtime,omitempty" yaml:"runtime,omitempty"`
+	DockerHost     string `json:"docker_host,omitempty" yaml:"docker_host,omitempty"`
+	ChainID        string `json:"chain_id,omitempty" yaml:"chain_id,omitempty"`
+	Home           string `json:"home,omitempty" yaml:"home,omitempty"`
+	Node           string `json:"node,omitempty" yaml:"node,omitempty"`
+	KeyringBackend string `json:"keyring_backend,omitempty" yaml:"keyring_backend,omitempty"`
+	Fees           string `json:"fees,omitempty" yaml:"fees,omitempty"`
+	Gas            string `json:"gas,omitempty" yaml:"gas,omitempty"`
+	UseREST        bool   `json:"use_rest,omitempty" yaml:"use_rest,omitempty"`
+	RESTURL        string `json:"rest_url,omitempty" yaml:"rest_url,omitempty"`
+}
+
 // Default returns a Config with default values.
 func Default() *Config {
 	return &Config{
 		Container:      "sekai-node",
+		Runtime:        "docker",
 		ChainID:        "localnet-1",
 		Home:           "/sekai",
 		Node:           "tcp://localhost:26657",
@@ -143,6 +196,9 @@ func (c *Config) loadFromEnv() {
 	if v := os.Getenv("SEKAI_CONTAINER"); v != "" {
 		c.Container = v
 	}
+	if v := os.Getenv("SEKAI_RUNTIME"); v != "" {
+		c.Runtime = v
+	}
 	if v := os.Getenv("SEKAI_CHAIN_ID"); v != "" {
 		c.ChainID = v
 	}
@@ -152,6 +208,9 @@ func (c *Config) loadFromEnv() {
 	if v := os.Getenv("SEKAI_NODE"); v != "" {
 		c.Node = v
 	}
+	if v := os.Getenv("SEKAI_NODE_STRATEGY"); v != "" {
+		c.NodeStrategy = v
+	}
 	if v := os.Getenv("SEKAI_KEYRING_BACKEND"); v != "" {
 		c.KeyringBackend = v
 	}
@@ -181,14 +240,22 @@ func (c *Config) loadFromEnv() {
 	if v := os.Getenv("SEKAI_VERBOSE"); v != "" {
 		c.Verbose = v == "true" || v == "1"
 	}
+	if v := os.Getenv("SEKAI_CACHE_TTL"); v != "" {
+		c.CacheTTL = v
+	}
+	if v := os.Getenv("SEKAI_PROFILE"); v != "" {
+		c.ActiveProfile = v
+	}
 }
 
 // parseYAML parses a simple YAML-like configuration format.
-// This is a basic implementation that handles key: value pairs.
+// This is a basic implementation that handles key: value pairs and the
+// indented name: value entries of the "aliases:" and "addresses:" sections.
 func (c *Config) parseYAML(data string) error {
 	lines := strings.Split(data, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
+	section := ""
+	for _, raw := range lines {
+		line := strings.TrimSpace(raw)
 		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
@@ -203,15 +270,43 @@ func (c *Config) parseYAML(data string) error {
 		// Remove quotes if present
 		value = strings.Trim(value, `"'`)
 
+		indented := strings.HasPrefix(raw, " ") || strings.HasPrefix(raw, "\t")
+		if section != "" && indented {
+			name := strings.Trim(key, `"'`)
+			switch section {
+			case "aliases":
+				if c.Aliases == nil {
+					c.Aliases = make(map[string]string)
+				}
+				c.Aliases[name] = value
+			case "addresses":
+				if c.Addresses == nil {
+					c.Addresses = make(map[string]string)
+				}
+				c.Addresses[name] = value
+			}
+			continue
+		}
+		section = ""
+		if (key == "aliases" || key == "addresses") && value == "" {
+			section = key
+		}
+
 		switch key {
 		case "container":
 			c.Container = value
+		case "runtime":
+			c.Runtime = value
+		case "docker_host":
+			c.DockerHost = value
 		case "chain_id":
 			c.ChainID = value
 		case "home":
 			c.Home = value
 		case "node":
 			c.Node = value
+		case "node_strategy":
+			c.NodeStrategy = value
 		case "keyring_backend":
 			c.KeyringBackend = value
 		case "fees":
@@ -232,6 +327,10 @@ func (c *Config) parseYAML(data string) error {
 			c.RESTURL = value
 		case "verbose":
 			c.Verbose = value == "true"
+		case "cache_ttl":
+			c.CacheTTL = value
+		case "active_profile":
+			c.ActiveProfile = value
 		}
 	}
 	return nil
@@ -303,6 +402,18 @@ func (c *Config) Merge(other *Config) {
 	if other == nil {
 		return
 	}
+	for name, template := range other.Aliases {
+		if c.Aliases == nil {
+			c.Aliases = make(map[string]string)
+		}
+		c.Aliases[name] = template
+	}
+	for alias, address := range other.Addresses {
+		if c.Addresses == nil {
+			c.Addresses = make(map[string]string)
+		}
+		c.Addresses[alias] = address
+	}
 	if other.Container != "" {
 		c.Container = other.Container
 	}
@@ -315,6 +426,9 @@ func (c *Config) Merge(other *Config) {
 	if other.Node != "" {
 		c.Node = other.Node
 	}
+	if other.NodeStrategy != "" {
+		c.NodeStrategy = other.NodeStrategy
+	}
 	if other.KeyringBackend != "" {
 		c.KeyringBackend = other.KeyringBackend
 	}
@@ -342,6 +456,9 @@ func (c *Config) Merge(other *Config) {
 	if other.Verbose {
 		c.Verbose = other.Verbose
 	}
+	if other.CacheTTL != "" {
+		c.CacheTTL = other.CacheTTL
+	}
 }
 
 // Validate validates the configuration.
@@ -357,3 +474,47 @@ func (c *Config) Validate() error {
 	}
 	return nil
 }
+
+// Profile returns the named profile. An empty name selects the active
+// profile, and the default profile is empty so that every value falls
+// back to the top-level settings.
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.ActiveProfile
+	}
+	if name == "" || name == DefaultProfile {
+		if p, ok := c.Profiles[DefaultProfile]; ok {
+			return p, nil
+		}
+		return &Profile{}, nil
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q (available: %s)", name, strings.Join(c.ProfileNames(), ", "))
+	}
+	return p, nil
+}
+
+// ProfileNames returns the names of all profiles, including the default.
+func (c *Config) ProfileNames() []string {
+	names := []string{DefaultProfile}
+	for name := range c.Profiles {
+		if name != DefaultProfile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// UseProfile makes the named profile active.
+func (c *Config) UseProfile(name string) error {
+	if _, err := c.Profile(name); err != nil {
+		return err
+	}
+	if name == DefaultProfile {
+		name = ""
+	}
+	c.ActiveProfile = name
+	return nil
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Keys returns the names of the settings that can be read and written with
+// Get and Set, e.g. "chain-id". They are the JSON names of the scalar
+// Config fields with dashes for underscores.
+func Keys() []string {
+	var keys []string
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		if key := fieldKey(t.Field(i)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ProfileKeys returns the names of the settings a profile can override.
+func ProfileKeys() []string {
+	var keys []string
+	t := reflect.TypeOf(Profile{})
+	for i := 0; i < t.NumField(); i++ {
+		if key := fieldKey(t.Field(i)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Get returns the value of a setting as text.
+func (c *Config) Get(key string) (string, error) {
+	field, err := lookupField(reflect.ValueOf(c).Elem(), key, Keys())
+	if err != nil {
+		return "", err
+	}
+	return formatField(field), nil
+}
+
+// Set validates value and assigns it to a setting. Setting
+// "active-profile" requires the profile to exist.
+func (c *Config) Set(key, value string) error {
+	field, err := lookupField(reflect.ValueOf(c).Elem(), key, Keys())
+	if err != nil {
+		return err
+	}
+	if normalizeKey(key) == "active-profile" {
+		return c.UseProfile(value)
+	}
+	return setField(field, normalizeKey(key), value)
+}
+
+// Get returns the value a profile overrides a setting with, or "" if it
+// falls back to the top-level setting.
+func (p *Profile) Get(key string) (string, error) {
+	field, err := lookupField(reflect.ValueOf(p).Elem(), key, ProfileKeys())
+	if err != nil {
+		return "", err
+	}
+	return formatField(field), nil
+}
+
+// Set validates value and assigns it to a setting of the profile. An
+// empty value makes the profile fall back to the top-level setting.
+func (p *Profile) Set(key, value string) error {
+	field, err := lookupField(reflect.ValueOf(p).Elem(), key, ProfileKeys())
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		field.SetZero()
+		return nil
+	}
+	return setField(field, normalizeKey(key), value)
+}
+
+// fieldKey returns the setting name of a struct field, or "" if it is not
+// a scalar setting.
+func fieldKey(f reflect.StructField) string {
+	switch f.Type.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float64:
+	default:
+		return ""
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return ""
+	}
+	return strings.ReplaceAll(name, "_", "-")
+}
+
+// normalizeKey accepts both "chain-id" and "chain_id".
+func normalizeKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "_", "-")
+}
+
+// lookupField returns the field of v named by key.
+func lookupField(v reflect.Value, key string, valid []string) (reflect.Value, error) {
+	key = normalizeKey(key)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if fieldKey(t.Field(i)) == key {
+			return v.Field(i), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("unknown config key %q (valid keys: %s)", key, strings.Join(valid, ", "))
+}
+
+// formatField returns the value of a setting as text.
+func formatField(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return v.String()
+	}
+}
+
+// setField parses value for the type of the setting, validates it and
+// assigns it.
+func setField(v reflect.Value, key, value string) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q is not true or false", key, value)
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %q is not a number", key, value)
+		}
+		if key == "gas-adjustment" && f < 1.0 {
+			return fmt.Errorf("invalid %s: must be >= 1.0", key)
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	if err := validateValue(key, value); err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	v.SetString(value)
+	return nil
+}
+
+// coinsPattern matches fee amounts such as "100ukex" or "100ukex,5lol".
+var coinsPattern = regexp.MustCompile(`^\d+[a-zA-Z][a-zA-Z0-9/:._-]*(,\d+[a-zA-Z][a-zA-Z0-9/:._-]*)*$`)
+
+// validateValue checks a string setting.
+func validateValue(key, value string) error {
+	oneOf := func(allowed ...string) error {
+		for _, a := range allowed {
+			if value == a {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q must be one of %s", value, strings.Join(allowed, ", "))
+	}
+	switch key {
+	case "node":
+		return validateURLs(value, "tcp", "http", "https")
+	case "node-strategy":
+		return oneOf("first-healthy", "round-robin")
+	case "rest-url":
+		return validateURLs(value, "http", "https")
+	case "docker-host":
+		if value == "" {
+			return nil
+		}
+		return validateURL(value, "unix", "tcp", "ssh", "http", "https", "npipe")
+	case "runtime":
+		return oneOf("docker", "podman")
+	case "keyring-backend":
+		return oneOf("test", "file", "os")
+	case "broadcast-mode":
+		return oneOf("sync", "async", "block")
+	case "output":
+		return oneOf("text", "json", "yaml", "table", "csv")
+	case "fees":
+		if !coinsPattern.MatchString(value) {
+			return fmt.Errorf("%q is not an amount such as 100ukex", value)
+		}
+	case "gas":
+		if value != "auto" {
+			if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+				return fmt.Errorf("%q is not a gas limit or \"auto\"", value)
+			}
+		}
+	case "cache-ttl":
+		return validateTTL(value)
+	}
+	return nil
+}
+
+// validateURL checks that value is a URL with a host and one of the
+// given schemes.
+func validateURL(value string, schemes ...string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a URL: %w", value, err)
+	}
+	for _, s := range schemes {
+		if u.Scheme == s {
+			if u.Host == "" && u.Scheme != "unix" && u.Scheme != "npipe" {
+				return fmt.Errorf("%q has no host", value)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%q must start with %s://", value, strings.Join(schemes, ":// or "))
+}
+
+// validateURLs checks a comma-separated list of URLs with validateURL.
+func validateURLs(value string, schemes ...string) error {
+	for _, v := range strings.Split(value, ",") {
+		if err := validateURL(strings.TrimSpace(v), schemes...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTTL checks a cache age limit: "0", seconds, days ("7d") or a
+// duration such as "24h".
+func validateTTL(value string) error {
+	if _, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.ParseUint(days, 10, 64); err == nil && n > 0 {
+			return nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= time.Second {
+		return nil
+	}
+	return fmt.Errorf("%q is not a duration such as 24h or 7d", value)
+}
@@ -0,0 +1,133 @@
+package output
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Additional ANSI escape sequences used by themes.
+const (
+	ansiBlue    = "\033[34m"
+	ansiMagenta = "\033[35m"
+	ansiCyan    = "\033[36m"
+)
+
+// Theme maps field roles to ANSI color sequences. An empty color leaves
+// that role uncolored.
+type Theme struct {
+	// Key colors field and map keys.
+	Key string
+
+	// Address colors bech32 and hex addresses.
+	Address string
+
+	// Amount colors coin amounts such as "100ukex".
+	Amount string
+
+	// Success colors a zero result code.
+	Success string
+
+	// Failure colors a non-zero result code.
+	Failure string
+}
+
+// DefaultTheme returns the theme used for colored text output.
+func DefaultTheme() *Theme {
+	return &Theme{
+		Key:     ansiCyan,
+		Address: ansiMagenta,
+		Amount:  ansiYellow,
+		Success: ansiGreen,
+		Failure: ansiRed,
+	}
+}
+
+// ColorEnabled reports whether colored output should be written to w.
+// Colors are disabled by noColor, by the NO_COLOR environment variable,
+// for TERM=dumb and when w is not a terminal.
+func ColorEnabled(w io.Writer, noColor bool) bool {
+	if noColor {
+		return false
+	}
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return IsTerminal(w)
+}
+
+// IsTerminal reports whether w is a terminal.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	// /dev/null is a character device too, but not a terminal.
+	null, err := os.Stat(os.DevNull)
+	return err != nil || !os.SameFile(info, null)
+}
+
+var (
+	amountPattern  = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?[a-zA-Z][a-zA-Z0-9/:._-]*$`)
+	hexAddrPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+)
+
+// paint wraps s in color, or returns s unchanged if color is empty.
+func paint(color, s string) string {
+	if color == "" || s == "" {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+// valueColor returns the theme color for a scalar value of the named
+// field, or "" if the value has no role.
+func (t *Theme) valueColor(key, value string) string {
+	if t == nil {
+		return ""
+	}
+	switch strings.ToLower(key) {
+	case "code", "result_code":
+		if value == "0" {
+			return t.Success
+		}
+		return t.Failure
+	}
+	if isAddress(value) {
+		return t.Address
+	}
+	if isAmount(value) {
+		return t.Amount
+	}
+	return ""
+}
+
+// isAddress reports whether s looks like a KIRA bech32 or hex address.
+func isAddress(s string) bool {
+	if strings.HasPrefix(s, "kira1") || strings.HasPrefix(s, "kiravaloper1") || strings.HasPrefix(s, "kiravalcons1") {
+		return len(s) >= 38 && !strings.ContainsAny(s, " \t")
+	}
+	return hexAddrPattern.MatchString(s)
+}
+
+// isAmount reports whether s is a coin amount or a comma-separated list
+// of them.
+func isAmount(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, part := range strings.Split(s, ",") {
+		if !amountPattern.MatchString(strings.TrimSpace(part)) {
+			return false
+		}
+	}
+	return true
+}
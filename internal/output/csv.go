@@ -0,0 +1,161 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// CSVFormatter formats data as CSV for import into spreadsheets. Lists get
+// a header row and one row per element; single objects become key,value
+// rows. Nested objects are flattened into dotted keys such as
+// "balance.amount", and nested lists are written as compact JSON.
+type CSVFormatter struct {
+	// Columns selects and orders the columns to show. Empty shows all columns.
+	Columns []string
+}
+
+// Format formats data as CSV.
+func (f *CSVFormatter) Format(w io.Writer, data interface{}) error {
+	s, err := f.FormatString(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, s)
+	return err
+}
+
+// FormatString formats data as a CSV string.
+func (f *CSVFormatter) FormatString(data interface{}) (string, error) {
+	if data == nil {
+		return "", nil
+	}
+
+	v := reflect.ValueOf(data)
+	if raw, ok := data.(json.RawMessage); ok {
+		var parsed interface{}
+		if err := json.Unmarshal(raw, &parsed); err != nil {
+			return writeCSV([][]string{{string(raw)}})
+		}
+		v = reflect.ValueOf(parsed)
+	}
+	v = unwrapList(indirect(v))
+
+	if !v.IsValid() {
+		return "", nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return "", nil
+		}
+		columns, rows := csvRows(v)
+		if len(f.Columns) > 0 {
+			columns, rows = selectColumns(columns, rows, f.Columns)
+		}
+		return writeCSV(append([][]string{columns}, rows...))
+	case reflect.Struct, reflect.Map:
+		var flat []flatField
+		flattenFields("", v, &flat)
+		rows := [][]string{{"key", "value"}}
+		for _, field := range flat {
+			rows = append(rows, []string{field.name, field.value})
+		}
+		if len(f.Columns) > 0 {
+			rows = append(rows[:1], filterKeyRows(rows[1:], f.Columns)...)
+		}
+		return writeCSV(rows)
+	default:
+		return writeCSV([][]string{{cellString(v)}})
+	}
+}
+
+// flatField is a scalar value at a dotted path of a nested object.
+type flatField struct {
+	name  string
+	value string
+}
+
+// flattenFields appends the fields of a struct or map to out, descending
+// into nested objects with dotted names. Lists and scalars are leaves.
+func flattenFields(prefix string, v reflect.Value, out *[]flatField) {
+	for _, name := range fieldNames(v) {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		field := indirect(fieldValue(v, name))
+		if field.IsValid() && (field.Kind() == reflect.Struct || field.Kind() == reflect.Map) {
+			flattenFields(key, field, out)
+			continue
+		}
+		value := ""
+		if !isEmptyList(field) {
+			value = cellString(field)
+		}
+		*out = append(*out, flatField{name: key, value: value})
+	}
+}
+
+// isEmptyList reports whether v is a nil or empty slice, which is written
+// as an empty field rather than "null" or "[]".
+func isEmptyList(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Len() == 0
+}
+
+// csvRows builds columns and rows from a slice like tableRows, flattening
+// nested objects of each element into dotted columns.
+func csvRows(v reflect.Value) ([]string, [][]string) {
+	var columns []string
+	seen := make(map[string]bool)
+	items := make([]map[string]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := indirect(v.Index(i))
+		if item.Kind() != reflect.Struct && item.Kind() != reflect.Map {
+			continue
+		}
+		var flat []flatField
+		flattenFields("", item, &flat)
+		items[i] = make(map[string]string, len(flat))
+		for _, field := range flat {
+			items[i][field.name] = field.value
+			if !seen[field.name] {
+				seen[field.name] = true
+				columns = append(columns, field.name)
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		rows := make([][]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			rows = append(rows, []string{cellString(v.Index(i))})
+		}
+		return []string{"value"}, rows
+	}
+
+	rows := make([][]string, 0, v.Len())
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for j, col := range columns {
+			row[j] = item[col]
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows
+}
+
+// writeCSV encodes records with encoding/csv, which quotes fields
+// containing commas, quotes or newlines.
+func writeCSV(records [][]string) (string, error) {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,196 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ANSI escape sequences used to highlight diffs.
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+)
+
+// ChangeKind describes how a field changed between two snapshots.
+type ChangeKind string
+
+const (
+	// ChangeAdded means the field is new in the current snapshot.
+	ChangeAdded ChangeKind = "added"
+
+	// ChangeRemoved means the field is missing from the current snapshot.
+	ChangeRemoved ChangeKind = "removed"
+
+	// ChangeModified means the field value differs between snapshots.
+	ChangeModified ChangeKind = "changed"
+)
+
+// Change is a single field-level difference between two snapshots.
+type Change struct {
+	Path string      `json:"path"`
+	Kind ChangeKind  `json:"kind"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// Flatten converts data into a map of dotted field paths to scalar values
+// (e.g., "proposals[0].status"). Data is normalized through JSON first so
+// structs, maps and raw JSON responses compare alike.
+func Flatten(data interface{}) (map[string]interface{}, error) {
+	var normalized interface{}
+	if raw, ok := data.(json.RawMessage); ok {
+		if err := json.Unmarshal(raw, &normalized); err != nil {
+			return nil, fmt.Errorf("failed to parse data: %w", err)
+		}
+	} else {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode data: %w", err)
+		}
+		if err := json.Unmarshal(b, &normalized); err != nil {
+			return nil, fmt.Errorf("failed to decode data: %w", err)
+		}
+	}
+
+	result := make(map[string]interface{})
+	flattenValue("", normalized, result)
+	return result, nil
+}
+
+func flattenValue(path string, v interface{}, result map[string]interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if len(val) == 0 && path != "" {
+			result[path] = val
+			return
+		}
+		for k, child := range val {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			flattenValue(childPath, child, result)
+		}
+	case []interface{}:
+		if len(val) == 0 && path != "" {
+			result[path] = val
+			return
+		}
+		for i, child := range val {
+			flattenValue(fmt.Sprintf("%s[%d]", path, i), child, result)
+		}
+	default:
+		result[path] = val
+	}
+}
+
+// Diff returns the field-level changes from prev to curr, sorted by path.
+func Diff(prev, curr interface{}) ([]Change, error) {
+	before, err := Flatten(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := Flatten(curr)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for path, newVal := range after {
+		oldVal, ok := before[path]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Path: path, Kind: ChangeAdded, New: newVal})
+		case scalarString(oldVal) != scalarString(newVal):
+			changes = append(changes, Change{Path: path, Kind: ChangeModified, Old: oldVal, New: newVal})
+		}
+	}
+	for path, oldVal := range before {
+		if _, ok := after[path]; !ok {
+			changes = append(changes, Change{Path: path, Kind: ChangeRemoved, Old: oldVal})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// DiffFormatter renders the current snapshot with changes from the previous
+// snapshot highlighted: "+" for added, "-" for removed and "~" for changed.
+type DiffFormatter struct {
+	// Color enables ANSI colors for changed lines.
+	Color bool
+}
+
+// Format writes curr as "path: value" lines with changes from prev marked.
+func (f *DiffFormatter) Format(w io.Writer, prev, curr interface{}) error {
+	before, err := Flatten(prev)
+	if err != nil {
+		return err
+	}
+	after, err := Flatten(curr)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(after)+len(before))
+	for path := range after {
+		paths = append(paths, path)
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var sb strings.Builder
+	for _, path := range paths {
+		newVal, inAfter := after[path]
+		oldVal, inBefore := before[path]
+		switch {
+		case !inBefore:
+			sb.WriteString(f.line(ansiGreen, fmt.Sprintf("+ %s: %s", path, scalarString(newVal))))
+		case !inAfter:
+			sb.WriteString(f.line(ansiRed, fmt.Sprintf("- %s: %s", path, scalarString(oldVal))))
+		case scalarString(oldVal) != scalarString(newVal):
+			sb.WriteString(f.line(ansiYellow, fmt.Sprintf("~ %s: %s (was %s)", path, scalarString(newVal), scalarString(oldVal))))
+		default:
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", path, scalarString(newVal)))
+		}
+	}
+
+	_, err = fmt.Fprint(w, sb.String())
+	return err
+}
+
+// line wraps a line in the given color when colors are enabled.
+func (f *DiffFormatter) line(color, s string) string {
+	if f.Color {
+		return color + s + ansiReset + "\n"
+	}
+	return s + "\n"
+}
+
+// scalarString formats a flattened scalar value for display and comparison.
+func scalarString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return val
+	case map[string]interface{}:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
@@ -0,0 +1,196 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNoMatch is returned by ExtractField when the path selects nothing.
+var ErrNoMatch = errors.New("field path matched nothing")
+
+// pathStep is one step of a field path: a map key, an array index, or a
+// wildcard over all elements.
+type pathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ExtractField returns the value at path in the JSON encoding of data.
+// Paths are dot-separated keys with optional array steps, e.g.
+// "balances[0].amount", ".pagination.next_key" or "validators[*].address";
+// a leading "$" is allowed. A negative index counts from the end. With a
+// wildcard the result is the list of all matches.
+func ExtractField(data interface{}, path string) (interface{}, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var root interface{}
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+
+	matches := []interface{}{root}
+	multi := false
+	for _, step := range steps {
+		var next []interface{}
+		for _, m := range matches {
+			next = append(next, step.apply(m)...)
+		}
+		matches = next
+		multi = multi || step.wildcard
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoMatch, path)
+	}
+	if multi {
+		return matches, nil
+	}
+	return matches[0], nil
+}
+
+// apply returns the values step selects from v.
+func (s pathStep) apply(v interface{}) []interface{} {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			keys := make([]string, 0, len(node))
+			for k := range node {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			values := make([]interface{}, 0, len(keys))
+			for _, k := range keys {
+				values = append(values, node[k])
+			}
+			return values
+		}
+		if s.isIndex {
+			return nil
+		}
+		if val, ok := node[s.key]; ok && val != nil {
+			return []interface{}{val}
+		}
+	case []interface{}:
+		if s.wildcard {
+			return node
+		}
+		if !s.isIndex {
+			return nil
+		}
+		i := s.index
+		if i < 0 {
+			i += len(node)
+		}
+		if i >= 0 && i < len(node) {
+			return []interface{}{node[i]}
+		}
+	}
+	return nil
+}
+
+// parsePath splits a field path into steps.
+func parsePath(path string) ([]pathStep, error) {
+	p := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	var steps []pathStep
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+		case '[':
+			end := strings.IndexByte(p, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid field path %q: missing ]", path)
+			}
+			inner := strings.TrimSpace(p[1:end])
+			p = p[end+1:]
+			switch {
+			case inner == "" || inner == "*":
+				steps = append(steps, pathStep{wildcard: true})
+			case strings.HasPrefix(inner, "\"") || strings.HasPrefix(inner, "'"):
+				steps = append(steps, pathStep{key: strings.Trim(inner, "\"'")})
+			default:
+				i, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid field path %q: %q is not an index", path, inner)
+				}
+				steps = append(steps, pathStep{index: i, isIndex: true})
+			}
+			continue
+		}
+		end := strings.IndexAny(p, ".[")
+		if end < 0 {
+			end = len(p)
+		}
+		key := p[:end]
+		p = p[end:]
+		if key == "" {
+			continue
+		}
+		if key == "*" {
+			steps = append(steps, pathStep{wildcard: true})
+		} else {
+			steps = append(steps, pathStep{key: key})
+		}
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("invalid field path %q: empty", path)
+	}
+	return steps, nil
+}
+
+// WriteField writes a value returned by ExtractField. JSON and YAML
+// formatters encode it as usual; for text, scalars are printed bare and a
+// list of scalars one per line, so the output can be used in scripts.
+func WriteField(w io.Writer, f Formatter, value interface{}) error {
+	switch f.(type) {
+	case *JSONFormatter, *YAMLFormatter:
+		return f.Format(w, value)
+	}
+	if s, ok := scalarText(value); ok {
+		_, err := fmt.Fprintln(w, s)
+		return err
+	}
+	if list, ok := value.([]interface{}); ok {
+		lines := make([]string, 0, len(list))
+		for _, item := range list {
+			s, ok := scalarText(item)
+			if !ok {
+				return f.Format(w, value)
+			}
+			lines = append(lines, s)
+		}
+		_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+		return err
+	}
+	return f.Format(w, value)
+}
+
+// scalarText returns the bare text of a JSON scalar.
+func scalarText(v interface{}) (string, bool) {
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case json.Number:
+		return s.String(), true
+	case bool:
+		return strconv.FormatBool(s), true
+	}
+	return "", false
+}
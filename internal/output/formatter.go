@@ -21,6 +21,12 @@ const (
 
 	// FormatYAML is YAML format.
 	FormatYAML Format = "yaml"
+
+	// FormatTable is aligned columnar format for list-style data.
+	FormatTable Format = "table"
+
+	// FormatCSV is comma-separated values for spreadsheet import.
+	FormatCSV Format = "csv"
 )
 
 // Formatter formats data for output.
@@ -39,6 +45,10 @@ func NewFormatter(format Format) Formatter {
 		return &JSONFormatter{Indent: true}
 	case FormatYAML:
 		return &YAMLFormatter{}
+	case FormatTable:
+		return &TableFormatter{}
+	case FormatCSV:
+		return &CSVFormatter{}
 	default:
 		return &TextFormatter{}
 	}
@@ -53,6 +63,9 @@ func NewFormatterFromString(format string) Formatter {
 type TextFormatter struct {
 	// Indent is the indentation string for nested structures.
 	Indent string
+
+	// Theme colors keys and values with ANSI escapes. Nil disables colors.
+	Theme *Theme
 }
 
 // Format formats data as text.
@@ -152,9 +165,9 @@ func (f *TextFormatter) formatValue(v reflect.Value, depth int) string {
 
 			if fieldValue.Kind() == reflect.Struct || fieldValue.Kind() == reflect.Map ||
 				(fieldValue.Kind() == reflect.Slice && fieldValue.Len() > 0) {
-				sb.WriteString(fmt.Sprintf("%s%s:\n%s", prefix, name, formatted))
+				sb.WriteString(fmt.Sprintf("%s%s:\n%s", prefix, f.key(name), formatted))
 			} else {
-				sb.WriteString(fmt.Sprintf("%s%s: %s\n", prefix, name, formatted))
+				sb.WriteString(fmt.Sprintf("%s%s: %s\n", prefix, f.key(name), f.value(name, formatted)))
 			}
 		}
 		return sb.String()
@@ -168,10 +181,15 @@ func (f *TextFormatter) formatValue(v reflect.Value, depth int) string {
 			if val == "" {
 				continue
 			}
-			if iter.Value().Kind() == reflect.Struct || iter.Value().Kind() == reflect.Map {
-				sb.WriteString(fmt.Sprintf("%s%s:\n%s", prefix, key, val))
+			// Values of decoded JSON are wrapped in interfaces
+			elem := iter.Value()
+			for elem.Kind() == reflect.Interface && !elem.IsNil() {
+				elem = elem.Elem()
+			}
+			if elem.Kind() == reflect.Struct || elem.Kind() == reflect.Map || elem.Kind() == reflect.Slice {
+				sb.WriteString(fmt.Sprintf("%s%s:\n%s", prefix, f.key(key), val))
 			} else {
-				sb.WriteString(fmt.Sprintf("%s%s: %s\n", prefix, key, val))
+				sb.WriteString(fmt.Sprintf("%s%s: %s\n", prefix, f.key(key), f.value(key, val)))
 			}
 		}
 		return sb.String()
@@ -188,6 +206,7 @@ func (f *TextFormatter) formatValue(v reflect.Value, depth int) string {
 			}
 			// Remove leading whitespace for list items
 			item = strings.TrimPrefix(item, prefix)
+			item = f.value("", item)
 			sb.WriteString(fmt.Sprintf("%s- %s", prefix, item))
 			if !strings.HasSuffix(item, "\n") {
 				sb.WriteString("\n")
@@ -200,6 +219,23 @@ func (f *TextFormatter) formatValue(v reflect.Value, depth int) string {
 	}
 }
 
+// key colors a field or map key when a theme is set.
+func (f *TextFormatter) key(name string) string {
+	if f.Theme == nil {
+		return name
+	}
+	return paint(f.Theme.Key, name)
+}
+
+// value colors a scalar value by its role when a theme is set.
+// Multi-line values are nested structures and are left as is.
+func (f *TextFormatter) value(key, s string) string {
+	if f.Theme == nil || strings.Contains(s, "\n") {
+		return s
+	}
+	return paint(f.Theme.valueColor(key, s), s)
+}
+
 // JSONFormatter formats data as JSON.
 type JSONFormatter struct {
 	// Indent enables pretty-printing with indentation.
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// DenomResolver returns the display info of a denom. ok is false for
+// denoms without registered display info, which are left as they are.
+type DenomResolver func(denom string) (info types.DenomInfo, ok bool)
+
+// coinPattern matches one coin amount, e.g. "1000000ukex" or "0.5ukex".
+var coinPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)([a-zA-Z][a-zA-Z0-9/]*)$`)
+
+// Humanize returns the JSON form of data with coin amounts shown in their
+// display unit followed by the raw amount, e.g. "1 KEX (1000000ukex)".
+// Coin objects ({"denom": ..., "amount": ...}) and coin strings, also
+// comma-separated lists, are rewritten if resolve knows their denoms.
+// If data cannot be encoded it is returned unchanged.
+func Humanize(data interface{}, resolve DenomResolver) interface{} {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var root interface{}
+	if err := dec.Decode(&root); err != nil {
+		return data
+	}
+	return humanizeValue(root, resolve)
+}
+
+// humanizeValue rewrites the coins in a decoded JSON value.
+func humanizeValue(v interface{}, resolve DenomResolver) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if s, ok := humanizeCoinObject(v, resolve); ok {
+			return s
+		}
+		for key, field := range v {
+			v[key] = humanizeValue(field, resolve)
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = humanizeValue(elem, resolve)
+		}
+		return v
+	case string:
+		if s, ok := humanizeCoinString(v, resolve); ok {
+			return s
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// humanizeCoinObject formats an object holding only a denom and an amount.
+func humanizeCoinObject(v map[string]interface{}, resolve DenomResolver) (string, bool) {
+	if len(v) != 2 {
+		return "", false
+	}
+	denom, ok := v["denom"].(string)
+	if !ok {
+		return "", false
+	}
+	amount, ok := v["amount"].(string)
+	if !ok {
+		return "", false
+	}
+	return humanizeCoin(denom, amount, resolve)
+}
+
+// humanizeCoinString formats a coin string such as "100ukex,5lol". Each
+// coin must parse, and at least one denom must be known.
+func humanizeCoinString(s string, resolve DenomResolver) (string, bool) {
+	parts := strings.Split(s, ",")
+	display := make([]string, 0, len(parts))
+	known := false
+	for _, part := range parts {
+		m := coinPattern.FindStringSubmatch(strings.TrimSpace(part))
+		if m == nil {
+			return "", false
+		}
+		if h, ok := humanizeCoin(m[2], m[1], resolve); ok {
+			display = append(display, h)
+			known = true
+		} else {
+			display = append(display, m[0])
+		}
+	}
+	if !known {
+		return "", false
+	}
+	return strings.Join(display, ", "), true
+}
+
+// humanizeCoin formats one amount as "1 KEX (1000000ukex)".
+func humanizeCoin(denom, amount string, resolve DenomResolver) (string, bool) {
+	info, ok := resolve(denom)
+	if !ok {
+		return "", false
+	}
+	value, err := types.ScaleAmount(amount, info.Decimals)
+	if err != nil {
+		return "", false
+	}
+	symbol := info.Symbol
+	if symbol == "" {
+		symbol = denom
+	}
+	return types.FormatAmount(value, info.Decimals) + " " + symbol + " (" + amount + denom + ")", true
+}
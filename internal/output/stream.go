@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// JSONArrayWriter writes a JSON array one element at a time, so a long
+// list can be printed as it is read instead of being held in memory. The
+// output matches that of JSONFormatter for the whole array.
+type JSONArrayWriter struct {
+	w      io.Writer
+	indent bool
+	count  int
+	buf    bytes.Buffer
+	enc    *json.Encoder
+}
+
+// NewJSONArrayWriter returns a writer of a JSON array to w, indented like
+// f's output.
+func NewJSONArrayWriter(w io.Writer, f *JSONFormatter) *JSONArrayWriter {
+	a := &JSONArrayWriter{w: w, indent: f.Indent}
+	a.enc = json.NewEncoder(&a.buf)
+	if f.Indent {
+		a.enc.SetIndent("  ", "  ")
+	}
+	return a
+}
+
+// Write appends v to the array.
+func (a *JSONArrayWriter) Write(v interface{}) error {
+	a.buf.Reset()
+	if a.count == 0 {
+		a.buf.WriteByte('[')
+	} else {
+		a.buf.WriteByte(',')
+	}
+	if a.indent {
+		a.buf.WriteString("\n  ")
+	}
+	if err := a.enc.Encode(v); err != nil {
+		return err
+	}
+	// Encode ends each value with a newline
+	a.buf.Truncate(a.buf.Len() - 1)
+	a.count++
+	_, err := a.w.Write(a.buf.Bytes())
+	return err
+}
+
+// Count returns the number of elements written.
+func (a *JSONArrayWriter) Count() int {
+	return a.count
+}
+
+// Close ends the array; an array without elements is written as [].
+func (a *JSONArrayWriter) Close() error {
+	end := "]\n"
+	switch {
+	case a.count == 0:
+		end = "[]\n"
+	case a.indent:
+		end = "\n]\n"
+	}
+	_, err := io.WriteString(a.w, end)
+	return err
+}
@@ -0,0 +1,290 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// TableFormatter formats list-style data as aligned columns with a header row.
+// Single objects are rendered as key/value rows.
+type TableFormatter struct {
+	// Columns selects and orders the columns to show. Empty shows all columns.
+	Columns []string
+}
+
+// Format formats data as a table.
+func (f *TableFormatter) Format(w io.Writer, data interface{}) error {
+	s, err := f.FormatString(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, s)
+	return err
+}
+
+// FormatString formats data as a table string.
+func (f *TableFormatter) FormatString(data interface{}) (string, error) {
+	if data == nil {
+		return "No results\n", nil
+	}
+
+	v := reflect.ValueOf(data)
+	if raw, ok := data.(json.RawMessage); ok {
+		var parsed interface{}
+		if err := json.Unmarshal(raw, &parsed); err != nil {
+			return string(raw) + "\n", nil
+		}
+		v = reflect.ValueOf(parsed)
+	}
+	v = unwrapList(indirect(v))
+
+	if !v.IsValid() {
+		return "No results\n", nil
+	}
+
+	var columns []string
+	var rows [][]string
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return "No results\n", nil
+		}
+		columns, rows = tableRows(v)
+	case reflect.Struct, reflect.Map:
+		columns = []string{"key", "value"}
+		for _, field := range fieldNames(v) {
+			rows = append(rows, []string{field, cellString(fieldValue(v, field))})
+		}
+		if len(f.Columns) > 0 {
+			rows = filterKeyRows(rows, f.Columns)
+		}
+		return renderTable(columns, rows), nil
+	default:
+		return cellString(v) + "\n", nil
+	}
+
+	if len(f.Columns) > 0 {
+		columns, rows = selectColumns(columns, rows, f.Columns)
+	}
+	return renderTable(columns, rows), nil
+}
+
+// indirect dereferences pointers and interfaces.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// unwrapList returns the inner list of a response wrapper such as
+// {"proposals": [...]} or {"data": [...], "pagination": {...}}, so list
+// queries render as rows. Other values are returned unchanged.
+func unwrapList(v reflect.Value) reflect.Value {
+	if !v.IsValid() || (v.Kind() != reflect.Struct && v.Kind() != reflect.Map) {
+		return v
+	}
+
+	var list reflect.Value
+	for _, name := range fieldNames(v) {
+		field := indirect(fieldValue(v, name))
+		if !field.IsValid() {
+			continue
+		}
+		if field.Kind() == reflect.Slice || field.Kind() == reflect.Array {
+			if list.IsValid() {
+				return v // more than one list, not a simple wrapper
+			}
+			list = field
+		}
+	}
+	if list.IsValid() {
+		return list
+	}
+	return v
+}
+
+// fieldNames returns the display names of a struct's exported fields (in
+// declaration order, using json tags) or a map's keys (sorted).
+func fieldNames(v reflect.Value) []string {
+	var names []string
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if name := jsonFieldName(t.Field(i)); name != "" {
+				names = append(names, name)
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			names = append(names, fmt.Sprintf("%v", k.Interface()))
+		}
+		sort.Strings(names)
+	}
+	return names
+}
+
+// fieldValue returns the value of a struct field or map entry by display name.
+func fieldValue(v reflect.Value, name string) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if jsonFieldName(t.Field(i)) == name {
+				return v.Field(i)
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			if fmt.Sprintf("%v", k.Interface()) == name {
+				return v.MapIndex(k)
+			}
+		}
+	}
+	return reflect.Value{}
+}
+
+// jsonFieldName returns the json name of an exported field, or "" if skipped.
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name := field.Name
+	if tag := field.Tag.Get("json"); tag != "" {
+		parts := strings.Split(tag, ",")
+		if parts[0] == "-" {
+			return ""
+		}
+		if parts[0] != "" {
+			name = parts[0]
+		}
+	}
+	return name
+}
+
+// tableRows builds columns and rows from a slice. Struct and map elements
+// become one row each; scalar elements go in a single "value" column.
+func tableRows(v reflect.Value) ([]string, [][]string) {
+	var columns []string
+	seen := make(map[string]bool)
+	for i := 0; i < v.Len(); i++ {
+		item := indirect(v.Index(i))
+		if item.Kind() != reflect.Struct && item.Kind() != reflect.Map {
+			continue
+		}
+		for _, name := range fieldNames(item) {
+			if !seen[name] {
+				seen[name] = true
+				columns = append(columns, name)
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		rows := make([][]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			rows = append(rows, []string{cellString(v.Index(i))})
+		}
+		return []string{"value"}, rows
+	}
+
+	rows := make([][]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := indirect(v.Index(i))
+		row := make([]string, len(columns))
+		for j, col := range columns {
+			row[j] = cellString(fieldValue(item, col))
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows
+}
+
+// selectColumns keeps only the wanted columns, in the wanted order.
+// Column names match case-insensitively; unknown columns are left empty.
+func selectColumns(columns []string, rows [][]string, wanted []string) ([]string, [][]string) {
+	index := make([]int, len(wanted))
+	for i, w := range wanted {
+		index[i] = -1
+		for j, c := range columns {
+			if strings.EqualFold(c, w) {
+				index[i] = j
+				break
+			}
+		}
+	}
+
+	selected := make([][]string, len(rows))
+	for r, row := range rows {
+		selected[r] = make([]string, len(wanted))
+		for i, j := range index {
+			if j >= 0 {
+				selected[r][i] = row[j]
+			}
+		}
+	}
+	return wanted, selected
+}
+
+// filterKeyRows keeps key/value rows whose key is in wanted.
+func filterKeyRows(rows [][]string, wanted []string) [][]string {
+	var filtered [][]string
+	for _, w := range wanted {
+		for _, row := range rows {
+			if strings.EqualFold(row[0], w) {
+				filtered = append(filtered, row)
+			}
+		}
+	}
+	return filtered
+}
+
+// cellString formats a value for a table cell. Nested values are shown
+// as compact JSON.
+func cellString(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes())
+		}
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Sprintf("%v", v.Interface())
+		}
+		return string(b)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// renderTable writes columns and rows aligned with an upper-case header.
+func renderTable(columns []string, rows [][]string) string {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = strings.ToUpper(c)
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+
+	return sb.String()
+}
@@ -0,0 +1,520 @@
+package scenarios
+
+import "strings"
+
+// ActionSpec describes an action the ActionMapper implements for a module.
+type ActionSpec struct {
+	// Required lists the params the action fails without
+	Required []string `json:"required,omitempty"`
+
+	// OneOf lists params of which at least one must be set
+	OneOf []string `json:"one_of,omitempty"`
+
+	// Tx reports whether the action signs and broadcasts a transaction
+	Tx bool `json:"tx,omitempty"`
+}
+
+// moduleAliases maps alternative module names to the module the mapper
+// executes them as.
+var moduleAliases = map[string]string{
+	"customgov":      "gov",
+	"customstaking":  "staking",
+	"customevidence": "evidence",
+	"customslashing": "slashing",
+}
+
+// mappedActions lists, per module, the actions ActionMapper.Execute maps
+// to SDK calls, including their alternative spellings. Modules missing
+// here run through generic execution. Keep it in sync with the mapper.
+var mappedActions = map[string]map[string]ActionSpec{
+	"keys": {
+		"list":           {},
+		"show":           {Required: []string{"name"}},
+		"add":            {Required: []string{"name"}},
+		"delete":         {Required: []string{"name"}},
+		"export":         {Required: []string{"name"}},
+		"import":         {Required: []string{"name", "armor"}},
+		"rename":         {Required: []string{"old_name", "new_name"}},
+		"mnemonic":       {Required: []string{"name"}},
+		"get-address":    {Required: []string{"name"}},
+		"getaddress":     {Required: []string{"name"}},
+		"address":        {Required: []string{"name"}},
+		"exists":         {Required: []string{"name"}},
+		"create":         {Required: []string{"name"}},
+		"recover":        {Required: []string{"name", "mnemonic"}},
+		"import-hex":     {Required: []string{"name", "hex_key"}},
+		"importhex":      {Required: []string{"name", "hex_key"}},
+		"list-key-types": {},
+		"listkeytypes":   {},
+		"migrate":        {},
+		"parse":          {Required: []string{"address"}},
+	},
+	"bank": {
+		"balances":            {Required: []string{"address"}},
+		"balance":             {Required: []string{"address"}},
+		"total":               {},
+		"total-supply":        {},
+		"send":                {Required: []string{"from", "to", "amount"}, Tx: true},
+		"spendable-balances":  {Required: []string{"address"}},
+		"spendablebalances":   {Required: []string{"address"}},
+		"supply-of":           {Required: []string{"denom"}},
+		"supplyof":            {Required: []string{"denom"}},
+		"multi-send":          {Required: []string{"from", "to_addresses", "amount"}, Tx: true},
+		"multisend":           {Required: []string{"from", "to_addresses", "amount"}, Tx: true},
+		"denom-metadata":      {},
+		"denommetadata":       {},
+		"all-denoms-metadata": {},
+		"alldenomsmetadata":   {},
+		"send-enabled":        {},
+		"sendenabled":         {},
+	},
+	"auth": {
+		"account":            {Required: []string{"address"}},
+		"accounts":           {},
+		"module-accounts":    {},
+		"params":             {},
+		"module-account":     {Required: []string{"name"}},
+		"moduleaccount":      {Required: []string{"name"}},
+		"address-by-acc-num": {Required: []string{"acc_num"}},
+		"addressbyaccnum":    {Required: []string{"acc_num"}},
+	},
+	"gov": {
+		"network-properties":                  {},
+		"proposals":                           {},
+		"proposal":                            {Required: []string{"id"}},
+		"all-roles":                           {},
+		"role":                                {OneOf: []string{"id", "sid"}},
+		"roles":                               {Required: []string{"address"}},
+		"permissions":                         {Required: []string{"address"}},
+		"councilors":                          {},
+		"vote":                                {Required: []string{"from", "proposal_id", "option"}, Tx: true},
+		"proposal-vote":                       {Required: []string{"from", "proposal_id", "option"}, Tx: true},
+		"claim-seat":                          {Required: []string{"from"}, Tx: true},
+		"councilor-claim-seat":                {Required: []string{"from"}, Tx: true},
+		"role-create":                         {Required: []string{"from", "sid"}, Tx: true},
+		"create-role":                         {Required: []string{"from", "sid"}, Tx: true},
+		"role-assign":                         {Required: []string{"from", "address", "role"}, Tx: true},
+		"assign-role":                         {Required: []string{"from", "address", "role"}, Tx: true},
+		"votes":                               {Required: []string{"proposal_id"}},
+		"voters":                              {Required: []string{"proposal_id"}},
+		"execution-fee":                       {Required: []string{"tx_type"}},
+		"executionfee":                        {Required: []string{"tx_type"}},
+		"all-execution-fees":                  {},
+		"allexecutionfees":                    {},
+		"identity-record":                     {Required: []string{"id"}},
+		"identityrecord":                      {Required: []string{"id"}},
+		"identity-records":                    {},
+		"identityrecords":                     {},
+		"identity-records-by-address":         {Required: []string{"address"}},
+		"identityrecordsbyaddress":            {Required: []string{"address"}},
+		"data-registry":                       {Required: []string{"key"}},
+		"dataregistry":                        {Required: []string{"key"}},
+		"data-registry-keys":                  {},
+		"dataregistrykeys":                    {},
+		"polls":                               {Required: []string{"address"}},
+		"poll-votes":                          {Required: []string{"poll_id"}},
+		"pollvotes":                           {Required: []string{"poll_id"}},
+		"poor-network-messages":               {},
+		"poornetworkmessages":                 {},
+		"custom-prefixes":                     {},
+		"customprefixes":                      {},
+		"all-proposal-durations":              {},
+		"allproposaldurations":                {},
+		"proposal-duration":                   {Required: []string{"proposal_type"}},
+		"proposalduration":                    {Required: []string{"proposal_type"}},
+		"non-councilors":                      {},
+		"noncouncilors":                       {},
+		"council-registry":                    {},
+		"councilregistry":                     {},
+		"whitelisted-permission-addresses":    {Required: []string{"permission"}},
+		"whitelistedpermissionaddresses":      {Required: []string{"permission"}},
+		"blacklisted-permission-addresses":    {Required: []string{"permission"}},
+		"blacklistedpermissionaddresses":      {Required: []string{"permission"}},
+		"whitelisted-role-addresses":          {Required: []string{"role"}},
+		"whitelistedroleaddresses":            {Required: []string{"role"}},
+		"proposer-voters-count":               {},
+		"proposervoterscount":                 {},
+		"all-identity-record-verify-requests": {},
+		"allidentityrecordverifyrequests":     {},
+		"identity-record-verify-request":      {Required: []string{"id"}},
+		"identityrecordverifyrequest":         {Required: []string{"id"}},
+		"identity-record-verify-requests-by-approver":    {Required: []string{"approver"}},
+		"identityrecordverifyrequestsbyapprover":         {Required: []string{"approver"}},
+		"identity-record-verify-requests-by-requester":   {Required: []string{"requester"}},
+		"identityrecordverifyrequestsbyrequester":        {Required: []string{"requester"}},
+		"councilor-activate":                             {Required: []string{"from"}, Tx: true},
+		"counciloractivate":                              {Required: []string{"from"}, Tx: true},
+		"councilor-pause":                                {Required: []string{"from"}, Tx: true},
+		"councilorpause":                                 {Required: []string{"from"}, Tx: true},
+		"councilor-unpause":                              {Required: []string{"from"}, Tx: true},
+		"councilorunpause":                               {Required: []string{"from"}, Tx: true},
+		"permission-whitelist":                           {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permissionwhitelist":                            {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permission-blacklist":                           {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permissionblacklist":                            {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permission-remove-whitelisted":                  {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permissionremovewhitelisted":                    {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permission-remove-blacklisted":                  {Required: []string{"from", "address", "permission"}, Tx: true},
+		"permissionremoveblacklisted":                    {Required: []string{"from", "address", "permission"}, Tx: true},
+		"role-unassign":                                  {Required: []string{"from", "address", "role"}, Tx: true},
+		"unassign-role":                                  {Required: []string{"from", "address", "role"}, Tx: true},
+		"role-whitelist-permission":                      {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"rolewhitelistpermission":                        {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"role-blacklist-permission":                      {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"roleblacklistpermission":                        {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"role-remove-whitelisted-permission":             {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"roleremovewhitelistedpermission":                {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"role-remove-blacklisted-permission":             {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"roleremoveblacklistedpermission":                {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"poll-create":                                    {Required: []string{"from"}, Tx: true},
+		"pollcreate":                                     {Required: []string{"from"}, Tx: true},
+		"poll-vote":                                      {Required: []string{"from", "poll_id", "options"}, Tx: true},
+		"pollvote":                                       {Required: []string{"from", "poll_id", "options"}, Tx: true},
+		"set-network-properties":                         {Required: []string{"from"}, Tx: true},
+		"setnetworkproperties":                           {Required: []string{"from"}, Tx: true},
+		"set-execution-fee":                              {Required: []string{"from", "tx_type"}, Tx: true},
+		"setexecutionfee":                                {Required: []string{"from", "tx_type"}, Tx: true},
+		"register-identity-records":                      {Required: []string{"from", "infos_json"}, Tx: true},
+		"registeridentityrecords":                        {Required: []string{"from", "infos_json"}, Tx: true},
+		"delete-identity-records":                        {Required: []string{"from", "keys"}, Tx: true},
+		"deleteidentityrecords":                          {Required: []string{"from", "keys"}, Tx: true},
+		"request-identity-record-verify":                 {Required: []string{"from", "verifier", "record_ids"}, Tx: true},
+		"requestidentityrecordverify":                    {Required: []string{"from", "verifier", "record_ids"}, Tx: true},
+		"handle-identity-records-verify-request":         {Required: []string{"from", "request_id"}, Tx: true},
+		"handleidentityrecordsverifyrequest":             {Required: []string{"from", "request_id"}, Tx: true},
+		"cancel-identity-records-verify-request":         {Required: []string{"from", "request_id"}, Tx: true},
+		"cancelidentityrecordsverifyrequest":             {Required: []string{"from", "request_id"}, Tx: true},
+		"proposal-assign-role":                           {Required: []string{"from", "address", "role"}, Tx: true},
+		"proposalassignrole":                             {Required: []string{"from", "address", "role"}, Tx: true},
+		"proposal-unassign-role":                         {Required: []string{"from", "address", "role"}, Tx: true},
+		"proposalunassignrole":                           {Required: []string{"from", "address", "role"}, Tx: true},
+		"proposal-whitelist-permission":                  {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposalwhitelistpermission":                    {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposal-blacklist-permission":                  {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposalblacklistpermission":                    {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposal-remove-whitelisted-permission":         {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposalremovewhitelistedpermission":            {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposal-remove-blacklisted-permission":         {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposalremoveblacklistedpermission":            {Required: []string{"from", "address", "permission"}, Tx: true},
+		"proposal-create-role":                           {Required: []string{"from", "role_sid"}, Tx: true},
+		"proposalcreaterole":                             {Required: []string{"from", "role_sid"}, Tx: true},
+		"proposal-remove-role":                           {Required: []string{"from", "role_sid"}, Tx: true},
+		"proposalremoverole":                             {Required: []string{"from", "role_sid"}, Tx: true},
+		"proposal-whitelist-role-permission":             {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposalwhitelistrolepermission":                {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposal-blacklist-role-permission":             {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposalblacklistrolepermission":                {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposal-remove-whitelisted-role-permission":    {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposalremovewhitelistedrolepermission":        {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposal-remove-blacklisted-role-permission":    {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposalremoveblacklistedrolepermission":        {Required: []string{"from", "role_sid", "permission"}, Tx: true},
+		"proposal-set-network-property":                  {Required: []string{"from", "property", "value", "title"}, Tx: true},
+		"proposalsetnetworkproperty":                     {Required: []string{"from", "property", "value", "title"}, Tx: true},
+		"proposal-set-poor-network-msgs":                 {Required: []string{"from", "messages"}, Tx: true},
+		"proposalsetpoornetworkmsgs":                     {Required: []string{"from", "messages"}, Tx: true},
+		"proposal-set-execution-fees":                    {Required: []string{"from"}, Tx: true},
+		"proposalsetexecutionfees":                       {Required: []string{"from"}, Tx: true},
+		"proposal-upsert-data-registry":                  {Required: []string{"from", "key"}, Tx: true},
+		"proposalupsertdataregistry":                     {Required: []string{"from", "key"}, Tx: true},
+		"proposal-set-proposal-durations":                {Required: []string{"from", "proposal_types", "durations"}, Tx: true},
+		"proposalsetproposaldurations":                   {Required: []string{"from", "proposal_types", "durations"}, Tx: true},
+		"proposal-jail-councilor":                        {Required: []string{"from", "councilors"}, Tx: true},
+		"proposaljailcouncilor":                          {Required: []string{"from", "councilors"}, Tx: true},
+		"proposal-reset-whole-councilor-rank":            {Required: []string{"from"}, Tx: true},
+		"proposalresetwholecouncilorrank":                {Required: []string{"from"}, Tx: true},
+		"proposal-whitelist-account-permission":          {Required: []string{"from", "permission"}, Tx: true},
+		"proposalwhitelistaccountpermission":             {Required: []string{"from", "permission"}, Tx: true},
+		"proposal-blacklist-account-permission":          {Required: []string{"from", "permission"}, Tx: true},
+		"proposalblacklistaccountpermission":             {Required: []string{"from", "permission"}, Tx: true},
+		"proposal-remove-whitelisted-account-permission": {Required: []string{"from", "permission"}, Tx: true},
+		"proposalremovewhitelistedaccountpermission":     {Required: []string{"from", "permission"}, Tx: true},
+		"proposal-remove-blacklisted-account-permission": {Required: []string{"from", "permission"}, Tx: true},
+		"proposalremoveblacklistedaccountpermission":     {Required: []string{"from", "permission"}, Tx: true},
+	},
+	"staking": {
+		"validators":                {},
+		"validator":                 {OneOf: []string{"address", "val_address", "moniker"}},
+		"claim-validator-seat":      {Required: []string{"from"}, Tx: true},
+		"claimvalidatorseat":        {Required: []string{"from"}, Tx: true},
+		"proposal-unjail-validator": {Required: []string{"from", "val_address"}, Tx: true},
+		"proposalunjailvalidator":   {Required: []string{"from", "val_address"}, Tx: true},
+	},
+	"multistaking": {
+		"pools":                       {},
+		"delegate":                    {Required: []string{"from", "validator", "coins"}, Tx: true},
+		"undelegate":                  {Required: []string{"from", "validator", "coins"}, Tx: true},
+		"claim-rewards":               {Required: []string{"from"}, Tx: true},
+		"undelegations":               {Required: []string{"delegator", "validator"}},
+		"outstanding-rewards":         {Required: []string{"delegator"}},
+		"outstandingrewards":          {Required: []string{"delegator"}},
+		"compound-info":               {Required: []string{"delegator"}},
+		"compoundinfo":                {Required: []string{"delegator"}},
+		"delegations":                 {Required: []string{"delegator"}},
+		"staking-pool-delegators":     {Required: []string{"validator"}},
+		"stakingpooldelegators":       {Required: []string{"validator"}},
+		"claim-undelegation":          {Required: []string{"from", "undelegation_id"}, Tx: true},
+		"claimundelegation":           {Required: []string{"from", "undelegation_id"}, Tx: true},
+		"claim-matured-undelegations": {Required: []string{"from"}, Tx: true},
+		"claimmaturedundelegations":   {Required: []string{"from"}, Tx: true},
+		"register-delegator":          {Required: []string{"from"}, Tx: true},
+		"registerdelegator":           {Required: []string{"from"}, Tx: true},
+		"set-compound-info":           {Required: []string{"from"}, Tx: true},
+		"setcompoundinfo":             {Required: []string{"from"}, Tx: true},
+		"upsert-staking-pool":         {Required: []string{"from", "validator_key"}, Tx: true},
+		"upsertstakingpool":           {Required: []string{"from", "validator_key"}, Tx: true},
+	},
+	"tokens": {
+		"all-rates":                          {},
+		"rate":                               {Required: []string{"denom"}},
+		"rates-by-denom":                     {Required: []string{"denom"}},
+		"ratesbydenom":                       {Required: []string{"denom"}},
+		"token-black-whites":                 {},
+		"upsert-rate":                        {Required: []string{"from"}, Tx: true},
+		"upsertrate":                         {Required: []string{"from"}, Tx: true},
+		"proposal-upsert-rate":               {Required: []string{"from"}, Tx: true},
+		"proposalupsertrate":                 {Required: []string{"from"}, Tx: true},
+		"proposal-update-tokens-black-white": {Required: []string{"from"}, Tx: true},
+		"proposalupdatetokensblackwhite":     {Required: []string{"from"}, Tx: true},
+	},
+	"status": {
+		"status":              {},
+		"node-info":           {},
+		"nodeinfo":            {},
+		"sync-info":           {},
+		"syncinfo":            {},
+		"validator-info":      {},
+		"validatorinfo":       {},
+		"chain-id":            {},
+		"chainid":             {},
+		"latest-block-height": {},
+		"latestblockheight":   {},
+		"height":              {},
+		"is-syncing":          {},
+		"issyncing":           {},
+		"syncing":             {},
+		"network-properties":  {},
+		"networkproperties":   {},
+	},
+	"custody": {
+		"get":             {Required: []string{"address"}},
+		"custodians":      {Required: []string{"address"}},
+		"custodians-pool": {Required: []string{"address"}},
+		"custodianspool":  {Required: []string{"address"}},
+		"pool":            {Required: []string{"address"}},
+		"whitelist":       {Required: []string{"address"}},
+		"limits":          {Required: []string{"address"}},
+	},
+	"params": {
+		"subspace": {Required: []string{"subspace", "key"}},
+	},
+	"ethereum": {
+		"state": {},
+	},
+	"evidence": {
+		"all":          {},
+		"all-evidence": {},
+		"evidence":     {Required: []string{"hash"}},
+		"get":          {Required: []string{"hash"}},
+	},
+	"distributor": {
+		"fees-treasury":               {},
+		"feestreasury":                {},
+		"periodic-snapshot":           {},
+		"periodicsnapshot":            {},
+		"snapshot-period":             {},
+		"snapshotperiod":              {},
+		"snapshot-period-performance": {Required: []string{"validator"}},
+		"snapshotperiodperformance":   {Required: []string{"validator"}},
+		"year-start-snapshot":         {},
+		"yearstartsnapshot":           {},
+	},
+	"layer2": {
+		"all-dapps":           {},
+		"alldapps":            {},
+		"execution-registrar": {Required: []string{"dapp_name"}},
+		"executionregistrar":  {Required: []string{"dapp_name"}},
+		"transfer-dapps":      {},
+		"transferdapps":       {},
+	},
+	"recovery": {
+		"recovery-record":   {Required: []string{"address"}},
+		"recoveryrecord":    {Required: []string{"address"}},
+		"recovery-token":    {Required: []string{"address"}},
+		"recoverytoken":     {Required: []string{"address"}},
+		"rr-holder-rewards": {Required: []string{"address"}},
+		"rrholderrewards":   {Required: []string{"address"}},
+		"rr-holders":        {Required: []string{"rr_token"}},
+		"rrholders":         {Required: []string{"rr_token"}},
+	},
+	"slashing": {
+		"signing-info":           {Required: []string{"cons_address"}},
+		"signinginfo":            {Required: []string{"cons_address"}},
+		"signing-infos":          {},
+		"signinginfos":           {},
+		"active-staking-pools":   {},
+		"activestakingpools":     {},
+		"inactive-staking-pools": {},
+		"inactivestakingpools":   {},
+		"slashed-staking-pools":  {},
+		"slashedstakingpools":    {},
+		"slash-proposals":        {},
+		"slashproposals":         {},
+	},
+	"bridge": {
+		"get-cosmos-ethereum":    {Required: []string{"address"}},
+		"getcosmosEthereum":      {Required: []string{"address"}},
+		"cosmos-ethereum":        {Required: []string{"address"}},
+		"get-ethereum-cosmos":    {Required: []string{"address"}},
+		"getethereumcosmos":      {Required: []string{"address"}},
+		"ethereum-cosmos":        {Required: []string{"address"}},
+		"change-cosmos-ethereum": {Required: []string{"from", "cosmos_address", "ethereum_address", "amount"}, Tx: true},
+		"changecosmosEthereum":   {Required: []string{"from", "cosmos_address", "ethereum_address", "amount"}, Tx: true},
+		"change-ethereum-cosmos": {Required: []string{"from", "cosmos_address", "ethereum_tx_hash", "amount"}, Tx: true},
+		"changeethereumcosmos":   {Required: []string{"from", "cosmos_address", "ethereum_tx_hash", "amount"}, Tx: true},
+	},
+	"ubi": {
+		"records":             {},
+		"ubi-records":         {},
+		"record-by-name":      {Required: []string{"name"}},
+		"recordbyname":        {Required: []string{"name"}},
+		"record":              {Required: []string{"name"}},
+		"proposal-upsert-ubi": {Required: []string{"from"}, Tx: true},
+		"proposalupsertubi":   {Required: []string{"from"}, Tx: true},
+		"proposal-remove-ubi": {Required: []string{"from"}, Tx: true},
+		"proposalremoveubi":   {Required: []string{"from"}, Tx: true},
+	},
+	"upgrade": {
+		"current-plan":         {},
+		"currentplan":          {},
+		"next-plan":            {},
+		"nextplan":             {},
+		"proposal-set-plan":    {Required: []string{"from"}, Tx: true},
+		"proposalsetplan":      {Required: []string{"from"}, Tx: true},
+		"proposal-cancel-plan": {Required: []string{"from"}, Tx: true},
+		"proposalcancelplan":   {Required: []string{"from"}, Tx: true},
+	},
+	"spending": {
+		"pool-names":                          {},
+		"poolnames":                           {},
+		"pool-by-name":                        {Required: []string{"name"}},
+		"poolbyname":                          {Required: []string{"name"}},
+		"pool":                                {Required: []string{"name"}},
+		"pool-proposals":                      {Required: []string{"pool_name"}},
+		"poolproposals":                       {Required: []string{"pool_name"}},
+		"pools-by-account":                    {Required: []string{"address"}},
+		"poolsbyaccount":                      {Required: []string{"address"}},
+		"claim-spending-pool":                 {Required: []string{"from", "pool_name"}, Tx: true},
+		"claimspendingpool":                   {Required: []string{"from", "pool_name"}, Tx: true},
+		"claim":                               {Required: []string{"from", "pool_name"}, Tx: true},
+		"deposit-spending-pool":               {Required: []string{"from", "pool_name", "amount"}, Tx: true},
+		"depositspendingpool":                 {Required: []string{"from", "pool_name", "amount"}, Tx: true},
+		"deposit":                             {Required: []string{"from", "pool_name", "amount"}, Tx: true},
+		"create-spending-pool":                {Required: []string{"from"}, Tx: true},
+		"createspendingpool":                  {Required: []string{"from"}, Tx: true},
+		"create":                              {Required: []string{"from"}, Tx: true},
+		"register-spending-pool-beneficiary":  {Required: []string{"from", "pool_name"}, Tx: true},
+		"registerspendingpoolbeneficiary":     {Required: []string{"from", "pool_name"}, Tx: true},
+		"register-beneficiary":                {Required: []string{"from", "pool_name"}, Tx: true},
+		"proposal-update-spending-pool":       {Required: []string{"from"}, Tx: true},
+		"proposalupdatespendingpool":          {Required: []string{"from"}, Tx: true},
+		"proposal-spending-pool-distribution": {Required: []string{"from"}, Tx: true},
+		"proposalspendingpooldistribution":    {Required: []string{"from"}, Tx: true},
+		"proposal-spending-pool-withdraw":     {Required: []string{"from"}, Tx: true},
+		"proposalspendingpoolwithdraw":        {Required: []string{"from"}, Tx: true},
+	},
+	"collectives": {
+		"collectives":                {},
+		"all":                        {},
+		"list":                       {},
+		"collective":                 {Required: []string{"name"}},
+		"get":                        {Required: []string{"name"}},
+		"collectives-by-account":     {Required: []string{"address"}},
+		"collectivesbyaccount":       {Required: []string{"address"}},
+		"by-account":                 {Required: []string{"address"}},
+		"collectives-proposals":      {},
+		"collectivesproposals":       {},
+		"proposals":                  {},
+		"create-collective":          {Required: []string{"from", "name"}, Tx: true},
+		"createcollective":           {Required: []string{"from", "name"}, Tx: true},
+		"create":                     {Required: []string{"from", "name"}, Tx: true},
+		"contribute-collective":      {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"contributecollective":       {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"contribute":                 {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"donate-collective":          {Required: []string{"from", "name"}, Tx: true},
+		"donatecollective":           {Required: []string{"from", "name"}, Tx: true},
+		"donate":                     {Required: []string{"from", "name"}, Tx: true},
+		"withdraw-collective":        {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"withdrawcollective":         {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"withdraw":                   {Required: []string{"from", "name", "bonds"}, Tx: true},
+		"proposal-collective-update": {Required: []string{"from"}, Tx: true},
+		"proposalcollectiveupdate":   {Required: []string{"from"}, Tx: true},
+		"proposal-remove-collective": {Required: []string{"from"}, Tx: true},
+		"proposalremovecollective":   {Required: []string{"from"}, Tx: true},
+		"proposal-send-donation":     {Required: []string{"from"}, Tx: true},
+		"proposalsenddonation":       {Required: []string{"from"}, Tx: true},
+	},
+	"basket": {
+		"token-baskets":                    {},
+		"tokenbaskets":                     {},
+		"baskets":                          {},
+		"token-basket-by-id":               {Required: []string{"id"}},
+		"tokenbasketbyid":                  {Required: []string{"id"}},
+		"by-id":                            {Required: []string{"id"}},
+		"token-basket-by-denom":            {Required: []string{"denom"}},
+		"tokenbasketbydenom":               {Required: []string{"denom"}},
+		"by-denom":                         {Required: []string{"denom"}},
+		"historical-mints":                 {Required: []string{"basket_id"}},
+		"historicalmints":                  {Required: []string{"basket_id"}},
+		"historical-burns":                 {Required: []string{"basket_id"}},
+		"historicalburns":                  {Required: []string{"basket_id"}},
+		"historical-swaps":                 {Required: []string{"basket_id"}},
+		"historicalswaps":                  {Required: []string{"basket_id"}},
+		"mint-basket-tokens":               {Required: []string{"from", "basket_id", "deposit_coins"}, Tx: true},
+		"mintbaskettokens":                 {Required: []string{"from", "basket_id", "deposit_coins"}, Tx: true},
+		"mint":                             {Required: []string{"from", "basket_id", "deposit_coins"}, Tx: true},
+		"burn-basket-tokens":               {Required: []string{"from", "basket_id", "burn_amount"}, Tx: true},
+		"burnbaskettokens":                 {Required: []string{"from", "basket_id", "burn_amount"}, Tx: true},
+		"burn":                             {Required: []string{"from", "basket_id", "burn_amount"}, Tx: true},
+		"swap-basket-tokens":               {Required: []string{"from", "basket_id", "swap_in", "swap_out"}, Tx: true},
+		"swapbaskettokens":                 {Required: []string{"from", "basket_id", "swap_in", "swap_out"}, Tx: true},
+		"swap":                             {Required: []string{"from", "basket_id", "swap_in", "swap_out"}, Tx: true},
+		"basket-claim-rewards":             {Required: []string{"from", "basket_id"}, Tx: true},
+		"basketclaimrewards":               {Required: []string{"from", "basket_id"}, Tx: true},
+		"claim-rewards":                    {Required: []string{"from", "basket_id"}, Tx: true},
+		"disable-basket-deposits":          {Required: []string{"from", "basket_id"}, Tx: true},
+		"disablebasketdeposits":            {Required: []string{"from", "basket_id"}, Tx: true},
+		"disable-basket-withdraws":         {Required: []string{"from", "basket_id"}, Tx: true},
+		"disablebasketwithraws":            {Required: []string{"from", "basket_id"}, Tx: true},
+		"disable-basket-swaps":             {Required: []string{"from", "basket_id"}, Tx: true},
+		"disablebasketswaps":               {Required: []string{"from", "basket_id"}, Tx: true},
+		"proposal-create-basket":           {Required: []string{"from"}, Tx: true},
+		"proposalcreatebasket":             {Required: []string{"from"}, Tx: true},
+		"proposal-edit-basket":             {Required: []string{"from"}, Tx: true},
+		"proposaleditbasket":               {Required: []string{"from"}, Tx: true},
+		"proposal-basket-withdraw-surplus": {Required: []string{"from", "basket_ids", "withdraw_target"}, Tx: true},
+		"proposalbasketwithdrawsurplus":    {Required: []string{"from", "basket_ids", "withdraw_target"}, Tx: true},
+		"proposal-withdraw-surplus":        {Required: []string{"from", "basket_ids", "withdraw_target"}, Tx: true},
+	},
+}
+
+// LookupAction returns the spec of a mapped action. mapped reports whether
+// the module has mapped actions at all; if it has none, any action runs
+// through generic execution and ok is false.
+func LookupAction(module, action string) (spec ActionSpec, ok, mapped bool) {
+	actions, mapped := mappedActions[CanonicalModule(module)]
+	if !mapped {
+		return ActionSpec{}, false, false
+	}
+	spec, ok = actions[strings.ToLower(action)]
+	return spec, ok, true
+}
+
+// CanonicalModule returns the module name the mapper executes module as,
+// e.g. "gov" for "customgov".
+func CanonicalModule(module string) string {
+	module = strings.ToLower(module)
+	if canonical, ok := moduleAliases[module]; ok {
+		return canonical
+	}
+	return module
+}
@@ -0,0 +1,123 @@
+package scenarios
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// envKeyPattern matches the variable names allowed in an env file, the
+// same names a {{ }} reference can use.
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// ParseEnvFile loads variable overrides from a dotenv file of KEY=value
+// lines. Blank lines and lines starting with # are skipped, and an
+// "export " prefix is allowed. Values may be double-quoted, with \n, \t,
+// \" and \\ escapes, or single-quoted, taken literally. A # after
+// whitespace starts a comment in unquoted values.
+func ParseEnvFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open env file: %w", err)
+	}
+	defer f.Close()
+
+	result := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, err := parseEnvLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+		}
+		result[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file: %w", err)
+	}
+	return result, nil
+}
+
+// parseEnvLine parses one non-blank, non-comment KEY=value line.
+func parseEnvLine(line string) (string, string, error) {
+	line = strings.TrimPrefix(line, "export ")
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", fmt.Errorf("expected KEY=value, got %q", line)
+	}
+	key = strings.TrimSpace(key)
+	if !envKeyPattern.MatchString(key) {
+		return "", "", fmt.Errorf("invalid variable name %q", key)
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return key, "", nil
+	}
+	switch quote := value[0]; quote {
+	case '"', '\'':
+		end := closingQuote(value, quote)
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated %c quote in value of %s", quote, key)
+		}
+		rest := strings.TrimSpace(value[end+1:])
+		if rest != "" && !strings.HasPrefix(rest, "#") {
+			return "", "", fmt.Errorf("unexpected text after quoted value of %s: %q", key, rest)
+		}
+		if quote == '\'' {
+			return key, value[1:end], nil
+		}
+		return key, unescapeEnv(value[1:end]), nil
+	}
+
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	} else if i := strings.Index(value, "\t#"); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return key, value, nil
+}
+
+// closingQuote returns the index of the quote closing the value starting
+// at value[0], or -1. Double quotes may be escaped with a backslash.
+func closingQuote(value string, quote byte) int {
+	for i := 1; i < len(value); i++ {
+		switch {
+		case quote == '"' && value[i] == '\\':
+			i++
+		case value[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// unescapeEnv resolves the escapes of a double-quoted value.
+func unescapeEnv(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
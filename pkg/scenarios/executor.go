@@ -1,13 +1,25 @@
 package scenarios
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/txs"
+)
+
+// Account sequence mismatches are retried after about one block, when the
+// previous transaction from the signer has been included.
+const (
+	sequenceRetries    = 2
+	sequenceRetryDelay = 6 * time.Second
 )
 
 // Executor runs scenarios against the blockchain.
@@ -17,6 +29,9 @@ type Executor struct {
 	output io.Writer
 	vars   *VariableStore
 	mapper *ActionMapper
+
+	// logMu serializes progress messages from parallel steps
+	logMu sync.Mutex
 }
 
 // NewExecutor creates a new scenario executor.
@@ -25,12 +40,16 @@ func NewExecutor(client sdk.Client, opts *ExecutorOptions) *Executor {
 		opts = DefaultExecutorOptions()
 	}
 
+	mapper := NewActionMapper(client)
+	if opts.AddressBook != nil {
+		mapper.SetAddressBook(opts.AddressBook)
+	}
 	return &Executor{
 		client: client,
 		opts:   opts,
 		output: os.Stdout,
 		vars:   NewVariableStore(),
-		mapper: NewActionMapper(client),
+		mapper: mapper,
 	}
 }
 
@@ -44,9 +63,10 @@ func (e *Executor) Execute(ctx context.Context, scenario *Scenario) (*ExecutionR
 	startTime := time.Now()
 
 	result := &ExecutionResult{
-		Scenario: scenario.Name,
-		Steps:    make([]StepResult, 0, len(scenario.Steps)),
-		Success:  true,
+		Scenario:  scenario.Name,
+		Steps:     make([]StepResult, 0, len(scenario.Steps)),
+		Success:   true,
+		StartedAt: startTime,
 	}
 
 	// Initialize variables from scenario defaults
@@ -58,49 +78,33 @@ func (e *Executor) Execute(ctx context.Context, scenario *Scenario) (*ExecutionR
 	if e.opts.Variables != nil {
 		e.vars.MergeFromStringMap(e.opts.Variables)
 	}
+	result.Variables = e.vars.All()
+
+	steps, err := e.selectSteps(scenario.Steps)
+	if err != nil {
+		return nil, err
+	}
 
 	e.logf("Starting scenario: %s\n", scenario.Name)
 	if scenario.Description != "" {
 		e.logf("Description: %s\n", scenario.Description)
 	}
-	e.logf("Steps: %d\n\n", len(scenario.Steps))
-
-	// Execute each step sequentially
-	for i, step := range scenario.Steps {
-		stepNum := i + 1
-		e.logf("[%d/%d] %s\n", stepNum, len(scenario.Steps), step.Name)
-
-		stepResult := e.executeStep(ctx, &step)
-		result.Steps = append(result.Steps, stepResult)
-
-		if stepResult.Success {
-			e.logf("  Status: OK")
-			if stepResult.TxHash != "" {
-				e.logf(" (tx: %s, height: %d)", truncateHash(stepResult.TxHash), stepResult.BlockHeight)
-			}
-			e.logf("\n")
-
-			// Store output if specified
-			if step.Output != "" && stepResult.Output != nil {
-				e.vars.Set(step.Output, stepResult.Output)
-				if e.opts.Verbose {
-					e.logf("  Output stored in: %s\n", step.Output)
-				}
-			}
-		} else {
-			result.Success = false
-			e.logf("  Status: FAILED\n")
-			e.logf("  Error: %s\n", stepResult.Error)
+	count := fmt.Sprintf("%d", len(steps))
+	if len(steps) < len(scenario.Steps) {
+		count = fmt.Sprintf("%d of %d selected", len(steps), len(scenario.Steps))
+	}
+	if e.opts.MaxParallel > 1 {
+		e.logf("Steps: %s (up to %d in parallel)\n\n", count, e.opts.MaxParallel)
+	} else {
+		e.logf("Steps: %s\n\n", count)
+	}
 
-			if !e.opts.ContinueOnError {
-				result.Error = fmt.Sprintf("step '%s' failed: %s", step.Name, stepResult.Error)
-				break
-			}
-		}
-		e.logf("\n")
+	if err := e.runSteps(ctx, steps, result); err != nil {
+		return nil, err
 	}
 
-	result.Duration = time.Since(startTime)
+	result.FinishedAt = time.Now()
+	result.Duration = result.FinishedAt.Sub(startTime)
 
 	// Print summary
 	e.logf("=====================================\n")
@@ -110,12 +114,19 @@ func (e *Executor) Execute(ctx context.Context, scenario *Scenario) (*ExecutionR
 		e.logf("Scenario failed: %s\n", result.Error)
 	}
 	e.logf("Duration: %s\n", result.Duration.Round(time.Millisecond))
+	if e.opts.MaxParallel > 1 {
+		e.logf("Total step time: %s\n", result.StepTime.Round(time.Millisecond))
+	}
 
 	return result, nil
 }
 
-// executeStep runs a single step and returns the result.
-func (e *Executor) executeStep(ctx context.Context, step *Step) StepResult {
+// executeForEach runs a step once per item of its for_each variable. Each
+// iteration gets its own variable scope with {{ item }} and {{ item_index }}
+// set, so templates can still reference scenario variables and outputs.
+// The step succeeds only if every item succeeds; with ContinueOnError the
+// remaining items are still attempted after a failure.
+func (e *Executor) executeForEach(ctx context.Context, step *Step) StepResult {
 	startTime := time.Now()
 
 	result := StepResult{
@@ -124,24 +135,98 @@ func (e *Executor) executeStep(ctx context.Context, step *Step) StepResult {
 		Action: step.Action,
 	}
 
-	// Handle dry-run mode
-	if e.opts.DryRun {
-		result.Success = true
-		result.Skipped = true
+	name := ForEachVariable(step.ForEach)
+	items, err := e.vars.ListItems(name)
+	if err != nil {
+		if e.opts.DryRun {
+			// The list may come from the output of a step that did not run.
+			result.Success = true
+			result.Skipped = true
+			result.Duration = time.Since(startTime)
+			e.logf("  [DRY-RUN] Would execute %s.%s for each item of %s\n", step.Module, step.Action, name)
+			return result
+		}
+		result.Success = false
+		result.Error = fmt.Sprintf("for_each: %v", err)
 		result.Duration = time.Since(startTime)
-		e.logf("  [DRY-RUN] Would execute: %s.%s\n", step.Module, step.Action)
-		if len(step.Params) > 0 {
-			e.logf("  Params:\n")
-			for k, v := range step.Params {
-				interpolated, _ := e.vars.Interpolate(v)
-				e.logf("    %s: %s\n", k, interpolated)
+		return result
+	}
+
+	e.logf("  Items: %d (from %s)\n", len(items), name)
+
+	result.Success = true
+	result.Items = make([]StepResult, 0, len(items))
+	outputs := make([]interface{}, 0, len(items))
+	registered := make([]interface{}, 0, len(items))
+	failed := 0
+	for i, item := range items {
+		if ctx.Err() != nil {
+			result.Success = false
+			result.Error = ctx.Err().Error()
+			break
+		}
+
+		scope := e.vars.NewScope()
+		scope.Set("item", item)
+		scope.Set("item_index", i)
+
+		label := toString(item)
+		e.logf("  [%d/%d] %s\n", i+1, len(items), label)
+
+		itemResult := e.executeStep(ctx, step, scope)
+		itemResult.Item = label
+		result.Items = append(result.Items, itemResult)
+		outputs = append(outputs, itemResult.Output)
+		if step.Register != "" {
+			value, _ := scope.Local(step.Register)
+			registered = append(registered, value)
+		}
+
+		if itemResult.Success {
+			if itemResult.TxHash != "" {
+				e.logf("    OK (tx: %s, height: %d)\n", truncateHash(itemResult.TxHash), itemResult.BlockHeight)
+			} else {
+				e.logf("    OK\n")
 			}
+			continue
 		}
-		return result
+
+		failed++
+		result.Success = false
+		e.logf("    FAILED: %s\n", itemResult.Error)
+		if !e.opts.ContinueOnError {
+			break
+		}
+	}
+
+	if failed > 0 {
+		result.Error = fmt.Sprintf("%d of %d items failed", failed, len(items))
+	}
+	if step.Register != "" && !e.opts.DryRun {
+		e.vars.Set(step.Register, registered)
+	}
+	result.Output = outputs
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// executeStep runs a single step with the given variables and returns the result.
+func (e *Executor) executeStep(ctx context.Context, step *Step, vars *VariableStore) StepResult {
+	startTime := time.Now()
+
+	result := StepResult{
+		Name:   step.Name,
+		Module: step.Module,
+		Action: step.Action,
+	}
+
+	// Handle dry-run mode
+	if e.opts.DryRun {
+		return e.dryRunStep(ctx, step, vars, result, startTime)
 	}
 
 	// Interpolate parameters
-	params, err := e.vars.InterpolateParams(step.Params)
+	params, err := vars.InterpolateParams(step.Params)
 	if err != nil {
 		result.Success = false
 		result.Error = fmt.Sprintf("parameter interpolation failed: %v", err)
@@ -152,18 +237,29 @@ func (e *Executor) executeStep(ctx context.Context, step *Step) StepResult {
 	if e.opts.Verbose && len(params) > 0 {
 		e.logf("  Params:\n")
 		for k, v := range params {
-			e.logf("    %s: %s\n", k, v)
+			e.logf("    %s: %s\n", k, logValue(k, v))
 		}
 	}
 
 	// Determine step type
 	stepType := GetStepType(step)
 
-	// Execute the action
+	// Execute the action, retrying transactions signed with a stale
+	// sequence once the previous transaction is in a block
 	output, txResp, err := e.mapper.Execute(ctx, step.Module, step.Action, params, step.TxOptions)
+	for retry := 0; err != nil && sdk.IsAccountSequence(err) && retry < sequenceRetries; retry++ {
+		e.logf("  Account sequence mismatch, retrying in %s...\n", sequenceRetryDelay)
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-time.After(sequenceRetryDelay):
+			output, txResp, err = e.mapper.Execute(ctx, step.Module, step.Action, params, step.TxOptions)
+		}
+	}
 	if err != nil {
 		result.Success = false
 		result.Error = err.Error()
+		result.ErrorKind = sdk.ErrorKind(err)
 		result.Duration = time.Since(startTime)
 		return result
 	}
@@ -196,6 +292,30 @@ func (e *Executor) executeStep(ctx context.Context, step *Step) StepResult {
 			}
 			result.BlockHeight = confirmed.Height
 			result.TxCode = confirmed.Code
+			if !confirmed.Success() {
+				result.Success = false
+				result.Error = fmt.Sprintf("transaction failed with code %d: %s", confirmed.Code, confirmed.RawLog)
+				result.Duration = time.Since(startTime)
+				return result
+			}
+		}
+	}
+
+	if step.Register != "" {
+		value, err := ResolvePath(registerData(output, &result), step.RegisterPath)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Sprintf("register %s: %v", step.Register, err)
+			result.Duration = time.Since(startTime)
+			return result
+		}
+		vars.Set(step.Register, value)
+		if e.opts.Verbose {
+			if returnsSecret(step) {
+				e.logf("  Registered %s = <redacted>\n", step.Register)
+			} else {
+				e.logf("  Registered %s = %s\n", step.Register, toString(value))
+			}
 		}
 	}
 
@@ -204,48 +324,140 @@ func (e *Executor) executeStep(ctx context.Context, step *Step) StepResult {
 	return result
 }
 
-// waitForTx polls for transaction confirmation.
-func (e *Executor) waitForTx(ctx context.Context, txHash string, txOpts *StepTxOptions) (*sdk.TxResponse, error) {
-	timeout := e.opts.TxWaitTimeout
-	if txOpts != nil && txOpts.WaitTimeout > 0 {
-		timeout = txOpts.WaitTimeout
+// dryRunStep reports what a step would do without executing it. For a
+// transaction step the unsigned transaction is built, logged and kept in
+// the result, so a reviewer sees exactly what would be signed. A
+// transaction that cannot be built because its parameters come from
+// steps that did not run, or because the client cannot generate
+// transactions, is only reported; any other error fails the step.
+func (e *Executor) dryRunStep(ctx context.Context, step *Step, vars *VariableStore, result StepResult, startTime time.Time) StepResult {
+	result.Success = true
+	result.Skipped = true
+	e.logf("  [DRY-RUN] Would execute: %s.%s\n", step.Module, step.Action)
+	if len(step.Params) > 0 {
+		e.logf("  Params:\n")
+		for k, v := range step.Params {
+			interpolated, _ := vars.Interpolate(v)
+			e.logf("    %s: %s\n", k, logValue(k, interpolated))
+		}
 	}
 
-	pollInterval := e.opts.TxPollInterval
+	// Modules without mapped actions run through generic execution, which
+	// tells transactions from queries by their name
+	spec, _, mapped := LookupAction(step.Module, step.Action)
+	if !spec.Tx && (mapped || GetStepType(step) != StepTypeTransaction) {
+		result.Duration = time.Since(startTime)
+		return result
+	}
+	params, err := vars.InterpolateParams(step.Params)
+	if err != nil {
+		e.logf("  Transaction not built: %v\n", err)
+		result.Duration = time.Since(startTime)
+		return result
+	}
+	tx, err := e.mapper.Generate(ctx, step.Module, step.Action, params, step.TxOptions)
+	switch {
+	case errors.Is(err, sdk.ErrNotSupported):
+		e.logf("  Transaction not built: %v\n", err)
+	case err != nil:
+		result.Success = false
+		result.Error = fmt.Sprintf("failed to build transaction: %v", err)
+		result.ErrorKind = sdk.ErrorKind(err)
+	default:
+		result.UnsignedTx = tx
+		if messages := txMessages(tx); len(messages) > 0 {
+			e.logf("  Messages:\n")
+			for _, msg := range messages {
+				var indented bytes.Buffer
+				if json.Indent(&indented, msg, "    ", "  ") != nil {
+					indented.Reset()
+					indented.Write(msg)
+				}
+				e.logf("    %s\n", indented.String())
+			}
+		}
+	}
+	result.Duration = time.Since(startTime)
+	return result
+}
 
-	deadline := time.Now().Add(timeout)
+// registerData returns the data a step's register_path is resolved
+// against. For transactions the confirmed txhash, height and code are set
+// at the top level, along with the event attributes keyed by name.
+func registerData(output interface{}, result *StepResult) interface{} {
+	if result.TxHash == "" {
+		return output
+	}
 
-	for time.Now().Before(deadline) {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+	data := make(map[string]interface{})
+	if generic, err := toGeneric(output); err == nil {
+		if m, ok := generic.(map[string]interface{}); ok {
+			data = m
 		}
-
-		// Query transaction by hash
-		resp, err := e.client.Query(ctx, &sdk.QueryRequest{
-			Module:   "tx",
-			Endpoint: txHash,
-		})
-
-		if err == nil && resp != nil {
-			// Parse response to get tx result
-			var txResp sdk.TxResponse
-			if err := parseQueryResponse(resp.Data, &txResp); err == nil {
-				if txResp.Height > 0 {
-					return &txResp, nil
+	}
+	data["txhash"] = result.TxHash
+	data["height"] = result.BlockHeight
+	data["code"] = result.TxCode
+
+	attributes := make(map[string]interface{})
+	if resp, ok := output.(*sdk.TxResponse); ok {
+		for _, log := range resp.Logs {
+			for _, event := range log.Events {
+				for _, attr := range event.Attributes {
+					// Keep the first occurrence of each key
+					if _, exists := attributes[attr.Key]; !exists {
+						attributes[attr.Key] = attr.Value
+					}
 				}
 			}
 		}
+	}
+	data["attributes"] = attributes
+	return data
+}
 
-		time.Sleep(pollInterval)
+// waitForTx polls for transaction confirmation.
+func (e *Executor) waitForTx(ctx context.Context, txHash string, txOpts *StepTxOptions) (*txs.TxResult, error) {
+	timeout := e.opts.TxWaitTimeout
+	if txOpts != nil && txOpts.WaitTimeout > 0 {
+		timeout = txOpts.WaitTimeout
+	}
+
+	result, err := txs.New(e.client).Wait(ctx, txHash, &txs.WaitOptions{
+		Timeout:      timeout,
+		PollInterval: e.opts.TxPollInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("waiting for transaction %s: %w", truncateHash(txHash), err)
 	}
+	return result, nil
+}
 
-	return nil, fmt.Errorf("timeout waiting for transaction %s after %s", truncateHash(txHash), timeout)
+// secretParams are step parameters holding key material or passphrases.
+var secretParams = map[string]bool{
+	"armor":      true,
+	"hex_key":    true,
+	"mnemonic":   true,
+	"passphrase": true,
+}
+
+// logValue returns a step parameter value for logging, hiding secrets.
+func logValue(name, value string) string {
+	if secretParams[name] {
+		return "<redacted>"
+	}
+	return value
+}
+
+// returnsSecret reports whether a step outputs key material.
+func returnsSecret(step *Step) bool {
+	return step.Module == "keys" && (step.Action == "export" || step.Action == "mnemonic")
 }
 
 // logf writes a formatted message to the output.
 func (e *Executor) logf(format string, args ...interface{}) {
+	e.logMu.Lock()
+	defer e.logMu.Unlock()
 	fmt.Fprintf(e.output, format, args...)
 }
 
@@ -256,11 +468,3 @@ func truncateHash(hash string) string {
 	}
 	return hash[:8] + "..." + hash[len(hash)-8:]
 }
-
-// parseQueryResponse parses JSON query response into a struct.
-func parseQueryResponse(data []byte, v interface{}) error {
-	// Import would be needed for json.Unmarshal
-	// For now, this is a placeholder - the actual implementation
-	// will use encoding/json
-	return nil
-}
@@ -0,0 +1,98 @@
+package scenarios
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// generateClient wraps a client so that the transaction of a step is
+// built unsigned instead of being signed and broadcast. The generated
+// transaction JSON is kept in tx.
+type generateClient struct {
+	sdk.Client
+
+	mu sync.Mutex
+	tx []byte
+}
+
+// Tx generates the unsigned transaction and returns an empty response.
+func (c *generateClient) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	tx, err := c.GenerateTx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.TxResponse{}, c.keep(tx)
+}
+
+// GenerateTx generates the transaction with the wrapped client, if it can
+// build transactions offline.
+func (c *generateClient) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	otc, ok := c.Client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, sdk.ErrNotSupported
+	}
+	return otc.GenerateTx(ctx, req)
+}
+
+// SignTx returns the transaction unsigned, for actions that generate,
+// edit, sign and broadcast a transaction in steps.
+func (c *generateClient) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	return tx, nil
+}
+
+// BroadcastTx keeps the transaction instead of broadcasting it.
+func (c *generateClient) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	return &sdk.TxResponse{}, c.keep(tx)
+}
+
+// keep stores the transaction of the step, which must be its only one.
+func (c *generateClient) keep(tx []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tx != nil {
+		return fmt.Errorf("action builds more than one transaction")
+	}
+	c.tx = tx
+	return nil
+}
+
+// Generate builds the unsigned transaction that Execute would sign and
+// broadcast for a transaction action, without signing or broadcasting
+// it. Key names and address book aliases are resolved as in Execute.
+// Keys actions, which change the local keyring, and actions that build no
+// transaction fail.
+func (m *ActionMapper) Generate(ctx context.Context, module, action string, params map[string]string, txOpts *StepTxOptions) (json.RawMessage, error) {
+	if strings.ToLower(module) == "keys" {
+		return nil, fmt.Errorf("keys.%s does not build a transaction", action)
+	}
+
+	gen := &generateClient{Client: m.client}
+	mapper := NewActionMapper(gen)
+	mapper.resolver = m.resolver
+	if _, _, err := mapper.Execute(ctx, module, action, params, txOpts); err != nil {
+		return nil, err
+	}
+	if gen.tx == nil {
+		return nil, fmt.Errorf("%s.%s does not build a transaction", module, action)
+	}
+	return json.RawMessage(strings.TrimSpace(string(gen.tx))), nil
+}
+
+// txMessages returns the messages of a transaction JSON, or nil if it
+// has none.
+func txMessages(tx json.RawMessage) []json.RawMessage {
+	var parsed struct {
+		Body struct {
+			Messages []json.RawMessage `json:"messages"`
+		} `json:"body"`
+	}
+	if json.Unmarshal(tx, &parsed) != nil {
+		return nil
+	}
+	return parsed.Body.Messages
+}
@@ -0,0 +1,101 @@
+package scenarios
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ResolvePath extracts a value from data using a simple JSON path such as
+// ".proposal_id", ".balances[0].amount" or "txhash". The leading "." (or
+// "$.") is optional; "" and "." return the whole value. Data is converted
+// to its JSON representation first, so struct fields are addressed by
+// their JSON names.
+func ResolvePath(data interface{}, path string) (interface{}, error) {
+	current, err := toGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return current, nil
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		key, indexes, err := parseSegment(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", path, err)
+		}
+
+		if key != "" {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("path %q: cannot look up %q in a non-object value", path, key)
+			}
+			current, ok = m[key]
+			if !ok {
+				return nil, fmt.Errorf("path %q: field %q not found", path, key)
+			}
+		}
+
+		for _, i := range indexes {
+			list, ok := current.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("path %q: cannot index a non-array value", path)
+			}
+			if i < 0 || i >= len(list) {
+				return nil, fmt.Errorf("path %q: index %d out of range (length %d)", path, i, len(list))
+			}
+			current = list[i]
+		}
+	}
+
+	return current, nil
+}
+
+// parseSegment splits a path segment like "items[0][1]" into its key and
+// array indexes.
+func parseSegment(segment string) (string, []int, error) {
+	key := segment
+	var indexes []int
+	if open := strings.Index(segment, "["); open >= 0 {
+		key = segment[:open]
+		rest := segment[open:]
+		for rest != "" {
+			end := strings.Index(rest, "]")
+			if rest[0] != '[' || end < 0 {
+				return "", nil, fmt.Errorf("malformed index in %q", segment)
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid index in %q", segment)
+			}
+			indexes = append(indexes, i)
+			rest = rest[end+1:]
+		}
+	}
+	if key == "" && len(indexes) == 0 {
+		return "", nil, fmt.Errorf("empty segment")
+	}
+	return key, indexes, nil
+}
+
+// toGeneric converts data to maps, slices and scalars via JSON. Numbers are
+// kept as json.Number so large integers are not rendered in float notation.
+func toGeneric(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode step output: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode step output: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,218 @@
+package scenarios
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Lint issue severities.
+const (
+	// SeverityError marks a step that fails when run.
+	SeverityError = "error"
+
+	// SeverityWarning marks a step that may fail or not run as intended.
+	SeverityWarning = "warning"
+)
+
+// LintIssue is a problem found in a scenario without running it.
+type LintIssue struct {
+	// Step is the one-based index of the step
+	Step int `json:"step"`
+
+	// Name of the step
+	Name string `json:"name"`
+
+	// Severity is SeverityError or SeverityWarning
+	Severity string `json:"severity"`
+
+	// Message describes the problem
+	Message string `json:"message"`
+}
+
+// Lint statically checks a loaded scenario: that each step's action is
+// mapped for its module and has its required params, that every variable
+// a step references is defined by the scenario, vars or an earlier step,
+// and that no step is unreachable because a step it depends on cannot
+// succeed. vars are the variables given at run time, e.g. with --var.
+// Issues are ordered by step.
+func Lint(s *Scenario, vars map[string]string) []LintIssue {
+	var issues []LintIssue
+	add := func(i int, severity, format string, args ...interface{}) {
+		issues = append(issues, LintIssue{
+			Step:     i + 1,
+			Name:     s.Steps[i].Name,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	defined := make(map[string]bool)
+	for k := range s.Variables {
+		defined[k] = true
+	}
+	for k := range vars {
+		defined[k] = true
+	}
+	producedBy := make(map[string]int)
+	for i, step := range s.Steps {
+		for _, name := range []string{step.Output, step.Register} {
+			if _, exists := producedBy[name]; name != "" && !exists {
+				producedBy[name] = i
+			}
+		}
+	}
+
+	failing := make([]bool, len(s.Steps))
+	for i, step := range s.Steps {
+		module := CanonicalModule(step.Module)
+		spec, ok, mapped := LookupAction(step.Module, step.Action)
+		switch {
+		case !mapped:
+			add(i, SeverityWarning, "module '%s' has no mapped actions; '%s' runs through generic execution", step.Module, step.Action)
+		case !ok:
+			add(i, SeverityError, "unknown %s action '%s'", module, step.Action)
+			failing[i] = true
+		default:
+			if missing := missingParams(step.Params, spec.Required); len(missing) > 0 {
+				add(i, SeverityError, "%s.%s is missing required params: %s", module, strings.ToLower(step.Action), strings.Join(missing, ", "))
+				failing[i] = true
+			}
+			if len(spec.OneOf) > 0 && len(missingParams(step.Params, spec.OneOf)) == len(spec.OneOf) {
+				add(i, SeverityError, "%s.%s requires one of the params: %s", module, strings.ToLower(step.Action), strings.Join(spec.OneOf, ", "))
+				failing[i] = true
+			}
+		}
+
+		for _, ref := range stepReferences(&step) {
+			root := strings.SplitN(ref, ".", 2)[0]
+			if defined[root] || (step.ForEach != "" && (root == "item" || root == "item_index")) {
+				continue
+			}
+			if p, ok := producedBy[root]; ok && p >= i {
+				add(i, SeverityError, "variable '%s' is set by step %d (%s), which does not run before this step", ref, p+1, s.Steps[p].Name)
+				failing[i] = true
+				continue
+			}
+			add(i, SeverityWarning, "variable '%s' is not defined by the scenario; pass it with --var or --env-file", ref)
+		}
+
+		if step.Output != "" {
+			defined[step.Output] = true
+		}
+		if step.Register != "" {
+			defined[step.Register] = true
+		}
+	}
+
+	issues = append(issues, unreachableSteps(s, failing)...)
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].Step < issues[b].Step
+	})
+	return issues
+}
+
+// missingParams returns the names in required that params lacks or
+// leaves empty.
+func missingParams(params map[string]string, required []string) []string {
+	var missing []string
+	for _, name := range required {
+		if strings.TrimSpace(params[name]) == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// stepReferences returns the variables a step references in its params
+// and for_each, in a stable order.
+func stepReferences(step *Step) []string {
+	keys := make([]string, 0, len(step.Params))
+	for k := range step.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var refs []string
+	seen := make(map[string]bool)
+	if step.ForEach != "" {
+		refs = append(refs, ForEachVariable(step.ForEach))
+		seen[refs[0]] = true
+	}
+	for _, k := range keys {
+		for _, ref := range ExtractVariables(step.Params[k]) {
+			if !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// unreachableSteps reports steps that never run: steps in a dependency
+// cycle, and steps that depend, directly or through other steps, on a step
+// that is certain to fail and so are skipped.
+func unreachableSteps(s *Scenario, failing []bool) []LintIssue {
+	deps, _ := (&Executor{vars: NewVariableStore()}).buildDependencies(s.Steps)
+	if deps == nil {
+		return nil
+	}
+
+	var issues []LintIssue
+	blockedBy := make([]int, len(s.Steps))
+	for i := range s.Steps {
+		blockedBy[i] = -1
+	}
+	for i, step := range s.Steps {
+		if inCycle(deps, i) {
+			issues = append(issues, LintIssue{
+				Step:     i + 1,
+				Name:     step.Name,
+				Severity: SeverityError,
+				Message:  "step is unreachable: it is part of a dependency cycle",
+			})
+			continue
+		}
+		for _, d := range deps[i] {
+			if d > i {
+				continue
+			}
+			if failing[d] {
+				blockedBy[i] = d
+			} else if blockedBy[d] >= 0 {
+				blockedBy[i] = blockedBy[d]
+			}
+			if blockedBy[i] >= 0 {
+				break
+			}
+		}
+		if blockedBy[i] >= 0 {
+			issues = append(issues, LintIssue{
+				Step:     i + 1,
+				Name:     step.Name,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("step is unreachable: it depends on step %d (%s), which cannot succeed", blockedBy[i]+1, s.Steps[blockedBy[i]].Name),
+			})
+		}
+	}
+	return issues
+}
+
+// inCycle reports whether step i depends on itself through deps.
+func inCycle(deps [][]int, i int) bool {
+	seen := make([]bool, len(deps))
+	stack := append([]int(nil), deps[i]...)
+	for len(stack) > 0 {
+		d := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if d == i {
+			return true
+		}
+		if !seen[d] {
+			seen[d] = true
+			stack = append(stack, deps[d]...)
+		}
+	}
+	return false
+}
@@ -37,7 +37,8 @@ import (
 type ActionMapper struct {
 	client sdk.Client
 
-	// Module instances (lazily initialized)
+	// Module instances, created up front so the mapper can be used
+	// by concurrently running steps
 	authMod        *auth.Module
 	bankMod        *bank.Module
 	basketMod      *basket.Module
@@ -60,37 +61,55 @@ type ActionMapper struct {
 	tokensMod      *tokens.Module
 	ubiMod         *ubi.Module
 	upgradeMod     *upgrade.Module
+
+	// resolver turns key names and address book aliases into addresses.
+	// It caches key names, so that a scenario using the same keys runs
+	// keys show only once per key.
+	resolver *keys.Resolver
 }
 
 // NewActionMapper creates a new action mapper.
 func NewActionMapper(client sdk.Client) *ActionMapper {
 	return &ActionMapper{
-		client: client,
+		client:         client,
+		authMod:        auth.New(client),
+		bankMod:        bank.New(client),
+		basketMod:      basket.New(client),
+		bridgeMod:      bridge.New(client),
+		collectivesMod: collectives.New(client),
+		custodyMod:     custody.New(client),
+		distributorMod: distributor.New(client),
+		ethereumMod:    ethereum.New(client),
+		evidenceMod:    evidence.New(client),
+		govMod:         gov.New(client),
+		keysMod:        keys.New(client),
+		layer2Mod:      layer2.New(client),
+		multistakeMod:  multistaking.New(client),
+		paramsMod:      paramsmod.New(client),
+		recoveryMod:    recovery.New(client),
+		slashingMod:    slashing.New(client),
+		spendingMod:    spending.New(client),
+		stakingMod:     staking.New(client),
+		statusMod:      status.New(client),
+		tokensMod:      tokens.New(client),
+		ubiMod:         ubi.New(client),
+		upgradeMod:     upgrade.New(client),
+		resolver:       keys.NewResolver(client, nil),
 	}
 }
 
-// resolveAddress resolves a key name to an address if needed.
-// If the input looks like a bech32 address (starts with "kira"), it's returned as-is.
-// Otherwise, it tries to resolve it as a key name.
+// SetAddressBook sets the aliases resolved for counterparties that have
+// no key in the keyring. It must be called before steps run.
+func (m *ActionMapper) SetAddressBook(book map[string]string) {
+	m.resolver = keys.NewResolver(m.client, book)
+}
+
+// resolveAddress resolves a key name or address book alias to an address
+// if needed. Bech32 addresses are returned as-is.
 // TODO: Handle Ethereum addresses (0x...) for torii bridge integration.
 // TODO: Handle custom bech32 prefixes for minted tokens.
 func (m *ActionMapper) resolveAddress(ctx context.Context, nameOrAddress string) (string, error) {
-	// If it looks like an address, return as-is
-	if strings.HasPrefix(nameOrAddress, "kira") {
-		return nameOrAddress, nil
-	}
-
-	// Try to resolve as key name
-	if m.keysMod == nil {
-		m.keysMod = keys.New(m.client)
-	}
-
-	keyInfo, err := m.keysMod.Show(ctx, nameOrAddress)
-	if err != nil {
-		return "", fmt.Errorf("failed to resolve '%s' as key name: %w", nameOrAddress, err)
-	}
-
-	return keyInfo.Address, nil
+	return m.resolver.Resolve(ctx, nameOrAddress)
 }
 
 // Execute runs a module action with the given parameters.
@@ -101,6 +120,8 @@ func (m *ActionMapper) Execute(ctx context.Context, module, action string, param
 
 	switch module {
 	case "keys":
+		// Keys may be added, deleted or renamed; resolve names afresh
+		m.resolver.Forget()
 		return m.executeKeys(ctx, action, params)
 	case "bank":
 		return m.executeBank(ctx, action, params, txOpts)
@@ -152,10 +173,6 @@ func (m *ActionMapper) Execute(ctx context.Context, module, action string, param
 
 // executeKeys handles keys module actions.
 func (m *ActionMapper) executeKeys(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.keysMod == nil {
-		m.keysMod = keys.New(m.client)
-	}
-
 	switch action {
 	case "list":
 		result, err := m.keysMod.List(ctx)
@@ -190,7 +207,11 @@ func (m *ActionMapper) executeKeys(ctx context.Context, action string, params ma
 		if name == "" {
 			return nil, nil, fmt.Errorf("keys.export requires 'name' parameter")
 		}
-		result, err := m.keysMod.Export(ctx, name)
+		if params["unarmored_hex"] == "true" {
+			result, err := m.keysMod.ExportHex(ctx, name)
+			return result, nil, err
+		}
+		result, err := m.keysMod.ExportWithPassphrase(ctx, name, params["passphrase"])
 		return result, nil, err
 
 	case "import":
@@ -199,7 +220,7 @@ func (m *ActionMapper) executeKeys(ctx context.Context, action string, params ma
 		if name == "" || armor == "" {
 			return nil, nil, fmt.Errorf("keys.import requires 'name' and 'armor' parameters")
 		}
-		err := m.keysMod.Import(ctx, name, armor)
+		err := m.keysMod.ImportWithPassphrase(ctx, name, armor, params["passphrase"])
 		return nil, nil, err
 
 	case "rename":
@@ -300,10 +321,6 @@ func (m *ActionMapper) executeKeys(ctx context.Context, action string, params ma
 
 // executeBank handles bank module actions.
 func (m *ActionMapper) executeBank(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.bankMod == nil {
-		m.bankMod = bank.New(m.client)
-	}
-
 	switch action {
 	case "balances", "balance":
 		addressParam := params["address"]
@@ -427,10 +444,6 @@ func (m *ActionMapper) executeBank(ctx context.Context, action string, params ma
 
 // executeAuth handles auth module actions.
 func (m *ActionMapper) executeAuth(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.authMod == nil {
-		m.authMod = auth.New(m.client)
-	}
-
 	switch action {
 	case "account":
 		addrParam := params["address"]
@@ -482,10 +495,6 @@ func (m *ActionMapper) executeAuth(ctx context.Context, action string, params ma
 
 // executeGov handles governance module actions.
 func (m *ActionMapper) executeGov(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.govMod == nil {
-		m.govMod = gov.New(m.client)
-	}
-
 	switch action {
 	// Query actions
 	case "network-properties":
@@ -1507,10 +1516,6 @@ func (m *ActionMapper) executeGov(ctx context.Context, action string, params map
 
 // executeStaking handles staking module actions.
 func (m *ActionMapper) executeStaking(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.stakingMod == nil {
-		m.stakingMod = staking.New(m.client)
-	}
-
 	switch action {
 	case "validators":
 		// Resolve address if provided
@@ -1595,10 +1600,6 @@ func (m *ActionMapper) executeStaking(ctx context.Context, action string, params
 
 // executeMultistaking handles multistaking module actions.
 func (m *ActionMapper) executeMultistaking(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.multistakeMod == nil {
-		m.multistakeMod = multistaking.New(m.client)
-	}
-
 	switch action {
 	case "pools":
 		result, err := m.multistakeMod.Pools(ctx)
@@ -1679,6 +1680,18 @@ func (m *ActionMapper) executeMultistaking(ctx context.Context, action string, p
 		result, err := m.multistakeMod.CompoundInfo(ctx, delegator)
 		return result, nil, err
 
+	case "delegations":
+		delegatorParam := params["delegator"]
+		if delegatorParam == "" {
+			return nil, nil, fmt.Errorf("multistaking.delegations requires 'delegator' parameter")
+		}
+		delegator, err := m.resolveAddress(ctx, delegatorParam)
+		if err != nil {
+			return nil, nil, err
+		}
+		result, err := m.multistakeMod.DelegatorDelegations(ctx, delegator, nil)
+		return result, nil, err
+
 	case "staking-pool-delegators", "stakingpooldelegators":
 		validator := params["validator"]
 		if validator == "" {
@@ -1747,10 +1760,6 @@ func (m *ActionMapper) executeMultistaking(ctx context.Context, action string, p
 
 // executeTokens handles tokens module actions.
 func (m *ActionMapper) executeTokens(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.tokensMod == nil {
-		m.tokensMod = tokens.New(m.client)
-	}
-
 	switch action {
 	case "all-rates":
 		result, err := m.tokensMod.AllRates(ctx)
@@ -1890,10 +1899,6 @@ func (m *ActionMapper) executeTokens(ctx context.Context, action string, params
 
 // executeStatus handles status module actions.
 func (m *ActionMapper) executeStatus(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.statusMod == nil {
-		m.statusMod = status.New(m.client)
-	}
-
 	switch action {
 	case "status":
 		result, err := m.statusMod.Status(ctx)
@@ -1934,10 +1939,6 @@ func (m *ActionMapper) executeStatus(ctx context.Context, action string, params
 
 // executeCustody handles custody module actions.
 func (m *ActionMapper) executeCustody(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.custodyMod == nil {
-		m.custodyMod = custody.New(m.client)
-	}
-
 	switch action {
 	case "get":
 		addrParam := params["address"]
@@ -2006,10 +2007,6 @@ func (m *ActionMapper) executeCustody(ctx context.Context, action string, params
 
 // executeParams handles params module actions.
 func (m *ActionMapper) executeParams(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.paramsMod == nil {
-		m.paramsMod = paramsmod.New(m.client)
-	}
-
 	switch action {
 	case "subspace":
 		subspace := params["subspace"]
@@ -2027,10 +2024,6 @@ func (m *ActionMapper) executeParams(ctx context.Context, action string, params
 
 // executeEthereum handles ethereum module actions.
 func (m *ActionMapper) executeEthereum(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.ethereumMod == nil {
-		m.ethereumMod = ethereum.New(m.client)
-	}
-
 	switch action {
 	case "state":
 		result, err := m.ethereumMod.State(ctx)
@@ -2043,10 +2036,6 @@ func (m *ActionMapper) executeEthereum(ctx context.Context, action string, param
 
 // executeEvidence handles evidence module actions.
 func (m *ActionMapper) executeEvidence(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.evidenceMod == nil {
-		m.evidenceMod = evidence.New(m.client)
-	}
-
 	switch action {
 	case "all", "all-evidence":
 		result, err := m.evidenceMod.AllEvidence(ctx)
@@ -2067,10 +2056,6 @@ func (m *ActionMapper) executeEvidence(ctx context.Context, action string, param
 
 // executeDistributor handles distributor module actions.
 func (m *ActionMapper) executeDistributor(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.distributorMod == nil {
-		m.distributorMod = distributor.New(m.client)
-	}
-
 	switch action {
 	case "fees-treasury", "feestreasury":
 		result, err := m.distributorMod.FeesTreasury(ctx)
@@ -2103,10 +2088,6 @@ func (m *ActionMapper) executeDistributor(ctx context.Context, action string, pa
 
 // executeLayer2 handles layer2 module actions.
 func (m *ActionMapper) executeLayer2(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.layer2Mod == nil {
-		m.layer2Mod = layer2.New(m.client)
-	}
-
 	switch action {
 	case "all-dapps", "alldapps":
 		result, err := m.layer2Mod.AllDapps(ctx)
@@ -2134,10 +2115,6 @@ func (m *ActionMapper) executeLayer2(ctx context.Context, action string, params
 
 // executeRecovery handles recovery module actions.
 func (m *ActionMapper) executeRecovery(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.recoveryMod == nil {
-		m.recoveryMod = recovery.New(m.client)
-	}
-
 	switch action {
 	case "recovery-record", "recoveryrecord":
 		addrParam := params["address"]
@@ -2193,10 +2170,6 @@ func (m *ActionMapper) executeRecovery(ctx context.Context, action string, param
 
 // executeSlashing handles slashing module actions.
 func (m *ActionMapper) executeSlashing(ctx context.Context, action string, params map[string]string) (interface{}, *sdk.TxResponse, error) {
-	if m.slashingMod == nil {
-		m.slashingMod = slashing.New(m.client)
-	}
-
 	switch action {
 	case "signing-info", "signinginfo":
 		consAddress := params["cons_address"]
@@ -2236,10 +2209,6 @@ func (m *ActionMapper) executeSlashing(ctx context.Context, action string, param
 
 // executeBridge handles bridge module actions.
 func (m *ActionMapper) executeBridge(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.bridgeMod == nil {
-		m.bridgeMod = bridge.New(m.client)
-	}
-
 	switch action {
 	case "get-cosmos-ethereum", "getcosmosEthereum", "cosmos-ethereum":
 		addrParam := params["address"]
@@ -2300,10 +2269,6 @@ func (m *ActionMapper) executeBridge(ctx context.Context, action string, params
 
 // executeUbi handles ubi module actions.
 func (m *ActionMapper) executeUbi(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.ubiMod == nil {
-		m.ubiMod = ubi.New(m.client)
-	}
-
 	switch action {
 	case "records", "ubi-records":
 		result, err := m.ubiMod.Records(ctx)
@@ -2373,10 +2338,6 @@ func (m *ActionMapper) executeUbi(ctx context.Context, action string, params map
 
 // executeUpgrade handles upgrade module actions.
 func (m *ActionMapper) executeUpgrade(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.upgradeMod == nil {
-		m.upgradeMod = upgrade.New(m.client)
-	}
-
 	switch action {
 	case "current-plan", "currentplan":
 		result, err := m.upgradeMod.CurrentPlan(ctx)
@@ -2441,10 +2402,6 @@ func (m *ActionMapper) executeUpgrade(ctx context.Context, action string, params
 
 // executeSpending handles spending module actions.
 func (m *ActionMapper) executeSpending(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.spendingMod == nil {
-		m.spendingMod = spending.New(m.client)
-	}
-
 	switch action {
 	case "pool-names", "poolnames":
 		result, err := m.spendingMod.PoolNames(ctx)
@@ -2654,10 +2611,6 @@ func (m *ActionMapper) executeSpending(ctx context.Context, action string, param
 
 // executeCollectives handles collectives module actions.
 func (m *ActionMapper) executeCollectives(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.collectivesMod == nil {
-		m.collectivesMod = collectives.New(m.client)
-	}
-
 	switch action {
 	case "collectives", "all", "list":
 		result, err := m.collectivesMod.Collectives(ctx)
@@ -2795,10 +2748,6 @@ func (m *ActionMapper) executeCollectives(ctx context.Context, action string, pa
 
 // executeBasket handles basket module actions.
 func (m *ActionMapper) executeBasket(ctx context.Context, action string, params map[string]string, txOpts *StepTxOptions) (interface{}, *sdk.TxResponse, error) {
-	if m.basketMod == nil {
-		m.basketMod = basket.New(m.client)
-	}
-
 	switch action {
 	case "token-baskets", "tokenbaskets", "baskets":
 		tokens := params["tokens"]
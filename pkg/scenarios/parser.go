@@ -1,14 +1,177 @@
 package scenarios
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/goccy/go-yaml"
 )
 
+// Defaults for loading scenarios from a source other than a local file.
+const (
+	// DefaultFetchTimeout bounds fetching a scenario URL.
+	DefaultFetchTimeout = 30 * time.Second
+
+	// DefaultMaxSize is the largest scenario accepted from a URL or stdin.
+	DefaultMaxSize = 1 << 20
+)
+
+// SourceOptions configures LoadSource and LoadFromURL.
+type SourceOptions struct {
+	// Stdin is read for the source "-".
+	Stdin io.Reader
+
+	// SHA256, if set, is the hex SHA-256 checksum the scenario must match.
+	SHA256 string
+
+	// Insecure allows plain http:// URLs.
+	Insecure bool
+
+	// Timeout bounds fetching a URL (default DefaultFetchTimeout).
+	Timeout time.Duration
+
+	// MaxSize is the largest scenario accepted, in bytes (default
+	// DefaultMaxSize).
+	MaxSize int64
+}
+
+// LoadSource loads a scenario from a file path, "-" for stdin, or an
+// http(s):// URL, verifying its checksum if one is given.
+func LoadSource(ctx context.Context, source string, opts SourceOptions) (*Scenario, error) {
+	if IsURL(source) {
+		return LoadFromURL(ctx, source, opts)
+	}
+
+	var r io.Reader
+	name := source
+	if source == "-" {
+		if opts.Stdin == nil {
+			return nil, fmt.Errorf("no stdin to read the scenario from")
+		}
+		r = opts.Stdin
+		name = "stdin"
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open scenario file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	data, err := readLimited(r, opts.maxSize(), name)
+	if err != nil {
+		return nil, err
+	}
+	return loadVerified(data, opts.SHA256, name)
+}
+
+// LoadFromURL fetches a scenario over HTTPS, or plain HTTP with
+// opts.Insecure, within the timeout and size limits of opts.
+func LoadFromURL(ctx context.Context, rawURL string, opts SourceOptions) (*Scenario, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid scenario URL %q", rawURL)
+	}
+	if err := checkScheme(u, opts.Insecure); err != nil {
+		return nil, err
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultFetchTimeout
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("too many redirects")
+			}
+			return checkScheme(req.URL, opts.Insecure)
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario URL %q: %w", rawURL, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scenario: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch scenario: %s returned %s", rawURL, resp.Status)
+	}
+
+	data, err := readLimited(resp.Body, opts.maxSize(), rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return loadVerified(data, opts.SHA256, rawURL)
+}
+
+// IsURL reports whether a scenario source is an http(s) URL.
+func IsURL(source string) bool {
+	return strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://")
+}
+
+// checkScheme refuses anything but HTTPS unless insecure is set.
+func checkScheme(u *url.URL, insecure bool) error {
+	switch {
+	case u.Scheme == "https":
+		return nil
+	case u.Scheme == "http" && insecure:
+		return nil
+	case u.Scheme == "http":
+		return fmt.Errorf("refusing to fetch scenario over plain HTTP from %s (use https or --insecure)", u.Host)
+	default:
+		return fmt.Errorf("unsupported scenario URL scheme %q", u.Scheme)
+	}
+}
+
+// readLimited reads r, failing if it holds more than max bytes.
+func readLimited(r io.Reader, max int64, name string) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario from %s: %w", name, err)
+	}
+	if int64(len(data)) > max {
+		return nil, fmt.Errorf("scenario from %s is larger than %d bytes", name, max)
+	}
+	return data, nil
+}
+
+// loadVerified checks data against the expected checksum, if any, and
+// parses it.
+func loadVerified(data []byte, want, name string) (*Scenario, error) {
+	if want != "" {
+		sum := sha256.Sum256(data)
+		got := hex.EncodeToString(sum[:])
+		if !strings.EqualFold(strings.TrimSpace(want), got) {
+			return nil, fmt.Errorf("checksum mismatch for scenario from %s: expected sha256 %s, got %s", name, want, got)
+		}
+	}
+	return Load(bytes.NewReader(data))
+}
+
+// maxSize returns the size limit, applying the default.
+func (o SourceOptions) maxSize() int64 {
+	if o.MaxSize > 0 {
+		return o.MaxSize
+	}
+	return DefaultMaxSize
+}
+
 // LoadFromFile loads a scenario from a YAML file.
 func LoadFromFile(path string) (*Scenario, error) {
 	f, err := os.Open(path)
@@ -53,6 +216,10 @@ func validate(s *Scenario) error {
 	}
 
 	outputs := make(map[string]bool)
+	names := make(map[string]bool, len(s.Steps))
+	for _, step := range s.Steps {
+		names[step.Name] = true
+	}
 
 	for i, step := range s.Steps {
 		stepNum := i + 1
@@ -82,6 +249,33 @@ func validate(s *Scenario) error {
 			outputs[step.Output] = true
 		}
 
+		// depends_on must reference other existing steps
+		for _, dep := range step.DependsOn {
+			if dep == step.Name {
+				return fmt.Errorf("scenario validation failed: step '%s' depends on itself", step.Name)
+			}
+			if !names[dep] {
+				return fmt.Errorf("scenario validation failed: step '%s' depends on unknown step '%s'", step.Name, dep)
+			}
+		}
+
+		// register must be a plain variable name
+		if step.Register != "" {
+			if !registerNamePattern.MatchString(step.Register) || step.Register == "item" || step.Register == "item_index" {
+				return fmt.Errorf("scenario validation failed: step '%s' has invalid register name '%s'", step.Name, step.Register)
+			}
+		} else if step.RegisterPath != "" {
+			return fmt.Errorf("scenario validation failed: step '%s' has 'register_path' without 'register'", step.Name)
+		}
+
+		// for_each must name a single variable
+		if step.ForEach != "" {
+			name := ForEachVariable(step.ForEach)
+			if !variableNamePattern.MatchString(name) {
+				return fmt.Errorf("scenario validation failed: step '%s' has invalid for_each '%s' (must name a variable)", step.Name, step.ForEach)
+			}
+		}
+
 		// Validate tx_options if present
 		if step.TxOptions != nil {
 			if step.TxOptions.BroadcastMode != "" {
@@ -96,46 +290,53 @@ func validate(s *Scenario) error {
 	return nil
 }
 
+// variableNamePattern matches a bare variable reference.
+var variableNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_\.]*$`)
+
+// registerNamePattern matches a variable name that can be registered.
+var registerNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// validModules are the module names a step may use.
+var validModules = map[string]bool{
+	// Core modules
+	"keys":   true,
+	"bank":   true,
+	"status": true,
+	"auth":   true,
+
+	// Governance
+	"gov":        true,
+	"customgov":  true,
+	"permission": true,
+	"role":       true,
+	"councilor":  true,
+	"poll":       true,
+	"proposal":   true,
+
+	// Staking
+	"staking":       true,
+	"customstaking": true,
+	"multistaking":  true,
+
+	// Economy
+	"tokens":      true,
+	"basket":      true,
+	"spending":    true,
+	"ubi":         true,
+	"distributor": true,
+
+	// Advanced
+	"upgrade":     true,
+	"slashing":    true,
+	"collectives": true,
+	"custody":     true,
+	"bridge":      true,
+	"layer2":      true,
+	"recovery":    true,
+}
+
 // isValidModule checks if a module name is supported.
 func isValidModule(module string) bool {
-	validModules := map[string]bool{
-		// Core modules
-		"keys":   true,
-		"bank":   true,
-		"status": true,
-		"auth":   true,
-
-		// Governance
-		"gov":        true,
-		"customgov":  true,
-		"permission": true,
-		"role":       true,
-		"councilor":  true,
-		"poll":       true,
-		"proposal":   true,
-
-		// Staking
-		"staking":       true,
-		"customstaking": true,
-		"multistaking":  true,
-
-		// Economy
-		"tokens":      true,
-		"basket":      true,
-		"spending":    true,
-		"ubi":         true,
-		"distributor": true,
-
-		// Advanced
-		"upgrade":     true,
-		"slashing":    true,
-		"collectives": true,
-		"custody":     true,
-		"bridge":      true,
-		"layer2":      true,
-		"recovery":    true,
-	}
-
 	return validModules[strings.ToLower(module)]
 }
 
@@ -207,9 +408,15 @@ func (s *Scenario) String() string {
 	sb.WriteString(fmt.Sprintf("Steps: %d\n", len(s.Steps)))
 	for i, step := range s.Steps {
 		sb.WriteString(fmt.Sprintf("  %d. [%s] %s.%s", i+1, GetStepType(&step), step.Module, step.Action))
+		if step.ForEach != "" {
+			sb.WriteString(fmt.Sprintf(" for each %s", ForEachVariable(step.ForEach)))
+		}
 		if step.Output != "" {
 			sb.WriteString(fmt.Sprintf(" -> %s", step.Output))
 		}
+		if step.Register != "" {
+			sb.WriteString(fmt.Sprintf(" (register %s)", step.Register))
+		}
 		sb.WriteString("\n")
 	}
 	return sb.String()
@@ -0,0 +1,51 @@
+package scenarios
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Report formats.
+const (
+	ReportFormatJSON = "json"
+	ReportFormatYAML = "yaml"
+)
+
+// MarshalReport encodes an execution result as a JSON or YAML report.
+// Both formats use the JSON field names of ExecutionResult; durations are
+// in nanoseconds and timestamps in RFC 3339 format.
+func MarshalReport(result *ExecutionResult, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	switch strings.ToLower(format) {
+	case "", ReportFormatJSON:
+		return append(data, '\n'), nil
+	case ReportFormatYAML:
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode report: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported report format %q (must be json or yaml)", format)
+	}
+}
+
+// WriteReport writes an execution result report to path.
+func WriteReport(path, format string, result *ExecutionResult) error {
+	data, err := MarshalReport(result, format)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
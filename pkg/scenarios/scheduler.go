@@ -0,0 +1,277 @@
+package scenarios
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// stepDone reports a finished step to the scheduler.
+type stepDone struct {
+	index  int
+	result StepResult
+}
+
+// runSteps executes the scenario steps and appends their results in
+// declaration order.
+//
+// Steps start once their dependencies have finished, up to MaxParallel at
+// a time. A step depends on the steps named in depends_on, on earlier steps
+// whose output or registered variable it references, and on the previous
+// transaction signed by the same key, so that transactions from one
+// account never race for the same sequence number. With MaxParallel below
+// 2 this runs the steps one by one in declaration order.
+func (e *Executor) runSteps(ctx context.Context, steps []Step, result *ExecutionResult) error {
+	deps, err := e.buildDependencies(steps)
+	if err != nil {
+		return err
+	}
+
+	maxParallel := e.opts.MaxParallel
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+	parallel := maxParallel > 1
+
+	n := len(steps)
+	results := make([]*StepResult, n)
+	started := make([]bool, n)
+	done := make(chan stepDone)
+	running := 0
+	stopped := false
+	scenarioStart := time.Now()
+
+	for {
+		// Launch every step whose dependencies are satisfied
+		for launched := true; launched && !stopped; {
+			launched = false
+			for i := range steps {
+				if started[i] || running >= maxParallel || ctx.Err() != nil {
+					continue
+				}
+				ready, failedDep := dependenciesFinished(deps[i], results, steps)
+				if !ready {
+					continue
+				}
+				started[i] = true
+				launched = true
+
+				step := &steps[i]
+				if failedDep != "" {
+					skipped := StepResult{
+						Name:    step.Name,
+						Module:  step.Module,
+						Action:  step.Action,
+						Skipped: true,
+						Started: time.Since(scenarioStart),
+						Error:   fmt.Sprintf("dependency '%s' failed", failedDep),
+					}
+					results[i] = &skipped
+					e.logf("[%d/%d] %s\n  Status: SKIPPED (%s)\n\n", i+1, n, step.Name, skipped.Error)
+					continue
+				}
+
+				e.logf("[%d/%d] %s\n", i+1, n, step.Name)
+				running++
+				go func(i int, step *Step, offset time.Duration) {
+					var stepResult StepResult
+					if step.ForEach != "" {
+						stepResult = e.executeForEach(ctx, step)
+					} else {
+						stepResult = e.executeStep(ctx, step, e.vars)
+					}
+					stepResult.Started = offset
+					done <- stepDone{index: i, result: stepResult}
+				}(i, step, time.Since(scenarioStart))
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+
+		d := <-done
+		running--
+		results[d.index] = &d.result
+		if !e.finishStep(&steps[d.index], d.index, n, &d.result, parallel, result) {
+			stopped = true
+		}
+	}
+
+	for _, r := range results {
+		if r != nil {
+			result.Steps = append(result.Steps, *r)
+			result.StepTime += r.Duration
+		}
+	}
+	if ctx.Err() != nil && result.Error == "" {
+		result.Success = false
+		result.Error = ctx.Err().Error()
+	}
+	return nil
+}
+
+// finishStep logs a finished step, stores its output and records a
+// failure in the scenario result. It returns false if execution should
+// stop.
+func (e *Executor) finishStep(step *Step, index, total int, stepResult *StepResult, parallel bool, result *ExecutionResult) bool {
+	prefix := "  Status: "
+	if parallel {
+		prefix = fmt.Sprintf("[%d/%d] %s: ", index+1, total, step.Name)
+	}
+
+	if stepResult.Success {
+		e.logf("%sOK", prefix)
+		if stepResult.TxHash != "" {
+			e.logf(" (tx: %s, height: %d)", truncateHash(stepResult.TxHash), stepResult.BlockHeight)
+		}
+		if parallel {
+			e.logf(" in %s", stepResult.Duration.Round(time.Millisecond))
+		}
+		e.logf("\n")
+
+		// Store output if specified
+		if step.Output != "" && stepResult.Output != nil {
+			e.vars.Set(step.Output, stepResult.Output)
+			if e.opts.Verbose {
+				e.logf("  Output stored in: %s\n", step.Output)
+			}
+		}
+		e.logf("\n")
+		return true
+	}
+
+	result.Success = false
+	e.logf("%sFAILED\n", prefix)
+	if stepResult.ErrorKind != "" {
+		e.logf("  Error (%s): %s\n\n", stepResult.ErrorKind, stepResult.Error)
+	} else {
+		e.logf("  Error: %s\n\n", stepResult.Error)
+	}
+
+	if !e.opts.ContinueOnError {
+		if result.Error == "" {
+			result.Error = fmt.Sprintf("step '%s' failed: %s", step.Name, stepResult.Error)
+		}
+		return false
+	}
+	return true
+}
+
+// dependenciesFinished reports whether all dependencies have finished and,
+// if any of them did not succeed, the name of the first such step.
+func dependenciesFinished(deps []int, results []*StepResult, steps []Step) (bool, string) {
+	failed := ""
+	for _, d := range deps {
+		if results[d] == nil {
+			return false, ""
+		}
+		if !results[d].Success && failed == "" {
+			failed = steps[d].Name
+		}
+	}
+	return true, failed
+}
+
+// buildDependencies returns the indexes of the steps each step waits for.
+func (e *Executor) buildDependencies(steps []Step) ([][]int, error) {
+	byName := make(map[string]int, len(steps))
+	for i, step := range steps {
+		if _, exists := byName[step.Name]; !exists {
+			byName[step.Name] = i
+		}
+	}
+
+	deps := make([][]int, len(steps))
+	producers := make(map[string]int)
+	lastBySigner := make(map[string]int)
+
+	for i := range steps {
+		step := &steps[i]
+		seen := make(map[int]bool)
+		add := func(d int) {
+			if d != i && !seen[d] {
+				seen[d] = true
+				deps[i] = append(deps[i], d)
+			}
+		}
+
+		// Explicit dependencies
+		for _, name := range step.DependsOn {
+			d, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("step '%s' depends on unknown step '%s'", step.Name, name)
+			}
+			add(d)
+		}
+
+		// Data dependencies on earlier outputs and registered values
+		refs := []string{ForEachVariable(step.ForEach)}
+		for _, v := range step.Params {
+			refs = append(refs, ExtractVariables(v)...)
+		}
+		for _, ref := range refs {
+			if d, ok := producers[strings.SplitN(ref, ".", 2)[0]]; ok {
+				add(d)
+			}
+		}
+
+		// Transactions from the same key run in declaration order
+		if GetStepType(step) == StepTypeTransaction {
+			if from := step.Params["from"]; from != "" {
+				if signer, err := e.vars.Interpolate(from); err == nil {
+					from = signer
+				}
+				if d, ok := lastBySigner[from]; ok {
+					add(d)
+				}
+				lastBySigner[from] = i
+			}
+		}
+
+		if step.Output != "" {
+			producers[step.Output] = i
+		}
+		if step.Register != "" {
+			producers[step.Register] = i
+		}
+	}
+
+	return deps, checkAcyclic(steps, deps)
+}
+
+// checkAcyclic returns an error if the dependency graph has a cycle.
+func checkAcyclic(steps []Step, deps [][]int) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(steps))
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visiting:
+			return fmt.Errorf("dependency cycle involving step '%s'", steps[i].Name)
+		case visited:
+			return nil
+		}
+		state[i] = visiting
+		for _, d := range deps[i] {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		return nil
+	}
+
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
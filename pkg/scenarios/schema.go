@@ -0,0 +1,176 @@
+package scenarios
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schema returns a JSON Schema (draft-07) of scenario files, for editor
+// completion and validation. The structure and descriptions come from the
+// Scenario, Step and StepTxOptions fields and their description tags; the
+// modules, the actions of each mapped module and their required params
+// come from the same tables as validate and Lint, so the schema stays in
+// sync with the mapper.
+func Schema() map[string]interface{} {
+	schema := objectSchema(reflect.TypeOf(Scenario{}))
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = "sekai-cli scenario"
+
+	steps := schema["properties"].(map[string]interface{})["steps"].(map[string]interface{})
+	steps["minItems"] = 1
+	step := steps["items"].(map[string]interface{})
+	step["properties"].(map[string]interface{})["module"].(map[string]interface{})["enum"] = sortedKeys(validModules)
+	step["allOf"] = actionRules()
+	return schema
+}
+
+// objectSchema describes a struct by the yaml names of its fields. Fields
+// without omitempty are required; unknown fields are rejected, as the
+// strict parser does.
+func objectSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		prop := typeSchema(field.Type)
+		if desc := field.Tag.Get("description"); desc != "" {
+			prop["description"] = desc
+		}
+		properties[name] = prop
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// typeSchema describes a field type.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return map[string]interface{}{"type": "string", "pattern": `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return typeSchema(t.Elem())
+	case reflect.Struct:
+		return objectSchema(t)
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		// Scalars are accepted for string values, as YAML users write
+		// numbers and booleans unquoted
+		values := map[string]interface{}{}
+		if t.Elem().Kind() == reflect.String {
+			values["type"] = []string{"string", "number", "boolean"}
+		}
+		return map[string]interface{}{"type": "object", "additionalProperties": values}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// actionRules returns the conditional rules of a step: for each module
+// with mapped actions the allowed actions, and for each action its
+// required params.
+func actionRules() []interface{} {
+	var rules []interface{}
+	for _, module := range sortedKeys(validModules) {
+		actions, ok := mappedActions[CanonicalModule(module)]
+		if !ok {
+			continue
+		}
+		names := make([]string, 0, len(actions))
+		for action := range actions {
+			names = append(names, action)
+		}
+		sort.Strings(names)
+
+		rules = append(rules, map[string]interface{}{
+			"if": stepMatch(module, ""),
+			"then": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"action": map[string]interface{}{"enum": names},
+				},
+			},
+		})
+		for _, action := range names {
+			if params := paramsSchema(actions[action]); params != nil {
+				rules = append(rules, map[string]interface{}{
+					"if": stepMatch(module, action),
+					"then": map[string]interface{}{
+						"required":   []string{"params"},
+						"properties": map[string]interface{}{"params": params},
+					},
+				})
+			}
+		}
+	}
+	return rules
+}
+
+// stepMatch matches steps of a module, and of an action if it is set.
+func stepMatch(module, action string) map[string]interface{} {
+	properties := map[string]interface{}{
+		"module": map[string]interface{}{"const": module},
+	}
+	required := []string{"module"}
+	if action != "" {
+		properties["action"] = map[string]interface{}{"const": action}
+		required = append(required, "action")
+	}
+	return map[string]interface{}{"properties": properties, "required": required}
+}
+
+// paramsSchema returns the params an action requires, or nil if it
+// requires none.
+func paramsSchema(spec ActionSpec) map[string]interface{} {
+	if len(spec.Required) == 0 && len(spec.OneOf) == 0 {
+		return nil
+	}
+	params := map[string]interface{}{}
+	if len(spec.Required) > 0 {
+		params["required"] = spec.Required
+	}
+	if len(spec.OneOf) > 0 {
+		anyOf := make([]interface{}, 0, len(spec.OneOf))
+		for _, name := range spec.OneOf {
+			anyOf = append(anyOf, map[string]interface{}{"required": []string{name}})
+		}
+		params["anyOf"] = anyOf
+	}
+	return params
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
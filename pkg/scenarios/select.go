@@ -0,0 +1,120 @@
+package scenarios
+
+import (
+	"fmt"
+	"strings"
+)
+
+// selectSteps returns the steps chosen by the Steps, FromStep and ToStep
+// options, in declaration order: every named step, plus the range from
+// FromStep (the first step if empty) to ToStep (the last step if empty)
+// when either is set. Without a selection all steps are returned.
+//
+// Steps that were not selected do not run. depends_on entries naming them
+// are dropped, so selected steps run as if those dependencies had
+// succeeded. Variables a selected step takes from the output or register
+// of an unselected step must already be set, e.g. with --var; otherwise
+// an error names the step that provides them.
+func (e *Executor) selectSteps(steps []Step) ([]Step, error) {
+	if len(e.opts.Steps) == 0 && e.opts.FromStep == "" && e.opts.ToStep == "" {
+		return steps, nil
+	}
+
+	index := func(name, option string) (int, error) {
+		for i := range steps {
+			if steps[i].Name == name {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("%s: no step named '%s'", option, name)
+	}
+
+	selected := make([]bool, len(steps))
+	for _, name := range e.opts.Steps {
+		i, err := index(name, "--step")
+		if err != nil {
+			return nil, err
+		}
+		selected[i] = true
+	}
+	if e.opts.FromStep != "" || e.opts.ToStep != "" {
+		from, to := 0, len(steps)-1
+		var err error
+		if e.opts.FromStep != "" {
+			if from, err = index(e.opts.FromStep, "--from-step"); err != nil {
+				return nil, err
+			}
+		}
+		if e.opts.ToStep != "" {
+			if to, err = index(e.opts.ToStep, "--to-step"); err != nil {
+				return nil, err
+			}
+		}
+		if from > to {
+			return nil, fmt.Errorf("--from-step '%s' comes after --to-step '%s'", e.opts.FromStep, e.opts.ToStep)
+		}
+		for i := from; i <= to; i++ {
+			selected[i] = true
+		}
+	}
+
+	// Outputs and registered values of the steps that will not run
+	unselected := make(map[string]string)
+	for i := range steps {
+		if selected[i] {
+			continue
+		}
+		if steps[i].Output != "" {
+			unselected[steps[i].Output] = steps[i].Name
+		}
+		if steps[i].Register != "" {
+			unselected[steps[i].Register] = steps[i].Name
+		}
+	}
+
+	var result []Step
+	produced := make(map[string]bool)
+	for i := range steps {
+		if !selected[i] {
+			continue
+		}
+		step := steps[i]
+
+		refs := []string{ForEachVariable(step.ForEach)}
+		for _, v := range step.Params {
+			refs = append(refs, ExtractVariables(v)...)
+		}
+		for _, ref := range refs {
+			root := strings.SplitN(ref, ".", 2)[0]
+			provider, ok := unselected[root]
+			if !ok || produced[root] {
+				continue
+			}
+			if _, ok := e.vars.Get(ref); !ok {
+				hint := "select it too"
+				if ref == root {
+					hint += " or set --var " + root + "=..."
+				}
+				return nil, fmt.Errorf("step '%s' uses '%s' from step '%s', which is not selected (%s)", step.Name, ref, provider, hint)
+			}
+		}
+
+		var dependsOn []string
+		for _, name := range step.DependsOn {
+			// Unknown names are kept for runSteps to report
+			if d, err := index(name, "depends_on"); err != nil || selected[d] {
+				dependsOn = append(dependsOn, name)
+			}
+		}
+		step.DependsOn = dependsOn
+
+		if step.Output != "" {
+			produced[step.Output] = true
+		}
+		if step.Register != "" {
+			produced[step.Register] = true
+		}
+		result = append(result, step)
+	}
+	return result, nil
+}
@@ -4,66 +4,88 @@
 package scenarios
 
 import (
+	"encoding/json"
 	"time"
 )
 
 // Scenario represents a playbook of steps to execute against the blockchain.
 type Scenario struct {
 	// Name is a short identifier for the scenario
-	Name string `yaml:"name"`
+	Name string `yaml:"name" description:"Short identifier of the scenario"`
 
 	// Description provides details about what the scenario does
-	Description string `yaml:"description,omitempty"`
+	Description string `yaml:"description,omitempty" description:"What the scenario does"`
 
 	// Version of the scenario format (for future compatibility)
-	Version string `yaml:"version,omitempty"`
+	Version string `yaml:"version,omitempty" description:"Version of the scenario format"`
 
 	// Variables are default values that can be overridden via CLI
-	Variables map[string]interface{} `yaml:"variables,omitempty"`
+	Variables map[string]interface{} `yaml:"variables,omitempty" description:"Default variable values, overridable with --var"`
 
 	// Steps are the ordered list of operations to execute
-	Steps []Step `yaml:"steps"`
+	Steps []Step `yaml:"steps" description:"Operations to execute, in order"`
 }
 
 // Step represents a single operation in a scenario.
 type Step struct {
 	// Name is a human-readable description of this step
-	Name string `yaml:"name"`
+	Name string `yaml:"name" description:"Human-readable description of the step, unique within the scenario"`
 
 	// Module is the SDK module to use (e.g., "bank", "gov", "keys")
-	Module string `yaml:"module"`
+	Module string `yaml:"module" description:"SDK module to use, e.g. bank, gov or keys"`
 
 	// Action is the operation to perform (e.g., "send", "balances", "add")
-	Action string `yaml:"action"`
+	Action string `yaml:"action" description:"Operation to perform, e.g. send, balances or add"`
 
 	// Params are the parameters passed to the action
 	// Values can contain {{ variable }} placeholders
-	Params map[string]string `yaml:"params,omitempty"`
+	Params map[string]string `yaml:"params,omitempty" description:"Parameters of the action; values may contain {{ variable }} placeholders"`
 
 	// Output is the variable name to store the step's result
 	// Can be referenced in later steps as {{ output_name.field }}
-	Output string `yaml:"output,omitempty"`
+	Output string `yaml:"output,omitempty" description:"Variable to store the step result in, referenced as {{ output.field }}"`
+
+	// Register is the variable name to store a value extracted from the
+	// step's result, e.g. a proposal ID to vote on in a later step
+	Register string `yaml:"register,omitempty" description:"Variable to store a value extracted from the result in"`
+
+	// RegisterPath is the JSON path of the registered value within the
+	// result (e.g. ".proposal_id"). Transaction steps additionally expose
+	// .txhash, .height, .code and .attributes (event attributes by key).
+	// Empty registers the whole result.
+	RegisterPath string `yaml:"register_path,omitempty" description:"JSON path of the registered value, e.g. .proposal_id; empty registers the whole result"`
+
+	// DependsOn lists the names of steps that must complete before this
+	// one starts. It only matters with parallel execution, where steps
+	// otherwise start as soon as the data they reference is available.
+	DependsOn []string `yaml:"depends_on,omitempty" description:"Names of steps that must complete first when running in parallel"`
+
+	// ForEach names a variable (array or comma-separated list) to iterate.
+	// The step runs once per item, with the item available as {{ item }}
+	// and its zero-based position as {{ item_index }}. The step output is
+	// the list of per-item outputs.
+	ForEach string `yaml:"for_each,omitempty" description:"Variable (list or comma-separated string) to run the step for, once per {{ item }}"`
 
 	// TxOptions configures transaction-specific settings
-	TxOptions *StepTxOptions `yaml:"tx_options,omitempty"`
+	TxOptions *StepTxOptions `yaml:"tx_options,omitempty" description:"Transaction settings of the step"`
 }
 
 // StepTxOptions configures transaction behavior for a step.
 type StepTxOptions struct {
 	// Fees to pay for the transaction
-	Fees string `yaml:"fees,omitempty"`
+	Fees string `yaml:"fees,omitempty" description:"Fees to pay, e.g. 100ukex"`
 
 	// Gas limit for the transaction
-	Gas string `yaml:"gas,omitempty"`
+	Gas string `yaml:"gas,omitempty" description:"Gas limit, or auto"`
 
 	// Memo to include in the transaction
-	Memo string `yaml:"memo,omitempty"`
+	Memo string `yaml:"memo,omitempty" description:"Transaction memo"`
 
 	// BroadcastMode: sync, async, or block
-	BroadcastMode string `yaml:"broadcast_mode,omitempty"`
+	BroadcastMode string `yaml:"broadcast_mode,omitempty" description:"Broadcast mode: sync, async or block"`
 
 	// WaitTimeout is how long to wait for TX confirmation (default: 60s)
-	WaitTimeout time.Duration `yaml:"wait_timeout,omitempty"`
+	WaitTimeout time.Duration `yaml:"wait_timeout,omitempty" description:"How long to wait for confirmation, e.g. 60s"`
 }
 
 // ExecutionResult contains the complete result of running a scenario.
@@ -77,9 +99,23 @@ type ExecutionResult struct {
 	// Steps contains results for each step
 	Steps []StepResult `json:"steps"`
 
+	// StartedAt is when execution began
+	StartedAt time.Time `json:"started_at"`
+
+	// FinishedAt is when execution ended
+	FinishedAt time.Time `json:"finished_at"`
+
+	// Variables are the scenario variables after applying CLI overrides,
+	// i.e. the inputs needed to reproduce the run
+	Variables map[string]interface{} `json:"variables,omitempty"`
+
 	// Duration is how long the entire scenario took
 	Duration time.Duration `json:"duration"`
 
+	// StepTime is the sum of all step durations. With parallel execution,
+	// comparing it with Duration shows the speedup.
+	StepTime time.Duration `json:"step_time"`
+
 	// Error message if the scenario failed
 	Error string `json:"error,omitempty"`
 }
@@ -110,14 +146,31 @@ type StepResult struct {
 	// BlockHeight where TX was included
 	BlockHeight int64 `json:"block_height,omitempty"`
 
+	// Started is when the step began, relative to the scenario start
+	Started time.Duration `json:"started"`
+
 	// Duration of this step
 	Duration time.Duration `json:"duration"`
 
 	// Error message if the step failed
 	Error string `json:"error,omitempty"`
 
+	// ErrorKind classifies a failed step's error, e.g. "not_found" or
+	// "insufficient_fees" (see sdk.ErrorKind)
+	ErrorKind string `json:"error_kind,omitempty"`
+
 	// Skipped indicates if step was skipped (e.g., dry-run mode)
 	Skipped bool `json:"skipped,omitempty"`
+
+	// UnsignedTx is the transaction a dry run built for a transaction
+	// step, as it would be signed
+	UnsignedTx json.RawMessage `json:"unsigned_tx,omitempty"`
+
+	// Item is the for_each item this result belongs to
+	Item string `json:"item,omitempty"`
+
+	// Items contains the per-item results of a for_each step
+	Items []StepResult `json:"items,omitempty"`
 }
 
 // ExecutorOptions configures how scenarios are executed.
@@ -139,6 +192,22 @@ type ExecutorOptions struct {
 
 	// ContinueOnError continues executing even if a step fails
 	ContinueOnError bool
+
+	// MaxParallel is the maximum number of steps run concurrently.
+	// Values below 2 run steps sequentially.
+	MaxParallel int
+
+	// AddressBook maps aliases to addresses, resolved in address
+	// parameters after key names
+	AddressBook map[string]string
+
+	// Steps, if set, are the names of the only steps to run
+	Steps []string
+
+	// FromStep and ToStep, if either is set, select the steps from one
+	// named step to another, inclusive; they add to Steps
+	FromStep string
+	ToStep   string
 }
 
 // DefaultExecutorOptions returns sensible defaults for scenario execution.
@@ -150,6 +219,7 @@ func DefaultExecutorOptions() *ExecutorOptions {
 		TxWaitTimeout:   60 * time.Second,
 		TxPollInterval:  2 * time.Second,
 		ContinueOnError: false,
+		MaxParallel:     1,
 	}
 }
 
@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // VariableStore holds variables for scenario execution.
 // It supports nested access via dot notation (e.g., "step_output.field.subfield").
+// A store created with NewScope falls back to its parent for variables it
+// does not define itself.
+// It is safe for concurrent use.
 type VariableStore struct {
-	vars map[string]interface{}
+	mu     sync.RWMutex
+	vars   map[string]interface{}
+	parent *VariableStore
 }
 
 // NewVariableStore creates a new variable store.
@@ -20,19 +26,41 @@ func NewVariableStore() *VariableStore {
 	}
 }
 
+// NewScope creates a child store. Variables set in the child shadow the
+// parent's and are discarded with the child.
+func (vs *VariableStore) NewScope() *VariableStore {
+	return &VariableStore{
+		vars:   make(map[string]interface{}),
+		parent: vs,
+	}
+}
+
 // Set stores a variable value.
 func (vs *VariableStore) Set(name string, value interface{}) {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
 	vs.vars[name] = value
 }
 
+// Local returns a variable defined in this store itself, ignoring parents.
+func (vs *VariableStore) Local(name string) (interface{}, bool) {
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+	value, exists := vs.vars[name]
+	return value, exists
+}
+
 // Get retrieves a variable value with support for nested access.
 // Supports dot notation: "output.field.subfield"
 func (vs *VariableStore) Get(name string) (interface{}, bool) {
 	parts := strings.Split(name, ".")
 
-	// Get the root variable
-	current, exists := vs.vars[parts[0]]
+	// Get the root variable, falling back to the enclosing scope
+	current, exists := vs.Local(parts[0])
 	if !exists {
+		if vs.parent != nil {
+			return vs.parent.Get(name)
+		}
 		return nil, false
 	}
 
@@ -81,6 +109,8 @@ func (vs *VariableStore) GetString(name string) (string, bool) {
 // MergeFrom merges variables from a map into the store.
 // Existing variables with the same name are overwritten.
 func (vs *VariableStore) MergeFrom(vars map[string]interface{}) {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
 	for k, v := range vars {
 		vs.vars[k] = v
 	}
@@ -88,14 +118,21 @@ func (vs *VariableStore) MergeFrom(vars map[string]interface{}) {
 
 // MergeFromStringMap merges variables from a string map (CLI overrides).
 func (vs *VariableStore) MergeFromStringMap(vars map[string]string) {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
 	for k, v := range vars {
 		vs.vars[k] = v
 	}
 }
 
-// All returns all variables as a map.
+// All returns all variables as a map, including those of enclosing scopes.
 func (vs *VariableStore) All() map[string]interface{} {
 	result := make(map[string]interface{})
+	if vs.parent != nil {
+		result = vs.parent.All()
+	}
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
 	for k, v := range vs.vars {
 		result[k] = v
 	}
@@ -201,6 +238,46 @@ func toString(v interface{}) string {
 	}
 }
 
+// ListItems resolves a for_each variable to its items. Arrays are used as
+// is; strings are split on commas with surrounding whitespace trimmed.
+func (vs *VariableStore) ListItems(name string) ([]interface{}, error) {
+	value, exists := vs.Get(name)
+	if !exists {
+		return nil, fmt.Errorf("undefined variable: %s", name)
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		return v, nil
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items, nil
+	case string:
+		var items []interface{}
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("variable %s is not a list or comma-separated string", name)
+	}
+}
+
+// ForEachVariable returns the variable name referenced by a for_each
+// value, which may be given bare ("recipients") or as "{{ recipients }}".
+func ForEachVariable(forEach string) string {
+	name := strings.TrimSpace(forEach)
+	if m := variablePattern.FindStringSubmatch(name); m != nil && m[0] == name {
+		return m[1]
+	}
+	return name
+}
+
 // ParseCLIVars parses CLI variable overrides in the format "key=value".
 func ParseCLIVars(vars []string) (map[string]string, error) {
 	result := make(map[string]string)
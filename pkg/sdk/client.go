@@ -6,6 +6,12 @@ package sdk
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 )
 
 // Client is the core abstraction for blockchain communication.
@@ -85,6 +91,79 @@ type TxRequest struct {
 	SkipConfirmation bool
 }
 
+// SignOptions configures offline signing of a generated transaction.
+type SignOptions struct {
+	// Signer is the key name or address to sign with
+	Signer string
+
+	// AccountNumber overrides the signer's account number (queried if empty)
+	AccountNumber string
+
+	// Sequence overrides the signer's sequence (queried if empty)
+	Sequence string
+
+	// SignMode is the signing mode (the client's default if empty)
+	SignMode string
+}
+
+// Offline reports whether both account number and sequence are set, so
+// signing needs no connection to a node.
+func (o *SignOptions) Offline() bool {
+	return o != nil && o.AccountNumber != "" && o.Sequence != ""
+}
+
+// Signing modes of a transaction. Direct signs the protobuf encoding and is
+// the default; amino-json signs the legacy JSON encoding, which Ledger
+// devices and some older tools require for certain message types.
+const (
+	SignModeDirect    = "direct"
+	SignModeAminoJSON = "amino-json"
+)
+
+// ValidateSignMode checks that mode is a supported signing mode. An empty
+// mode is valid and selects the default.
+func ValidateSignMode(mode string) error {
+	switch mode {
+	case "", SignModeDirect, SignModeAminoJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown sign mode %q (expected %s or %s)", mode, SignModeDirect, SignModeAminoJSON)
+	}
+}
+
+// ValidateTxJSON checks that tx is a JSON transaction object, as produced
+// by GenerateTx or SignTx, before it is encoded.
+func ValidateTxJSON(tx []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(tx, &v); err != nil {
+		return fmt.Errorf("malformed transaction JSON: %w", err)
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("malformed transaction JSON: expected an object")
+	}
+	if _, ok := obj["body"]; !ok {
+		return fmt.Errorf("malformed transaction JSON: missing \"body\"")
+	}
+	return nil
+}
+
+// ValidateTxBytes checks that txBytes is base64-encoded transaction bytes
+// and returns it with surrounding whitespace removed.
+func ValidateTxBytes(txBytes string) (string, error) {
+	txBytes = strings.TrimSpace(txBytes)
+	if txBytes == "" {
+		return "", fmt.Errorf("transaction bytes are empty")
+	}
+	if strings.HasPrefix(txBytes, "{") {
+		return "", fmt.Errorf("expected base64-encoded transaction bytes, got JSON")
+	}
+	if _, err := base64.StdEncoding.DecodeString(txBytes); err != nil {
+		return "", fmt.Errorf("malformed base64 transaction: %w", err)
+	}
+	return txBytes, nil
+}
+
 // TxResponse represents the response from a transaction operation.
 type TxResponse struct {
 	// TxHash is the transaction hash
@@ -93,6 +172,9 @@ type TxResponse struct {
 	// Code is the response code (0 = success)
 	Code uint32 `json:"code"`
 
+	// Codespace is the module namespace of Code (e.g. "sdk")
+	Codespace string `json:"codespace,omitempty"`
+
 	// Height is the block height where the tx was included
 	Height int64 `json:"height,string"`
 
@@ -187,6 +269,105 @@ type ValidatorInfo struct {
 	VotingPower int64 `json:"voting_power"`
 }
 
+// TxSimulator is implemented by clients that can estimate the gas of a
+// transaction without broadcasting it.
+type TxSimulator interface {
+	// Simulate estimates the gas a transaction would use without broadcasting it.
+	Simulate(ctx context.Context, req *TxRequest) (*SimulateResponse, error)
+}
+
+// OfflineTxClient is implemented by clients that can build, sign and
+// broadcast a transaction in separate steps.
+type OfflineTxClient interface {
+	// GenerateTx builds an unsigned transaction and returns it as JSON.
+	GenerateTx(ctx context.Context, req *TxRequest) ([]byte, error)
+
+	// SignTx signs a transaction produced by GenerateTx and returns the signed JSON.
+	SignTx(ctx context.Context, tx []byte, opts *SignOptions) ([]byte, error)
+
+	// BroadcastTx submits a signed transaction to the network.
+	BroadcastTx(ctx context.Context, tx []byte, mode string) (*TxResponse, error)
+}
+
+// TxCodec is implemented by clients that can convert a transaction
+// between its JSON and protobuf forms.
+type TxCodec interface {
+	// EncodeTx converts a transaction JSON into its base64-encoded protobuf bytes.
+	EncodeTx(ctx context.Context, tx []byte) (string, error)
+
+	// DecodeTx converts base64-encoded protobuf transaction bytes into JSON.
+	DecodeTx(ctx context.Context, txBytes string) ([]byte, error)
+}
+
+// NetInfoClient is implemented by clients that can report the peers of
+// the node they talk to.
+type NetInfoClient interface {
+	// NetInfo returns the node's peer connections.
+	NetInfo(ctx context.Context) (*NetInfo, error)
+}
+
+// NetInfo describes the peer connections of a node.
+type NetInfo struct {
+	// Listening reports whether the node accepts inbound peers
+	Listening bool `json:"listening"`
+
+	// PeerCount is the number of connected peers
+	PeerCount int `json:"peer_count"`
+
+	// Peers lists the connected peers
+	Peers []Peer `json:"peers,omitempty"`
+}
+
+// Peer is a connected peer of a node.
+type Peer struct {
+	// ID is the peer's node ID
+	ID string `json:"id"`
+
+	// Moniker is the peer's moniker
+	Moniker string `json:"moniker"`
+
+	// RemoteIP is the peer's address
+	RemoteIP string `json:"remote_ip"`
+}
+
+// ParseNetInfo parses a Tendermint RPC net_info response, with or without
+// the JSON-RPC envelope.
+func ParseNetInfo(data []byte) (*NetInfo, error) {
+	type rawNetInfo struct {
+		Listening bool   `json:"listening"`
+		NPeers    string `json:"n_peers"`
+		Peers     []struct {
+			NodeInfo struct {
+				ID      string `json:"id"`
+				Moniker string `json:"moniker"`
+			} `json:"node_info"`
+			RemoteIP string `json:"remote_ip"`
+		} `json:"peers"`
+	}
+	var envelope struct {
+		Result *rawNetInfo `json:"result"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse net_info: %w", err)
+	}
+	raw := envelope.Result
+	if raw == nil {
+		raw = &rawNetInfo{}
+		if err := json.Unmarshal(data, raw); err != nil {
+			return nil, fmt.Errorf("failed to parse net_info: %w", err)
+		}
+	}
+
+	info := &NetInfo{Listening: raw.Listening, PeerCount: len(raw.Peers)}
+	if n, err := strconv.Atoi(raw.NPeers); err == nil {
+		info.PeerCount = n
+	}
+	for _, p := range raw.Peers {
+		info.Peers = append(info.Peers, Peer{ID: p.NodeInfo.ID, Moniker: p.NodeInfo.Moniker, RemoteIP: p.RemoteIP})
+	}
+	return info, nil
+}
+
 // KeysClient handles key management operations.
 // Note: Most implementations will only work with Docker client,
 // as keys are stored locally on the node.
@@ -228,6 +409,30 @@ type KeysClient interface {
 	Parse(ctx context.Context, address string) (*ParsedAddress, error)
 }
 
+// KeysExporter is implemented by keyring clients that can export and
+// import private keys protected by a chosen passphrase, or as hex.
+type KeysExporter interface {
+	// ExportWithPassphrase exports a private key as an ASCII-armored
+	// string encrypted with passphrase.
+	ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error)
+
+	// ExportHex exports a private key as unarmored hex.
+	ExportHex(ctx context.Context, name string) (string, error)
+
+	// ImportWithPassphrase imports a private key from an ASCII-armored
+	// string encrypted with passphrase.
+	ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error
+}
+
+// KeysSigner is implemented by keyring clients that can sign off-chain
+// messages.
+type KeysSigner interface {
+	// SignMessage signs data as an ADR-036 off-chain message of signer,
+	// the key's address, without the private key leaving the keyring. It
+	// returns the 64-byte signature and the compressed public key.
+	SignMessage(ctx context.Context, name, signer string, data []byte) (signature, pubKey []byte, err error)
+}
+
 // ParsedAddress contains address conversion results.
 type ParsedAddress struct {
 	Human  string `json:"human"` // The human-readable prefix (e.g., "kira")
@@ -236,12 +441,47 @@ type ParsedAddress struct {
 	Bech32 string // The original bech32 address (if input was bech32)
 }
 
+// SimulateResponse contains the result of a transaction simulation.
+type SimulateResponse struct {
+	// GasEstimate is the gas used by the simulated transaction
+	GasEstimate uint64 `json:"gas_estimate"`
+
+	// GasAdjustment is the factor applied to the estimate
+	GasAdjustment float64 `json:"gas_adjustment"`
+
+	// GasLimit is the adjusted gas limit: ceil(GasEstimate * GasAdjustment)
+	GasLimit uint64 `json:"gas_limit"`
+
+	// Fees is the fee the transaction would pay
+	Fees string `json:"fees,omitempty"`
+}
+
+// NewSimulateResponse creates a SimulateResponse with the gas limit
+// computed from the estimate and adjustment.
+func NewSimulateResponse(gasEstimate uint64, gasAdjustment float64, fees string) *SimulateResponse {
+	resp := &SimulateResponse{GasEstimate: gasEstimate, Fees: fees}
+	resp.Adjust(gasAdjustment)
+	return resp
+}
+
+// Adjust sets the gas adjustment and recomputes the gas limit.
+// A non-positive adjustment is treated as 1.
+func (r *SimulateResponse) Adjust(gasAdjustment float64) {
+	if gasAdjustment <= 0 {
+		gasAdjustment = 1
+	}
+	r.GasAdjustment = gasAdjustment
+	r.GasLimit = uint64(math.Ceil(float64(r.GasEstimate) * gasAdjustment))
+}
+
 // Pagination holds pagination parameters for list queries.
 type Pagination struct {
 	// Key is the pagination key for cursor-based pagination
 	Key string
 	// Offset is the offset for offset-based pagination
 	Offset uint64
+	// Page is the 1-based page number (alternative to Offset)
+	Page uint64
 	// Limit is the maximum number of items to return
 	Limit uint64
 	// CountTotal requests the total count
@@ -250,6 +490,60 @@ type Pagination struct {
 	Reverse bool
 }
 
+// EachPage follows the pages of a paginated query from p, calling fetch
+// with the pagination of each page until a page has no next key, or
+// repeats the key it was fetched with. fetch returns the next key of its
+// page. Later pages are fetched by key, so p's offset and page number
+// apply to the first page only.
+func EachPage(ctx context.Context, p *Pagination, fetch func(page *Pagination) (nextKey string, err error)) error {
+	var page Pagination
+	if p != nil {
+		page = *p
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next, err := fetch(&page)
+		if err != nil {
+			return err
+		}
+		if next == "" || next == page.Key {
+			return nil
+		}
+		page.Key, page.Offset, page.Page, page.CountTotal = next, 0, 0, false
+	}
+}
+
+// Params returns the pagination as query params named after the sekaid
+// flags (limit, offset, page, page-key, count-total, reverse).
+// Clients translate these names for their backend.
+func (p *Pagination) Params() map[string]string {
+	params := make(map[string]string)
+	if p == nil {
+		return params
+	}
+	if p.Limit > 0 {
+		params["limit"] = strconv.FormatUint(p.Limit, 10)
+	}
+	if p.Offset > 0 {
+		params["offset"] = strconv.FormatUint(p.Offset, 10)
+	}
+	if p.Page > 0 {
+		params["page"] = strconv.FormatUint(p.Page, 10)
+	}
+	if p.Key != "" {
+		params["page-key"] = p.Key
+	}
+	if p.CountTotal {
+		params["count-total"] = "true"
+	}
+	if p.Reverse {
+		params["reverse"] = "true"
+	}
+	return params
+}
+
 // KeyAddOptions configures key creation.
 type KeyAddOptions struct {
 	// Recover indicates whether to recover from mnemonic
@@ -4,9 +4,13 @@ package docker
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
@@ -15,6 +19,12 @@ import (
 type Client struct {
 	config *Config
 	keys   *keysClient
+
+	// prepared is the runtime resolved on the first command and reused
+	// by the following ones
+	prepareOnce sync.Once
+	prepared    *preparedRuntime
+	prepareErr  error
 }
 
 // Config holds configuration for the Docker client.
@@ -22,6 +32,12 @@ type Config struct {
 	// Container is the Docker container name or ID.
 	Container string
 
+	// Runtime selects the container runtime (docker or podman) and daemon.
+	Runtime Runtime
+
+	// Executor runs sekaid commands. Nil uses Runtime to exec into Container.
+	Executor Executor
+
 	// SekaidPath is the path to the sekaid binary inside the container.
 	SekaidPath string
 
@@ -31,6 +47,15 @@ type Config struct {
 	// KeyringBackend is the keyring backend type.
 	KeyringBackend string
 
+	// KeyringDir is the keyring directory inside the container, passed as
+	// --keyring-dir; empty leaves sekaid's default (the home directory).
+	KeyringDir string
+
+	// KeyringPassphrase unlocks the file keyring backend. It is written to
+	// the stdin of every command that opens the keyring and never passed
+	// as an argument or logged.
+	KeyringPassphrase string
+
 	// Home is the sekaid home directory inside the container.
 	Home string
 
@@ -46,16 +71,47 @@ type Config struct {
 	// GasAdjustment is the gas adjustment factor.
 	GasAdjustment float64
 
+	// MaxGas caps the gas limit computed for "auto" gas.
+	MaxGas uint64
+
 	// BroadcastMode is the default broadcast mode.
 	BroadcastMode string
 
+	// SignMode is the default signing mode passed as --sign-mode; empty
+	// leaves sekaid's default (direct).
+	SignMode string
+
 	// Output is the default output format.
 	Output string
+
+	// Height runs queries against the state at this block height; 0 means
+	// the latest block.
+	Height int64
+
+	// SequenceRetry rebroadcasts a transaction with the expected sequence
+	// after an account sequence mismatch.
+	SequenceRetry bool
+
+	// Logger, if set, receives every command run in the container and its
+	// output, with secrets redacted.
+	Logger sdk.Logger
+
+	// TraceDir, if set, receives a file for every failed command with its
+	// full command line, stdout and stderr, with secrets redacted.
+	TraceDir string
 }
 
+// DefaultMaxGas is the default cap for gas estimated with "auto".
+const DefaultMaxGas = 10000000
+
+// maxSequenceRetries caps rebroadcasts after account sequence mismatches,
+// so two clients fighting over one key cannot loop forever.
+const maxSequenceRetries = 1
+
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() *Config {
 	return &Config{
+		Runtime:        DefaultRuntime(),
 		SekaidPath:     "/sekaid",
 		ChainID:        "localnet-1",
 		KeyringBackend: "test",
@@ -64,6 +120,7 @@ func DefaultConfig() *Config {
 		Fees:           "100ukex",
 		Gas:            "200000",
 		GasAdjustment:  1.3,
+		MaxGas:         DefaultMaxGas,
 		BroadcastMode:  "sync",
 		Output:         "json",
 	}
@@ -79,6 +136,28 @@ func WithContainer(container string) Option {
 	}
 }
 
+// WithExecutor sets a custom executor for sekaid commands, e.g. to run
+// them in a Kubernetes pod instead of a container.
+func WithExecutor(e Executor) Option {
+	return func(c *Config) {
+		c.Executor = e
+	}
+}
+
+// WithRuntime sets the container runtime binary ("docker" or "podman").
+func WithRuntime(name string) Option {
+	return func(c *Config) {
+		c.Runtime.Name = name
+	}
+}
+
+// WithHost sets the container daemon address, overriding DOCKER_HOST.
+func WithHost(host string) Option {
+	return func(c *Config) {
+		c.Runtime.Host = host
+	}
+}
+
 // WithSekaidPath sets the sekaid binary path.
 func WithSekaidPath(path string) Option {
 	return func(c *Config) {
@@ -100,6 +179,20 @@ func WithKeyringBackend(backend string) Option {
 	}
 }
 
+// WithKeyringDir sets the keyring directory.
+func WithKeyringDir(dir string) Option {
+	return func(c *Config) {
+		c.KeyringDir = dir
+	}
+}
+
+// WithKeyringPassphrase sets the passphrase of the file keyring backend.
+func WithKeyringPassphrase(passphrase string) Option {
+	return func(c *Config) {
+		c.KeyringPassphrase = passphrase
+	}
+}
+
 // WithHome sets the home directory.
 func WithHome(home string) Option {
 	return func(c *Config) {
@@ -135,6 +228,13 @@ func WithGasAdjustment(adj float64) Option {
 	}
 }
 
+// WithMaxGas sets the cap for gas estimated with "auto".
+func WithMaxGas(maxGas uint64) Option {
+	return func(c *Config) {
+		c.MaxGas = maxGas
+	}
+}
+
 // WithBroadcastMode sets the broadcast mode.
 func WithBroadcastMode(mode string) Option {
 	return func(c *Config) {
@@ -142,6 +242,13 @@ func WithBroadcastMode(mode string) Option {
 	}
 }
 
+// WithSignMode sets the default signing mode of transactions.
+func WithSignMode(mode string) Option {
+	return func(c *Config) {
+		c.SignMode = mode
+	}
+}
+
 // WithOutput sets the output format.
 func WithOutput(output string) Option {
 	return func(c *Config) {
@@ -149,6 +256,35 @@ func WithOutput(output string) Option {
 	}
 }
 
+// WithSequenceRetry enables rebroadcasting a transaction with the sequence
+// the node expected after an account sequence mismatch.
+func WithSequenceRetry(enabled bool) Option {
+	return func(c *Config) {
+		c.SequenceRetry = enabled
+	}
+}
+
+// WithHeight runs queries at a past block height.
+func WithHeight(height int64) Option {
+	return func(c *Config) {
+		c.Height = height
+	}
+}
+
+// WithLogger logs the commands run in the container and their output.
+func WithLogger(logger sdk.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// WithTraceDir saves a trace of every failed command to a file in dir.
+func WithTraceDir(dir string) Option {
+	return func(c *Config) {
+		c.TraceDir = dir
+	}
+}
+
 // NewClient creates a new Docker-based client.
 func NewClient(container string, opts ...Option) (*Client, error) {
 	if container == "" {
@@ -196,6 +332,38 @@ func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, e
 		return nil, fmt.Errorf("transaction request is required")
 	}
 
+	// Resolve "auto" gas by simulating first
+	if c.txGas(req) == "auto" {
+		estimated, err := c.estimateGas(ctx, req)
+		if err != nil {
+			return nil, sdk.ParseInsufficientFunds(err)
+		}
+		req = estimated
+	}
+
+	resp, err := c.broadcastTx(ctx, req)
+
+	// Transactions sent back to back from one key can race for the same
+	// sequence; sekaid reports the one it expected, so sign again with it.
+	// An explicit --sequence is never overridden.
+	for retry := 0; c.config.SequenceRetry && retry < maxSequenceRetries && req.Flags["sequence"] == ""; retry++ {
+		seq, ok := sdk.ExpectedSequence(err)
+		if !ok || errors.Is(err, sdk.ErrUnauthorized) {
+			break
+		}
+		retried := *req
+		retried.Flags = make(map[string]string, len(req.Flags)+1)
+		for k, v := range req.Flags {
+			retried.Flags[k] = v
+		}
+		retried.Flags["sequence"] = strconv.FormatUint(seq, 10)
+		resp, err = c.broadcastTx(ctx, &retried)
+	}
+	return resp, sdk.ParseInsufficientFunds(err)
+}
+
+// broadcastTx signs and broadcasts req once.
+func (c *Client) broadcastTx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
 	// Build transaction command
 	args := c.buildTxArgs(req)
 
@@ -225,14 +393,260 @@ func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, e
 	return &txResp, nil
 }
 
+// Simulate runs the transaction with --dry-run and returns the gas estimate.
+func (c *Client) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("transaction request is required")
+	}
+
+	args := append(c.buildTxArgs(req), "--dry-run")
+
+	result, err := c.exec(ctx, args...)
+	if err != nil {
+		return nil, sdk.WrapTxError(req.Module, req.Action, err)
+	}
+
+	gas, err := ParseGasEstimate(result.Stdout + "\n" + result.Stderr)
+	if err != nil {
+		return nil, &sdk.TxError{
+			Module: req.Module,
+			Action: req.Action,
+			RawLog: result.Stdout,
+			Err:    err,
+		}
+	}
+
+	fees := req.Flags["fees"]
+	if fees == "" {
+		fees = c.config.Fees
+	}
+	return sdk.NewSimulateResponse(gas, c.txGasAdjustment(req), fees), nil
+}
+
+// estimateGas simulates req and returns a copy with the "auto" gas replaced
+// by ceil(estimate * gas adjustment). It fails rather than broadcasting
+// when simulation fails or the result exceeds MaxGas.
+func (c *Client) estimateGas(ctx context.Context, req *sdk.TxRequest) (*sdk.TxRequest, error) {
+	sim, err := c.Simulate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("gas estimation failed: %w", err)
+	}
+	if c.config.MaxGas > 0 && sim.GasLimit > c.config.MaxGas {
+		return nil, &sdk.TxError{
+			Module: req.Module,
+			Action: req.Action,
+			Err:    fmt.Errorf("estimated gas %d exceeds maximum %d; set --gas explicitly to override", sim.GasLimit, c.config.MaxGas),
+		}
+	}
+
+	estimated := *req
+	estimated.Flags = make(map[string]string, len(req.Flags)+1)
+	for k, v := range req.Flags {
+		estimated.Flags[k] = v
+	}
+	estimated.Flags["gas"] = strconv.FormatUint(sim.GasLimit, 10)
+	return &estimated, nil
+}
+
+// GenerateTx builds the transaction with --generate-only and returns the
+// unsigned transaction JSON.
+func (c *Client) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	if req == nil {
+		return nil, fmt.Errorf("transaction request is required")
+	}
+
+	if c.txGas(req) == "auto" {
+		estimated, err := c.estimateGas(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		req = estimated
+	}
+
+	args := append(c.buildTxArgs(req), "--generate-only")
+
+	result, err := c.exec(ctx, args...)
+	if err != nil {
+		return nil, sdk.WrapTxError(req.Module, req.Action, err)
+	}
+	if !json.Valid([]byte(result.Stdout)) {
+		return nil, &sdk.TxError{
+			Module: req.Module,
+			Action: req.Action,
+			RawLog: result.Stdout,
+			Err:    fmt.Errorf("failed to parse unsigned transaction"),
+		}
+	}
+	return []byte(result.Stdout), nil
+}
+
+// SignTx signs a transaction with sekaid tx sign. The transaction is
+// passed on stdin so no file needs to exist inside the container.
+func (c *Client) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	if opts == nil || opts.Signer == "" {
+		return nil, fmt.Errorf("signer is required")
+	}
+
+	args := append([]string{"--from", opts.Signer, "--output", "json"}, c.keyringArgs()...)
+	if opts.Offline() {
+		args = append(args, "--offline")
+	} else {
+		args = append(args, "--node", c.config.Node)
+	}
+	if opts.AccountNumber != "" {
+		args = append(args, "--account-number", opts.AccountNumber)
+	}
+	if opts.Sequence != "" {
+		args = append(args, "--sequence", opts.Sequence)
+	}
+	signMode := opts.SignMode
+	if signMode == "" {
+		signMode = c.config.SignMode
+	}
+	if signMode != "" {
+		args = append(args, "--sign-mode", signMode)
+	}
+	if c.config.ChainID != "" {
+		args = append(args, "--chain-id", c.config.ChainID)
+	}
+	if c.config.Home != "" {
+		args = append(args, "--home", c.config.Home)
+	}
+
+	result, err := c.sign(ctx, tx, args...)
+	if err != nil {
+		return nil, sdk.WrapTxError("tx", "sign", err)
+	}
+	if !json.Valid([]byte(result.Stdout)) {
+		return nil, &sdk.TxError{
+			Module: "tx",
+			Action: "sign",
+			RawLog: result.Stdout,
+			Err:    fmt.Errorf("failed to parse signed transaction"),
+		}
+	}
+	return []byte(result.Stdout), nil
+}
+
+// sekaidBroadcastMode maps a broadcast mode to one sekaid accepts. Newer
+// Cosmos SDK releases removed "block", so it is broadcast as "sync" and
+// callers wait for inclusion themselves.
+func sekaidBroadcastMode(mode string) string {
+	if mode == "block" {
+		return "sync"
+	}
+	return mode
+}
+
+// BroadcastTx submits a signed transaction with sekaid tx broadcast.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	if mode == "" {
+		mode = c.config.BroadcastMode
+	}
+
+	args := []string{"tx", "broadcast", "/dev/stdin",
+		"--output", "json",
+		"--node", c.config.Node,
+	}
+	if mode != "" {
+		args = append(args, "--broadcast-mode", sekaidBroadcastMode(mode))
+	}
+	if c.config.ChainID != "" {
+		args = append(args, "--chain-id", c.config.ChainID)
+	}
+
+	result, err := c.execWithInput(ctx, string(tx), args...)
+	if err != nil {
+		return nil, sdk.ParseInsufficientFunds(sdk.WrapTxError("tx", "broadcast", err))
+	}
+
+	var txResp sdk.TxResponse
+	if err := json.Unmarshal([]byte(result.Stdout), &txResp); err != nil {
+		return nil, &sdk.TxError{
+			Module: "tx",
+			Action: "broadcast",
+			RawLog: result.Stdout,
+			Err:    fmt.Errorf("failed to parse response: %w", err),
+		}
+	}
+	if txResp.Code != 0 {
+		return &txResp, sdk.ParseInsufficientFunds(sdk.NewTxErrorFromResponse("tx", "broadcast", &txResp))
+	}
+	return &txResp, nil
+}
+
+// EncodeTx encodes a transaction JSON with sekaid tx encode. The JSON is
+// passed on stdin like in SignTx.
+func (c *Client) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	if err := sdk.ValidateTxJSON(tx); err != nil {
+		return "", err
+	}
+
+	result, err := c.execWithInput(ctx, string(tx), "tx", "encode", "/dev/stdin")
+	if err != nil {
+		return "", sdk.WrapTxError("tx", "encode", err)
+	}
+	encoded, err := sdk.ValidateTxBytes(result.Stdout)
+	if err != nil {
+		return "", &sdk.TxError{
+			Module: "tx",
+			Action: "encode",
+			RawLog: result.Stdout,
+			Err:    fmt.Errorf("failed to parse encoded transaction: %w", err),
+		}
+	}
+	return encoded, nil
+}
+
+// DecodeTx decodes base64 transaction bytes with sekaid tx decode.
+func (c *Client) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	txBytes, err := sdk.ValidateTxBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.exec(ctx, "tx", "decode", txBytes, "--output", "json")
+	if err != nil {
+		return nil, sdk.WrapTxError("tx", "decode", err)
+	}
+	if !json.Valid([]byte(result.Stdout)) {
+		return nil, &sdk.TxError{
+			Module: "tx",
+			Action: "decode",
+			RawLog: result.Stdout,
+			Err:    fmt.Errorf("failed to parse decoded transaction"),
+		}
+	}
+	return []byte(result.Stdout), nil
+}
+
+// txGas returns the gas setting for a transaction (request flag or default).
+func (c *Client) txGas(req *sdk.TxRequest) string {
+	if gas, ok := req.Flags["gas"]; ok && gas != "" {
+		return gas
+	}
+	return c.config.Gas
+}
+
+// txGasAdjustment returns the gas adjustment for a transaction
+// (request flag or default).
+func (c *Client) txGasAdjustment(req *sdk.TxRequest) float64 {
+	if v, ok := req.Flags["gas-adjustment"]; ok {
+		if adj, err := strconv.ParseFloat(v, 64); err == nil && adj > 0 {
+			return adj
+		}
+	}
+	return c.config.GasAdjustment
+}
+
 // Keys returns the keyring client.
 func (c *Client) Keys() sdk.KeysClient {
 	return c.keys
 }
 
-// Status returns the node status.
+// Status returns the status of the configured node.
 func (c *Client) Status(ctx context.Context) (*sdk.StatusResponse, error) {
-	result, err := c.exec(ctx, "status", "--home", c.config.Home)
+	result, err := c.exec(ctx, "status", "--node", c.config.Node, "--home", c.config.Home)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get status: %w", err)
 	}
@@ -284,6 +698,41 @@ func (c *Client) Status(ctx context.Context) (*sdk.StatusResponse, error) {
 	}, nil
 }
 
+// AppVersion returns the version of the sekaid binary in the container.
+// Older releases print it on stderr.
+func (c *Client) AppVersion(ctx context.Context) (string, error) {
+	result, err := c.exec(ctx, "version")
+	if err != nil {
+		return "", fmt.Errorf("failed to get sekaid version: %w", err)
+	}
+	version := strings.TrimSpace(result.Stdout)
+	if version == "" {
+		version = strings.TrimSpace(result.Stderr)
+	}
+	if version == "" {
+		return "", fmt.Errorf("sekaid version printed nothing")
+	}
+	return version, nil
+}
+
+// netInfoScript fetches net_info from the node's RPC endpoint ($1) with
+// whichever of curl or wget the container has.
+const netInfoScript = `curl -fsS "$1/net_info" 2>/dev/null || wget -qO- "$1/net_info"`
+
+// NetInfo returns the peer connections of the node, read from its RPC
+// net_info endpoint inside the container.
+func (c *Client) NetInfo(ctx context.Context) (*sdk.NetInfo, error) {
+	rpc := c.config.Node
+	if strings.HasPrefix(rpc, "tcp://") {
+		rpc = "http://" + strings.TrimPrefix(rpc, "tcp://")
+	}
+	result, err := c.execShellWithInput(ctx, "", netInfoScript, strings.TrimSuffix(rpc, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get net_info: %w", err)
+	}
+	return sdk.ParseNetInfo([]byte(result.Stdout))
+}
+
 // Close releases resources (no-op for Docker client).
 func (c *Client) Close() error {
 	return nil
@@ -309,10 +758,11 @@ func (c *Client) buildQueryArgs(req *sdk.QueryRequest) []string {
 	// Add raw args if provided
 	args = append(args, req.RawArgs...)
 
-	// Add params as flags
+	// Add params as flags. The --key=value form also works for boolean
+	// flags such as --count-total.
 	for key, value := range req.Params {
 		if value != "" {
-			args = append(args, "--"+key, value)
+			args = append(args, "--"+key+"="+value)
 		}
 	}
 
@@ -326,6 +776,9 @@ func (c *Client) buildQueryArgs(req *sdk.QueryRequest) []string {
 	if c.config.ChainID != "" {
 		args = append(args, "--chain-id", c.config.ChainID)
 	}
+	if c.config.Height > 0 {
+		args = append(args, "--height", strconv.FormatInt(c.config.Height, 10))
+	}
 
 	return args
 }
@@ -381,8 +834,8 @@ func (c *Client) buildTxArgs(req *sdk.TxRequest) []string {
 	args = append(args,
 		"--output", "json",
 		"--node", c.config.Node,
-		"--keyring-backend", c.config.KeyringBackend,
 	)
+	args = append(args, c.keyringArgs()...)
 
 	if c.config.ChainID != "" {
 		args = append(args, "--chain-id", c.config.ChainID)
@@ -407,13 +860,18 @@ func (c *Client) buildTxArgs(req *sdk.TxRequest) []string {
 		args = append(args, "--gas", c.config.Gas)
 	}
 
+	// Add sign mode if not already specified
+	if _, ok := req.Flags["sign-mode"]; !ok && c.config.SignMode != "" {
+		args = append(args, "--sign-mode", c.config.SignMode)
+	}
+
 	// Set broadcast mode
 	mode := req.BroadcastMode
 	if mode == "" {
 		mode = c.config.BroadcastMode
 	}
 	if mode != "" {
-		args = append(args, "--broadcast-mode", mode)
+		args = append(args, "--broadcast-mode", sekaidBroadcastMode(mode))
 	}
 
 	// Skip confirmation
@@ -434,9 +892,183 @@ type ExecResult struct {
 	ExitCode int
 }
 
-// exec executes a sekaid command in the Docker container.
+// exec executes a sekaid command in the Docker container. Commands that
+// open the keyring get the keyring passphrase on stdin.
 func (c *Client) exec(ctx context.Context, args ...string) (*ExecResult, error) {
-	return execCommand(ctx, c.config.Container, c.config.SekaidPath, args...)
+	if input := c.keyringInput(); input != "" && usesKeyring(args) {
+		return c.execWithInput(ctx, input, args...)
+	}
+	c.logCommand(c.config.SekaidPath, args, "")
+	var result *ExecResult
+	var err error
+	if c.config.Executor != nil {
+		result, err = c.config.Executor.Exec(ctx, c.config.SekaidPath, args...)
+	} else {
+		var rt *preparedRuntime
+		if rt, err = c.runtime(); err == nil {
+			result, err = execCommand(ctx, rt, c.config.Container, c.config.SekaidPath, args...)
+		}
+	}
+	c.logResult(args, result, err)
+	c.traceFailure(c.config.SekaidPath, args, "", result, err)
+	return result, err
+}
+
+// execWithInput executes a sekaid command with input on stdin.
+func (c *Client) execWithInput(ctx context.Context, input string, args ...string) (*ExecResult, error) {
+	c.logCommand(c.config.SekaidPath, args, input)
+	var result *ExecResult
+	var err error
+	if c.config.Executor != nil {
+		result, err = c.config.Executor.ExecWithInput(ctx, c.config.SekaidPath, input, args...)
+	} else {
+		var rt *preparedRuntime
+		if rt, err = c.runtime(); err == nil {
+			result, err = execCommandWithInput(ctx, rt, c.config.Container, c.config.SekaidPath, input, args...)
+		}
+	}
+	c.logResult(args, result, err)
+	c.traceFailure(c.config.SekaidPath, args, input, result, err)
+	return result, err
+}
+
+// execShellWithInput runs a shell script in the container with input on
+// stdin. The script gets the sekaid path as $0 and args as $1, $2, ...
+func (c *Client) execShellWithInput(ctx context.Context, input, script string, args ...string) (*ExecResult, error) {
+	shArgs := append([]string{"-c", script, c.config.SekaidPath}, args...)
+	c.logCommand("sh", shArgs, input)
+	var result *ExecResult
+	var err error
+	if c.config.Executor != nil {
+		result, err = c.config.Executor.ExecWithInput(ctx, "sh", input, shArgs...)
+	} else {
+		var rt *preparedRuntime
+		if rt, err = c.runtime(); err == nil {
+			result, err = execCommandWithInput(ctx, rt, c.config.Container, "sh", input, shArgs...)
+		}
+	}
+	c.logResult(shArgs, result, err)
+	c.traceFailure("sh", shArgs, input, result, err)
+	return result, err
+}
+
+// keyringArgs returns the flags selecting the keyring.
+func (c *Client) keyringArgs() []string {
+	args := []string{"--keyring-backend", c.config.KeyringBackend}
+	if c.config.KeyringDir != "" {
+		args = append(args, "--keyring-dir", c.config.KeyringDir)
+	}
+	return args
+}
+
+// keyringInput returns the stdin that unlocks the keyring, or "" if sekaid
+// will not prompt for a passphrase. The file backend asks for it once, and
+// a second time when it creates the keyring; an unread line is harmless.
+func (c *Client) keyringInput() string {
+	if c.config.KeyringBackend != "file" || c.config.KeyringPassphrase == "" {
+		return ""
+	}
+	return strings.Repeat(c.config.KeyringPassphrase+"\n", 2)
+}
+
+// usesKeyring reports whether a command opens the keyring.
+func usesKeyring(args []string) bool {
+	for _, arg := range args {
+		if arg == "--keyring-backend" {
+			return true
+		}
+	}
+	return false
+}
+
+// signScript writes the transaction ($1) to a temporary file and signs it
+// with sekaid ($0) and the remaining args, so that stdin is left for the
+// keyring passphrase.
+const signScript = `f=$(mktemp) || exit 1
+printf '%s' "$1" > "$f"
+shift
+"$0" tx sign "$f" "$@"
+rc=$?
+rm -f "$f"
+exit $rc`
+
+// sign runs sekaid tx sign on tx with args. The transaction is passed on
+// stdin, or through a temporary file when stdin carries the keyring
+// passphrase.
+func (c *Client) sign(ctx context.Context, tx []byte, args ...string) (*ExecResult, error) {
+	if input := c.keyringInput(); input != "" {
+		return c.execShellWithInput(ctx, input, signScript, append([]string{string(tx)}, args...)...)
+	}
+	return c.execWithInput(ctx, string(tx), append([]string{"tx", "sign", "/dev/stdin"}, args...)...)
+}
+
+// runtime returns the container runtime resolved once per client, so
+// that scenarios running many commands skip the PATH lookup each time.
+func (c *Client) runtime() (*preparedRuntime, error) {
+	c.prepareOnce.Do(func() {
+		c.prepared, c.prepareErr = c.config.Runtime.prepare()
+	})
+	return c.prepared, c.prepareErr
+}
+
+// logCommand logs a command about to run, with secret flag values
+// redacted. Input on stdin carries passphrases and mnemonics, so only its
+// size is logged.
+func (c *Client) logCommand(binary string, args []string, input string) {
+	if c.config.Logger == nil {
+		return
+	}
+	c.config.Logger.Printf("exec: %s", c.commandLine(binary, args))
+	if input != "" {
+		c.config.Logger.Printf("stdin: [REDACTED] (%d bytes)", len(input))
+	}
+}
+
+// commandLine returns a command as it would be typed in a shell, with
+// secret flag values and armored keys passed as arguments redacted.
+func (c *Client) commandLine(binary string, args []string) string {
+	prefix := []string{binary}
+	if c.config.Executor == nil {
+		prefix = []string{c.config.Runtime.binary(), "exec", c.config.Container, binary}
+	}
+	words := make([]string, 0, len(prefix)+len(args))
+	for _, arg := range append(prefix, sdk.RedactArgs(args)...) {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"") {
+			arg = strconv.Quote(arg)
+		}
+		words = append(words, arg)
+	}
+	return sdk.RedactText(strings.Join(words, " "))
+}
+
+// logResult logs the output of a command with secrets redacted. The
+// stdout of a command printing a secret, such as a hex key export, is
+// only logged by size.
+func (c *Client) logResult(args []string, result *ExecResult, err error) {
+	if c.config.Logger == nil {
+		return
+	}
+	if result != nil {
+		c.config.Logger.Printf("exit code: %d", result.ExitCode)
+		if result.Stdout != "" {
+			c.config.Logger.Printf("stdout: %s", redactStdout(args, result.Stdout))
+		}
+		if result.Stderr != "" {
+			c.config.Logger.Printf("stderr: %s", sdk.RedactText(result.Stderr))
+		}
+	}
+	if err != nil {
+		c.config.Logger.Printf("error: %s", sdk.RedactText(err.Error()))
+	}
+}
+
+// redactStdout returns the stdout of a command for logging, with secrets
+// redacted.
+func redactStdout(args []string, stdout string) string {
+	if sdk.HasSecretOutput(args) {
+		return fmt.Sprintf("[REDACTED] (%d bytes)", len(stdout))
+	}
+	return sdk.RedactText(stdout)
 }
 
 // RawExec executes a raw sekaid command and returns the output.
@@ -455,8 +1087,8 @@ func (c *Client) RawExecWithFlags(ctx context.Context, args ...string) (string,
 	args = append(args,
 		"--output", "json",
 		"--node", c.config.Node,
-		"--keyring-backend", c.config.KeyringBackend,
 	)
+	args = append(args, c.keyringArgs()...)
 
 	if c.config.ChainID != "" {
 		args = append(args, "--chain-id", c.config.ChainID)
@@ -493,13 +1125,19 @@ func (k *keysClient) Add(ctx context.Context, name string, opts *sdk.KeyAddOptio
 		if opts.Index > 0 {
 			args = append(args, "--index", fmt.Sprintf("%d", opts.Index))
 		}
+		if len(opts.Multisig) > 0 {
+			args = append(args,
+				"--multisig", strings.Join(opts.Multisig, ","),
+				"--multisig-threshold", fmt.Sprintf("%d", opts.MultisigThreshold),
+			)
+		}
 	}
 
 	args = append(args,
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 		"--output", "json",
 	)
+	args = append(args, k.client.keyringArgs()...)
 
 	// For recovery, we need to handle mnemonic input differently
 	// This is a simplified implementation
@@ -525,9 +1163,9 @@ func (k *keysClient) Delete(ctx context.Context, name string, force bool) error
 	}
 
 	args = append(args,
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 	)
+	args = append(args, k.client.keyringArgs()...)
 
 	_, err := k.client.exec(ctx, args...)
 	if err != nil {
@@ -539,15 +1177,15 @@ func (k *keysClient) Delete(ctx context.Context, name string, force bool) error
 
 func (k *keysClient) List(ctx context.Context) ([]sdk.KeyInfo, error) {
 	args := []string{"keys", "list",
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 		"--output", "json",
 	}
+	args = append(args, k.client.keyringArgs()...)
 
 	result, err := k.client.exec(ctx, args...)
 	if err != nil {
 		// Check if it's just "no keys found" message
-		if strings.Contains(result.Stderr, "No records") || strings.Contains(result.Stdout, "No records") {
+		if result != nil && (strings.Contains(result.Stderr, "No records") || strings.Contains(result.Stdout, "No records")) {
 			return []sdk.KeyInfo{}, nil
 		}
 		return nil, fmt.Errorf("failed to list keys: %w", err)
@@ -569,10 +1207,10 @@ func (k *keysClient) List(ctx context.Context) ([]sdk.KeyInfo, error) {
 
 func (k *keysClient) Show(ctx context.Context, name string) (*sdk.KeyInfo, error) {
 	args := []string{"keys", "show", name,
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 		"--output", "json",
 	}
+	args = append(args, k.client.keyringArgs()...)
 
 	result, err := k.client.exec(ctx, args...)
 	if err != nil {
@@ -594,12 +1232,37 @@ func (k *keysClient) Show(ctx context.Context, name string) (*sdk.KeyInfo, error
 	return &keyInfo, nil
 }
 
+// Export exports the key as unarmored hex, which Import accepts back.
 func (k *keysClient) Export(ctx context.Context, name string) (string, error) {
+	return k.ExportHex(ctx, name)
+}
+
+func (k *keysClient) ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error) {
+	args := []string{"keys", "export", name,
+		"--home", k.client.config.Home,
+	}
+	args = append(args, k.client.keyringArgs()...)
+
+	// sekaid reads the passphrase from stdin, then the keyring's, and
+	// prints the armor to stderr
+	result, err := k.client.execWithInput(ctx, passphrase+"\n"+k.client.keyringInput(), args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to export key: %w", err)
+	}
+
+	armor := extractArmor(result.Stdout + "\n" + result.Stderr)
+	if armor == "" {
+		return "", fmt.Errorf("failed to export key: no armored key in sekaid output")
+	}
+	return armor, nil
+}
+
+func (k *keysClient) ExportHex(ctx context.Context, name string) (string, error) {
 	args := []string{"keys", "export", name,
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 		"--unarmored-hex", "--unsafe",
 	}
+	args = append(args, k.client.keyringArgs()...)
 
 	result, err := k.client.exec(ctx, args...)
 	if err != nil {
@@ -609,17 +1272,60 @@ func (k *keysClient) Export(ctx context.Context, name string) (string, error) {
 	return strings.TrimSpace(result.Stdout), nil
 }
 
+// importScript writes the armor ($1) to a temporary file and imports it as
+// key $2 with sekaid ($0) and the remaining args, so that stdin is left for
+// the passphrase.
+const importScript = `f=$(mktemp) || exit 1
+printf '%s\n' "$1" > "$f"
+name=$2
+shift 2
+"$0" keys import "$name" "$f" "$@"
+rc=$?
+rm -f "$f"
+exit $rc`
+
+// Import imports a key exported by Export. An ASCII-armored key is
+// encrypted and needs ImportWithPassphrase.
 func (k *keysClient) Import(ctx context.Context, name, armor string) error {
-	// Key import typically requires file input
-	// This is a simplified implementation
-	return fmt.Errorf("import not implemented - requires file input")
+	if extractArmor(armor) != "" {
+		return fmt.Errorf("failed to import key: armored key needs a passphrase")
+	}
+	return k.ImportHex(ctx, name, strings.TrimSpace(armor), "")
+}
+
+func (k *keysClient) ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error {
+	args := append([]string{armor, name, "--home", k.client.config.Home}, k.client.keyringArgs()...)
+	_, err := k.client.execShellWithInput(ctx, passphrase+"\n"+k.client.keyringInput(), importScript, args...)
+	if err != nil {
+		return fmt.Errorf("failed to import key: %w", err)
+	}
+	return nil
+}
+
+// extractArmor returns the ASCII-armored block in output, or "" if none.
+func extractArmor(output string) string {
+	start := strings.Index(output, "-----BEGIN ")
+	if start < 0 {
+		return ""
+	}
+	end := strings.Index(output[start:], "-----END ")
+	if end < 0 {
+		return ""
+	}
+	end += start
+	if nl := strings.IndexByte(output[end:], '\n'); nl >= 0 {
+		end += nl
+	} else {
+		end = len(output)
+	}
+	return strings.TrimSpace(output[start:end])
 }
 
 func (k *keysClient) Rename(ctx context.Context, oldName, newName string) error {
 	args := []string{"keys", "rename", oldName, newName, "--yes",
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 	}
+	args = append(args, k.client.keyringArgs()...)
 
 	_, err := k.client.exec(ctx, args...)
 	if err != nil {
@@ -636,9 +1342,9 @@ func (k *keysClient) Mnemonic(ctx context.Context, name string) (string, error)
 
 func (k *keysClient) ImportHex(ctx context.Context, name, hexKey, keyType string) error {
 	args := []string{"keys", "import-hex", name, hexKey,
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 	}
+	args = append(args, k.client.keyringArgs()...)
 	if keyType != "" {
 		args = append(args, "--key-type", keyType)
 	}
@@ -648,9 +1354,9 @@ func (k *keysClient) ImportHex(ctx context.Context, name, hexKey, keyType string
 
 func (k *keysClient) ListKeyTypes(ctx context.Context) ([]string, error) {
 	args := []string{"keys", "list-key-types",
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 	}
+	args = append(args, k.client.keyringArgs()...)
 	result, err := k.client.exec(ctx, args...)
 	if err != nil {
 		return nil, err
@@ -667,13 +1373,83 @@ func (k *keysClient) ListKeyTypes(ctx context.Context) ([]string, error) {
 
 func (k *keysClient) Migrate(ctx context.Context) error {
 	args := []string{"keys", "migrate",
-		"--keyring-backend", k.client.config.KeyringBackend,
 		"--home", k.client.config.Home,
 	}
+	args = append(args, k.client.keyringArgs()...)
 	_, err := k.client.exec(ctx, args...)
 	return err
 }
 
+// msgSignDataType is the type of the ADR-036 MsgSignData message; its
+// amino name "sign/MsgSignData" is what ends up in the sign bytes.
+const msgSignDataType = "/sign.MsgSignData"
+
+// SignMessage has sekaid sign a transaction holding a single MsgSignData
+// offline in amino JSON mode with an empty chain ID, zero account number
+// and sequence, which gives the ADR-036 sign document. The key stays in
+// the keyring; only the signature and public key are read back.
+func (k *keysClient) SignMessage(ctx context.Context, name, signer string, data []byte) ([]byte, []byte, error) {
+	unsigned, err := json.Marshal(map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": []map[string]string{{
+				"@type":  msgSignDataType,
+				"signer": signer,
+				"data":   base64.StdEncoding.EncodeToString(data),
+			}},
+			"memo":                           "",
+			"timeout_height":                 "0",
+			"extension_options":              []struct{}{},
+			"non_critical_extension_options": []struct{}{},
+		},
+		"auth_info": map[string]interface{}{
+			"signer_infos": []struct{}{},
+			"fee":          map[string]interface{}{"amount": []struct{}{}, "gas_limit": "0", "payer": "", "granter": ""},
+		},
+		"signatures": []string{},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args := []string{"--from", name,
+		"--offline",
+		"--account-number", "0",
+		"--sequence", "0",
+		"--chain-id", "",
+		"--sign-mode", sdk.SignModeAminoJSON,
+		"--output", "json",
+		"--home", k.client.config.Home,
+	}
+	args = append(args, k.client.keyringArgs()...)
+	result, err := k.client.sign(ctx, unsigned, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign message with key %s: %w", name, err)
+	}
+
+	var signed struct {
+		AuthInfo struct {
+			SignerInfos []struct {
+				PublicKey struct {
+					Type string `json:"@type"`
+					Key  []byte `json:"key"`
+				} `json:"public_key"`
+			} `json:"signer_infos"`
+		} `json:"auth_info"`
+		Signatures [][]byte `json:"signatures"`
+	}
+	if err := json.Unmarshal([]byte(result.Stdout), &signed); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse signed message: %w", err)
+	}
+	if len(signed.Signatures) != 1 || len(signed.AuthInfo.SignerInfos) != 1 {
+		return nil, nil, fmt.Errorf("failed to parse signed message: expected one signature")
+	}
+	pub := signed.AuthInfo.SignerInfos[0].PublicKey
+	if !strings.HasSuffix(pub.Type, "secp256k1.PubKey") {
+		return nil, nil, fmt.Errorf("key %s is not a secp256k1 key that can sign messages", name)
+	}
+	return signed.Signatures[0], pub.Key, nil
+}
+
 func (k *keysClient) Parse(ctx context.Context, address string) (*sdk.ParsedAddress, error) {
 	args := []string{"keys", "parse", address, "--output", "json"}
 	execResult, err := k.client.exec(ctx, args...)
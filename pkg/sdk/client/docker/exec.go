@@ -4,19 +4,115 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
 
-// execCommand executes a command in a Docker container.
-func execCommand(ctx context.Context, container, binary string, args ...string) (*ExecResult, error) {
-	// Build docker exec command
+// Executor runs a binary with arguments in the environment that hosts
+// sekaid and returns its output. Failed commands return the result along
+// with an *sdk.ExecutionError.
+type Executor interface {
+	// Exec runs binary with args.
+	Exec(ctx context.Context, binary string, args ...string) (*ExecResult, error)
+
+	// ExecWithInput runs binary with args, passing input on stdin.
+	ExecWithInput(ctx context.Context, binary, input string, args ...string) (*ExecResult, error)
+}
+
+// Supported container runtimes.
+const (
+	RuntimeDocker = "docker"
+	RuntimePodman = "podman"
+)
+
+// Runtime selects the container runtime binary and the daemon it talks to.
+type Runtime struct {
+	// Name is the runtime binary: "docker" (default) or "podman".
+	Name string
+
+	// Host is the daemon address (e.g., ssh://user@host or
+	// unix:///run/podman/podman.sock). Empty uses DOCKER_HOST, then the
+	// runtime's own default.
+	Host string
+}
+
+// DefaultRuntime returns the local docker runtime.
+func DefaultRuntime() Runtime {
+	return Runtime{Name: RuntimeDocker}
+}
+
+// binary returns the runtime binary name.
+func (r Runtime) binary() string {
+	if r.Name == "" {
+		return RuntimeDocker
+	}
+	return r.Name
+}
+
+// host returns the daemon address, falling back to DOCKER_HOST.
+func (r Runtime) host() string {
+	if r.Host != "" {
+		return r.Host
+	}
+	return os.Getenv("DOCKER_HOST")
+}
+
+// command builds a runtime command targeting the selected daemon.
+// It fails if the runtime binary is not on PATH.
+func (r Runtime) command(ctx context.Context, args ...string) (*exec.Cmd, error) {
+	p, err := r.prepare()
+	if err != nil {
+		return nil, err
+	}
+	return p.command(ctx, args...), nil
+}
+
+// preparedRuntime is a runtime with its binary path and daemon arguments
+// resolved, so that clients running many commands look them up only once.
+type preparedRuntime struct {
+	path string
+	args []string
+}
+
+// prepare resolves the runtime binary on PATH and the daemon arguments.
+func (r Runtime) prepare() (*preparedRuntime, error) {
+	bin := r.binary()
+	if bin != RuntimeDocker && bin != RuntimePodman {
+		return nil, fmt.Errorf("unsupported container runtime %q (use docker or podman)", bin)
+	}
+	path, err := exec.LookPath(bin)
+	if err != nil {
+		return nil, fmt.Errorf("container runtime %q not found in PATH: %w", bin, err)
+	}
+
+	var runtimeArgs []string
+	if host := r.host(); host != "" {
+		if bin == RuntimePodman {
+			runtimeArgs = append(runtimeArgs, "--remote", "--url", host)
+		} else {
+			runtimeArgs = append(runtimeArgs, "--host", host)
+		}
+	}
+	return &preparedRuntime{path: path, args: runtimeArgs}, nil
+}
+
+// command builds a runtime command. It is safe for concurrent use.
+func (p *preparedRuntime) command(ctx context.Context, args ...string) *exec.Cmd {
+	cmdArgs := make([]string, 0, len(p.args)+len(args))
+	cmdArgs = append(append(cmdArgs, p.args...), args...)
+	return exec.CommandContext(ctx, p.path, cmdArgs...)
+}
+
+// execCommand executes a command in a container.
+func execCommand(ctx context.Context, rt *preparedRuntime, container, binary string, args ...string) (*ExecResult, error) {
+	// Build exec command
 	dockerArgs := []string{"exec", container, binary}
 	dockerArgs = append(dockerArgs, args...)
 
-	cmd := exec.CommandContext(ctx, "docker", dockerArgs...)
+	cmd := rt.command(ctx, dockerArgs...)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -64,12 +160,12 @@ func execCommand(ctx context.Context, container, binary string, args ...string)
 }
 
 // execCommandWithInput executes a command with stdin input.
-func execCommandWithInput(ctx context.Context, container, binary string, input string, args ...string) (*ExecResult, error) {
-	// Build docker exec command with interactive flag for stdin
+func execCommandWithInput(ctx context.Context, rt *preparedRuntime, container, binary string, input string, args ...string) (*ExecResult, error) {
+	// Build exec command with interactive flag for stdin
 	dockerArgs := []string{"exec", "-i", container, binary}
 	dockerArgs = append(dockerArgs, args...)
 
-	cmd := exec.CommandContext(ctx, "docker", dockerArgs...)
+	cmd := rt.command(ctx, dockerArgs...)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdin = strings.NewReader(input)
@@ -104,15 +200,47 @@ func execCommandWithInput(ctx context.Context, container, binary string, input s
 
 // IsDockerAvailable checks if Docker is available and running.
 func IsDockerAvailable() bool {
-	cmd := exec.Command("docker", "info")
-	err := cmd.Run()
-	return err == nil
+	return DefaultRuntime().IsAvailable()
 }
 
 // IsContainerRunning checks if a specific container is running.
 func IsContainerRunning(container string) bool {
-	cmd := exec.Command("docker", "inspect", "-f", "{{.State.Running}}", container)
-	output, err := cmd.Output()
+	return DefaultRuntime().IsContainerRunning(container)
+}
+
+// GetContainerID returns the full container ID for a container name.
+func GetContainerID(container string) (string, error) {
+	return DefaultRuntime().GetContainerID(container)
+}
+
+// ListContainers returns a list of running container names.
+func ListContainers() ([]string, error) {
+	return DefaultRuntime().ListContainers()
+}
+
+// FindSekaiContainer attempts to find a SEKAI container by common naming patterns.
+func FindSekaiContainer() (string, error) {
+	return DefaultRuntime().FindSekaiContainer()
+}
+
+// output runs a runtime command and returns its stdout.
+func (r Runtime) output(args ...string) ([]byte, error) {
+	cmd, err := r.command(context.Background(), args...)
+	if err != nil {
+		return nil, err
+	}
+	return cmd.Output()
+}
+
+// IsAvailable checks if the runtime is installed and its daemon reachable.
+func (r Runtime) IsAvailable() bool {
+	_, err := r.output("info")
+	return err == nil
+}
+
+// IsContainerRunning checks if a specific container is running.
+func (r Runtime) IsContainerRunning(container string) bool {
+	output, err := r.output("inspect", "-f", "{{.State.Running}}", container)
 	if err != nil {
 		return false
 	}
@@ -120,9 +248,8 @@ func IsContainerRunning(container string) bool {
 }
 
 // GetContainerID returns the full container ID for a container name.
-func GetContainerID(container string) (string, error) {
-	cmd := exec.Command("docker", "inspect", "-f", "{{.Id}}", container)
-	output, err := cmd.Output()
+func (r Runtime) GetContainerID(container string) (string, error) {
+	output, err := r.output("inspect", "-f", "{{.Id}}", container)
 	if err != nil {
 		return "", fmt.Errorf("failed to get container ID: %w", err)
 	}
@@ -130,9 +257,8 @@ func GetContainerID(container string) (string, error) {
 }
 
 // ListContainers returns a list of running container names.
-func ListContainers() ([]string, error) {
-	cmd := exec.Command("docker", "ps", "--format", "{{.Names}}")
-	output, err := cmd.Output()
+func (r Runtime) ListContainers() ([]string, error) {
+	output, err := r.output("ps", "--format", "{{.Names}}")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list containers: %w", err)
 	}
@@ -150,8 +276,8 @@ func ListContainers() ([]string, error) {
 }
 
 // FindSekaiContainer attempts to find a SEKAI container by common naming patterns.
-func FindSekaiContainer() (string, error) {
-	containers, err := ListContainers()
+func (r Runtime) FindSekaiContainer() (string, error) {
+	containers, err := r.ListContainers()
 	if err != nil {
 		return "", err
 	}
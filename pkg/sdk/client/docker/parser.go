@@ -126,6 +126,16 @@ func ParseQueryResponse[T any](output string) (*T, error) {
 	return &result, nil
 }
 
+// ParseGasEstimate extracts the gas estimate printed by a --dry-run transaction.
+func ParseGasEstimate(output string) (uint64, error) {
+	re := regexp.MustCompile(`gas estimate:\s*(\d+)`)
+	matches := re.FindStringSubmatch(output)
+	if len(matches) < 2 {
+		return 0, fmt.Errorf("gas estimate not found in output")
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
 // extractTxHash attempts to extract a transaction hash from text output.
 func extractTxHash(output string) string {
 	// Look for common patterns
@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// traceFailure saves a failed command to a timestamped file in TraceDir:
+// the command line, exit code, error and full stdout and stderr. Secrets,
+// including key arguments and exported keys, are redacted as in debug
+// output and stdin is left out. Tracing is
+// best-effort; a trace that cannot be written is only logged.
+func (c *Client) traceFailure(binary string, args []string, input string, result *ExecResult, err error) {
+	if c.config.TraceDir == "" || err == nil {
+		return
+	}
+	path, werr := c.writeTrace(binary, args, input, result, err)
+	if c.config.Logger == nil {
+		return
+	}
+	if werr != nil {
+		c.config.Logger.Printf("failed to write trace: %v", werr)
+		return
+	}
+	c.config.Logger.Printf("trace: %s", path)
+}
+
+// writeTrace writes the trace file and returns its path.
+func (c *Client) writeTrace(binary string, args []string, input string, result *ExecResult, err error) (string, error) {
+	if err := os.MkdirAll(c.config.TraceDir, 0o700); err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	f, ferr := os.CreateTemp(c.config.TraceDir, "sekaid-"+now.Format("20060102T150405Z")+"-*.log")
+	if ferr != nil {
+		return "", ferr
+	}
+	defer f.Close()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "time: %s\n", now.Format(time.RFC3339Nano))
+	fmt.Fprintf(&b, "command: %s\n", c.commandLine(binary, args))
+	if input != "" {
+		fmt.Fprintf(&b, "stdin: [REDACTED] (%d bytes)\n", len(input))
+	}
+	if result != nil {
+		fmt.Fprintf(&b, "exit code: %d\n", result.ExitCode)
+	}
+	fmt.Fprintf(&b, "error: %s\n", sdk.RedactText(err.Error()))
+	if result != nil {
+		fmt.Fprintf(&b, "\n--- stdout ---\n%s\n", redactStdout(args, result.Stdout))
+		fmt.Fprintf(&b, "\n--- stderr ---\n%s\n", sdk.RedactText(result.Stderr))
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
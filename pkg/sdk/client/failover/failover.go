@@ -0,0 +1,494 @@
+// Package failover provides an sdk.Client that spreads calls over several
+// node endpoints. Each endpoint is probed with a status call before first
+// use, and calls move on to the next endpoint when the active one becomes
+// unreachable. An endpoint that went down is probed again after a backoff,
+// so long-running sessions recover from transient outages.
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Endpoint selection strategies.
+const (
+	// StrategyFirstHealthy uses the first endpoint that passes its probe
+	// and stays on it until it fails.
+	StrategyFirstHealthy = "first-healthy"
+
+	// StrategyRoundRobin starts each call at the endpoint after the one
+	// used by the previous call.
+	StrategyRoundRobin = "round-robin"
+)
+
+// DefaultProbeTimeout bounds the status call that checks an endpoint.
+const DefaultProbeTimeout = 5 * time.Second
+
+// DefaultRetryAfter is how long an endpoint that went down is skipped
+// before it is probed again.
+const DefaultRetryAfter = 30 * time.Second
+
+// Strategies lists the supported endpoint selection strategies.
+func Strategies() []string {
+	return []string{StrategyFirstHealthy, StrategyRoundRobin}
+}
+
+// Endpoint is a client for one node.
+type Endpoint struct {
+	// Name identifies the endpoint in errors and debug output, e.g. its URL.
+	Name string
+
+	// Client talks to the node.
+	Client sdk.Client
+}
+
+// Client implements sdk.Client over a list of endpoints.
+type Client struct {
+	endpoints    []Endpoint
+	strategy     string
+	probeTimeout time.Duration
+	retryAfter   time.Duration
+	logger       sdk.Logger
+
+	mu      sync.Mutex
+	probed  []bool
+	healthy []bool
+	// downSince is when an unhealthy endpoint last failed
+	downSince []time.Time
+	current   int
+}
+
+// Option is a function that configures the failover client.
+type Option func(*Client)
+
+// WithStrategy sets the endpoint selection strategy.
+func WithStrategy(strategy string) Option {
+	return func(c *Client) {
+		if strategy != "" {
+			c.strategy = strategy
+		}
+	}
+}
+
+// WithProbeTimeout sets the timeout of the status call that checks an
+// endpoint.
+func WithProbeTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.probeTimeout = timeout
+	}
+}
+
+// WithRetryAfter sets how long an endpoint that went down is skipped
+// before it is probed again.
+func WithRetryAfter(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryAfter = d
+	}
+}
+
+// WithLogger logs probes and failovers.
+func WithLogger(logger sdk.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// NewClient creates a client over endpoints, tried in the given order.
+func NewClient(endpoints []Endpoint, opts ...Option) (*Client, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints given")
+	}
+	c := &Client{
+		endpoints:    endpoints,
+		strategy:     StrategyFirstHealthy,
+		probeTimeout: DefaultProbeTimeout,
+		retryAfter:   DefaultRetryAfter,
+		probed:       make([]bool, len(endpoints)),
+		healthy:      make([]bool, len(endpoints)),
+		downSince:    make([]time.Time, len(endpoints)),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.strategy != StrategyFirstHealthy && c.strategy != StrategyRoundRobin {
+		return nil, fmt.Errorf("unknown node strategy %q (must be one of %s)", c.strategy, strings.Join(Strategies(), ", "))
+	}
+	return c, nil
+}
+
+// SplitEndpoints splits a comma-separated list of endpoints, dropping
+// blanks.
+func SplitEndpoints(s string) []string {
+	var endpoints []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			endpoints = append(endpoints, e)
+		}
+	}
+	return endpoints
+}
+
+// Active returns the name of the endpoint the next call starts at.
+func (c *Client) Active() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.endpoints[c.current].Name
+}
+
+// order returns the endpoint indexes in the order the next call tries
+// them, advancing the start for round-robin.
+func (c *Client) order() []int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	start := c.current
+	if c.strategy == StrategyRoundRobin {
+		c.current = (c.current + 1) % len(c.endpoints)
+	}
+	order := make([]int, len(c.endpoints))
+	for i := range order {
+		order[i] = (start + i) % len(c.endpoints)
+	}
+	return order
+}
+
+// backingOff reports whether endpoint i went down less than retryAfter
+// ago, so it is skipped without a probe.
+func (c *Client) backingOff(i int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.probed[i] && !c.healthy[i] && time.Since(c.downSince[i]) < c.retryAfter
+}
+
+// usable probes endpoint i on first use and reports whether it is healthy.
+// An endpoint that went down is skipped until retryAfter has passed, then
+// probed again; force probes it right away.
+func (c *Client) usable(ctx context.Context, i int, force bool) error {
+	if !force && c.backingOff(i) {
+		return fmt.Errorf("failed its health check")
+	}
+	c.mu.Lock()
+	probed, healthy := c.probed[i], c.healthy[i]
+	c.mu.Unlock()
+	if probed && healthy {
+		return nil
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, c.probeTimeout)
+	defer cancel()
+	_, err := c.endpoints[i].Client.Status(probeCtx)
+	c.mu.Lock()
+	c.probed[i], c.healthy[i] = true, err == nil
+	if err != nil {
+		c.downSince[i] = time.Now()
+	}
+	c.mu.Unlock()
+	if err != nil {
+		c.logf("node %s failed its health check: %v", c.endpoints[i].Name, err)
+		return err
+	}
+	if probed {
+		c.logf("node %s is healthy again", c.endpoints[i].Name)
+	}
+	return nil
+}
+
+// markDown records that endpoint i stopped responding.
+func (c *Client) markDown(i int, err error) {
+	c.mu.Lock()
+	c.probed[i], c.healthy[i] = true, false
+	c.downSince[i] = time.Now()
+	c.mu.Unlock()
+	c.logf("node %s failed, trying the next node: %v", c.endpoints[i].Name, err)
+}
+
+// use records endpoint i as the one first-healthy stays on.
+func (c *Client) use(i int) {
+	if c.strategy != StrategyFirstHealthy {
+		return
+	}
+	c.mu.Lock()
+	c.current = i
+	c.mu.Unlock()
+}
+
+// do runs call against the endpoints in turn until one succeeds or fails
+// with an error that is not about reaching the node. retry decides which
+// errors move on to the next endpoint. If every endpoint is still backing
+// off from an earlier failure, they are all probed again rather than
+// failing without trying any.
+func (c *Client) do(ctx context.Context, retry func(error) bool, call func(sdk.Client) error) error {
+	order := c.order()
+	force := true
+	for _, i := range order {
+		if !c.backingOff(i) {
+			force = false
+			break
+		}
+	}
+
+	var failures []string
+	for _, i := range order {
+		name := c.endpoints[i].Name
+		if err := c.usable(ctx, i, force); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		err := call(c.endpoints[i].Client)
+		if err == nil || !retry(err) || ctx.Err() != nil {
+			c.use(i)
+			return err
+		}
+		c.markDown(i, err)
+		failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+	}
+	return fmt.Errorf("all %d nodes failed: %s", len(c.endpoints), strings.Join(failures, "; "))
+}
+
+// Query executes a read operation, failing over on unreachable nodes.
+func (c *Client) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	var resp *sdk.QueryResponse
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		resp, err = client.Query(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// Tx executes a transaction. It fails over only when the node could not
+// be reached at all, so a transaction is never submitted twice.
+func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	var resp *sdk.TxResponse
+	err := c.do(ctx, isDialError, func(client sdk.Client) (err error) {
+		resp, err = client.Tx(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// Simulate estimates the gas a transaction would use, if the client of
+// the first healthy node supports it.
+func (c *Client) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	var resp *sdk.SimulateResponse
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		sim, ok := client.(sdk.TxSimulator)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		resp, err = sim.Simulate(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// GenerateTx builds an unsigned transaction, if the client of the first
+// healthy node supports it.
+func (c *Client) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	var tx []byte
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		otc, ok := client.(sdk.OfflineTxClient)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		tx, err = otc.GenerateTx(ctx, req)
+		return err
+	})
+	return tx, err
+}
+
+// SignTx signs a transaction produced by GenerateTx, if the client of the
+// first healthy node supports it.
+func (c *Client) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	var signed []byte
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		otc, ok := client.(sdk.OfflineTxClient)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		signed, err = otc.SignTx(ctx, tx, opts)
+		return err
+	})
+	return signed, err
+}
+
+// BroadcastTx submits a signed transaction. Like Tx, it fails over only
+// when the node could not be reached.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	var resp *sdk.TxResponse
+	err := c.do(ctx, isDialError, func(client sdk.Client) (err error) {
+		otc, ok := client.(sdk.OfflineTxClient)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		resp, err = otc.BroadcastTx(ctx, tx, mode)
+		return err
+	})
+	return resp, err
+}
+
+// EncodeTx converts a transaction JSON into base64-encoded protobuf bytes.
+func (c *Client) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	var encoded string
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		codec, ok := client.(sdk.TxCodec)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		encoded, err = codec.EncodeTx(ctx, tx)
+		return err
+	})
+	return encoded, err
+}
+
+// DecodeTx converts base64-encoded protobuf transaction bytes into JSON.
+func (c *Client) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	var tx []byte
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		codec, ok := client.(sdk.TxCodec)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		tx, err = codec.DecodeTx(ctx, txBytes)
+		return err
+	})
+	return tx, err
+}
+
+// Keys returns the keyring of the active endpoint.
+func (c *Client) Keys() sdk.KeysClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.endpoints[c.current].Client.Keys()
+}
+
+// Status returns the status of the first healthy node.
+func (c *Client) Status(ctx context.Context) (*sdk.StatusResponse, error) {
+	var resp *sdk.StatusResponse
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		resp, err = client.Status(ctx)
+		return err
+	})
+	return resp, err
+}
+
+// NetInfo returns the peer connections of the first healthy node, if its
+// client supports it.
+func (c *Client) NetInfo(ctx context.Context) (*sdk.NetInfo, error) {
+	var info *sdk.NetInfo
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		nic, ok := client.(sdk.NetInfoClient)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		info, err = nic.NetInfo(ctx)
+		return err
+	})
+	return info, err
+}
+
+// AppVersion returns the sekaid version of the first healthy node, if its
+// client supports it.
+func (c *Client) AppVersion(ctx context.Context) (string, error) {
+	var version string
+	err := c.do(ctx, isUnavailable, func(client sdk.Client) (err error) {
+		avc, ok := client.(sdk.AppVersionClient)
+		if !ok {
+			return sdk.ErrNotSupported
+		}
+		version, err = avc.AppVersion(ctx)
+		return err
+	})
+	return version, err
+}
+
+// Close closes the clients of all endpoints.
+func (c *Client) Close() error {
+	var errs []error
+	for _, e := range c.endpoints {
+		if err := e.Client.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// logf writes to the logger, if set.
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
+// dialErrors are fragments of the errors sekaid and Go report when a node
+// cannot be reached, before any request was sent.
+var dialErrors = []string{
+	"connection refused",
+	"no such host",
+	"dial tcp",
+	"no route to host",
+	"network is unreachable",
+}
+
+// unavailableErrors are fragments of errors that mean the node stopped
+// responding, possibly after a request was sent.
+var unavailableErrors = []string{
+	"connection reset",
+	"i/o timeout",
+	"unexpected eof",
+	"timed out",
+	"bad gateway",
+	"service unavailable",
+}
+
+// isDialError reports whether err means the node could not be reached.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range dialErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnavailable reports whether err means the node is unreachable or
+// failing, so a read can be retried on another node.
+func isUnavailable(err error) bool {
+	if isDialError(err) {
+		return true
+	}
+	var httpErr *sdk.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500 || httpErr.StatusCode == 429
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range unavailableErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure Client implements sdk.Client and the optional transaction
+// interfaces.
+var (
+	_ sdk.Client          = (*Client)(nil)
+	_ sdk.TxSimulator     = (*Client)(nil)
+	_ sdk.OfflineTxClient = (*Client)(nil)
+	_ sdk.TxCodec         = (*Client)(nil)
+)
@@ -0,0 +1,635 @@
+// Package grpc provides a gRPC client for SEKAI blockchain.
+// It talks to sekaid's gRPC endpoint (port 9090 by default) over HTTP/2
+// with the standard library, encoding the few protobuf messages it needs
+// itself, so the SDK keeps its zero-dependency rule. Queries are answered
+// in the same JSON shape sekaid prints, so modules parse them unchanged.
+//
+// The query path covers bank, auth and customgov network properties, and
+// BroadcastTx submits signed transactions. Everything else returns an
+// error wrapping sdk.ErrNotSupported ("unsupported over grpc").
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Client implements the sdk.Client interface over gRPC.
+type Client struct {
+	config     *Config
+	baseURL    string
+	httpClient *http.Client
+	keys       *keysClient
+}
+
+// Config holds configuration for the gRPC client.
+type Config struct {
+	// Target is the gRPC endpoint: host:port or http://host:port for
+	// plaintext, https://host:port or grpcs://host:port for TLS.
+	Target string
+
+	// ChainID is the blockchain network identifier.
+	ChainID string
+
+	// Timeout is the request timeout.
+	Timeout time.Duration
+
+	// TLS configures TLS connections; nil uses the system roots.
+	TLS *tls.Config
+
+	// Height runs queries against the state at this block height; 0 means
+	// the latest block.
+	Height int64
+
+	// Logger, if set, receives every call and its status.
+	Logger sdk.Logger
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Target:  "localhost:9090",
+		Timeout: 30 * time.Second,
+	}
+}
+
+// Option is a function that configures the gRPC client.
+type Option func(*Config)
+
+// WithChainID sets the chain ID.
+func WithChainID(chainID string) Option {
+	return func(c *Config) {
+		c.ChainID = chainID
+	}
+}
+
+// WithTimeout sets the request timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.Timeout = timeout
+	}
+}
+
+// WithTLS sets the TLS configuration of https:// and grpcs:// targets.
+func WithTLS(cfg *tls.Config) Option {
+	return func(c *Config) {
+		c.TLS = cfg
+	}
+}
+
+// WithHeight runs queries at a past block height, sent in the
+// x-cosmos-block-height metadata.
+func WithHeight(height int64) Option {
+	return func(c *Config) {
+		c.Height = height
+	}
+}
+
+// WithLogger logs calls and their status.
+func WithLogger(logger sdk.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// NewClient creates a new gRPC client. Calls share one HTTP/2 connection,
+// and the client is safe for concurrent use.
+func NewClient(target string, opts ...Option) (*Client, error) {
+	if target == "" {
+		return nil, fmt.Errorf("gRPC target is required")
+	}
+
+	cfg := DefaultConfig()
+	cfg.Target = target
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	baseURL, secure, err := parseTarget(cfg.Target)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := newTransport(secure, cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		config:  cfg,
+		baseURL: baseURL,
+		httpClient: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: transport,
+		},
+	}
+	c.keys = &keysClient{}
+	return c, nil
+}
+
+// parseTarget returns the HTTP base URL of a gRPC target and whether it
+// uses TLS.
+func parseTarget(target string) (string, bool, error) {
+	target = strings.TrimSuffix(strings.TrimSpace(target), "/")
+	scheme, host, found := strings.Cut(target, "://")
+	if !found {
+		scheme, host = "http", target
+	}
+	switch scheme {
+	case "http", "grpc", "tcp":
+		scheme = "http"
+	case "https", "grpcs":
+		scheme = "https"
+	default:
+		return "", false, fmt.Errorf("invalid gRPC target %q: scheme must be http, https or grpcs", target)
+	}
+	if host == "" || strings.Contains(host, "/") {
+		return "", false, fmt.Errorf("invalid gRPC target %q: expected host:port", target)
+	}
+	return scheme + "://" + host, scheme == "https", nil
+}
+
+// unsupported returns the error of an operation the gRPC client lacks.
+func unsupported(op string) error {
+	return fmt.Errorf("%s: unsupported over grpc: %w", op, sdk.ErrNotSupported)
+}
+
+// Query executes a query operation via gRPC.
+func (c *Client) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("query request is required")
+	}
+
+	data, err := c.query(ctx, req)
+	if err != nil {
+		return nil, &sdk.QueryError{Module: req.Module, Endpoint: req.Endpoint, Err: err}
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.QueryResponse{Data: body, Height: c.config.Height}, nil
+}
+
+// query runs the gRPC method of a query request and returns its response
+// in sekaid's JSON shape.
+func (c *Client) query(ctx context.Context, req *sdk.QueryRequest) (any, error) {
+	arg := func() (string, error) {
+		if len(req.RawArgs) == 0 || req.RawArgs[0] == "" {
+			return "", fmt.Errorf("address is required")
+		}
+		return req.RawArgs[0], nil
+	}
+
+	switch req.Module + " " + req.Endpoint {
+	case "bank balances", "bank spendable-balances":
+		address, err := arg()
+		if err != nil {
+			return nil, err
+		}
+		if denom := req.Params["denom"]; denom != "" && req.Endpoint == "balances" {
+			fs, err := c.invoke(ctx, "/cosmos.bank.v1beta1.Query/Balance", message(nil).string(1, address).string(2, denom))
+			if err != nil {
+				return nil, err
+			}
+			f, _ := fs.get(1)
+			coin, err := decodeCoin(f.b)
+			return map[string]Coin{"balance": coin}, err
+		}
+		page, err := pageRequest(req.Params)
+		if err != nil {
+			return nil, err
+		}
+		method := "/cosmos.bank.v1beta1.Query/AllBalances"
+		if req.Endpoint == "spendable-balances" {
+			method = "/cosmos.bank.v1beta1.Query/SpendableBalances"
+		}
+		fs, err := c.invoke(ctx, method, message(nil).string(1, address).bytes(2, page))
+		if err != nil {
+			return nil, err
+		}
+		return coinsResponse(fs, "balances")
+
+	case "bank total", "bank supply":
+		if denom := req.Params["denom"]; denom != "" {
+			fs, err := c.invoke(ctx, "/cosmos.bank.v1beta1.Query/SupplyOf", message(nil).string(1, denom))
+			if err != nil {
+				return nil, err
+			}
+			f, _ := fs.get(1)
+			coin, err := decodeCoin(f.b)
+			return map[string]Coin{"amount": coin}, err
+		}
+		page, err := pageRequest(req.Params)
+		if err != nil {
+			return nil, err
+		}
+		fs, err := c.invoke(ctx, "/cosmos.bank.v1beta1.Query/TotalSupply", message(nil).bytes(1, page))
+		if err != nil {
+			return nil, err
+		}
+		return coinsResponse(fs, "supply")
+
+	case "auth account":
+		address, err := arg()
+		if err != nil {
+			return nil, err
+		}
+		fs, err := c.invoke(ctx, "/cosmos.auth.v1beta1.Query/Account", message(nil).string(1, address))
+		if err != nil {
+			return nil, err
+		}
+		account, err := fs.msg(1)
+		if err != nil {
+			return nil, err
+		}
+		return decodeAccount(account)
+
+	case "customgov network-properties", "gov network-properties":
+		fs, err := c.invoke(ctx, "/kira.gov.Query/NetworkProperties", nil)
+		if err != nil {
+			return nil, err
+		}
+		props, err := fs.msg(1)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]map[string]any{"properties": decodeNetworkProperties(props)}, nil
+	}
+
+	return nil, unsupported("query " + req.Module + " " + req.Endpoint)
+}
+
+// coinsResponse decodes a response of repeated coins (field 1) and a page
+// (field 2), such as QueryAllBalancesResponse.
+func coinsResponse(fs fields, name string) (map[string]any, error) {
+	coins, err := decodeCoins(fs, 1)
+	if err != nil {
+		return nil, err
+	}
+	page, err := decodePage(fs, 2)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{name: coins, "pagination": page}, nil
+}
+
+// decodeAccount decodes an account Any (type_url = 1, value = 2) holding a
+// cosmos.auth.v1beta1.BaseAccount: address = 1, pub_key = 2,
+// account_number = 3, sequence = 4.
+func decodeAccount(account fields) (map[string]any, error) {
+	typeURL := account.str(1)
+	if typeURL != "/cosmos.auth.v1beta1.BaseAccount" {
+		return nil, unsupported("account type " + typeURL)
+	}
+	acc, err := account.msg(2)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{
+		"@type":          typeURL,
+		"address":        acc.str(1),
+		"account_number": strconv.FormatUint(acc.uint(3), 10),
+		"sequence":       strconv.FormatUint(acc.uint(4), 10),
+	}
+	if pk, err := acc.msg(2); err == nil && len(pk) > 0 {
+		key, err := pk.msg(2)
+		if err != nil {
+			return nil, err
+		}
+		out["pub_key"] = map[string]string{
+			"@type": pk.str(1),
+			"key":   base64.StdEncoding.EncodeToString([]byte(key.str(1))),
+		}
+	}
+	return out, nil
+}
+
+// networkPropertyNames are the fields of kira.gov.NetworkProperties by
+// number, in proto order.
+var networkPropertyNames = []string{
+	1: "min_tx_fee", 2: "max_tx_fee", 3: "vote_quorum",
+	4: "minimum_proposal_end_time", 5: "proposal_enactment_time",
+	6: "min_proposal_end_blocks", 7: "min_proposal_enactment_blocks",
+	8: "enable_foreign_fee_payments", 9: "mischance_rank_decrease_amount",
+	10: "max_mischance", 11: "mischance_confidence",
+	12: "inactive_rank_decrease_percent", 13: "min_validators",
+	14: "poor_network_max_bank_send", 15: "unjail_max_time",
+	16: "enable_token_whitelist", 17: "enable_token_blacklist",
+	18: "min_identity_approval_tip", 19: "unique_identity_keys",
+	20: "ubi_hardcap", 21: "validators_fee_share", 22: "inflation_rate",
+	23: "inflation_period", 24: "unstaking_period", 25: "max_delegators",
+	26: "min_delegation_pushout", 27: "slashing_period",
+	28: "max_jailed_percentage", 29: "max_slashing_percentage",
+}
+
+// networkPropertyBools are the bool fields of kira.gov.NetworkProperties.
+var networkPropertyBools = map[int]bool{8: true, 16: true, 17: true}
+
+// decodeNetworkProperties decodes the known fields of
+// kira.gov.NetworkProperties. Integers are printed as strings, as by
+// sekaid; fields the SDK does not know are skipped.
+func decodeNetworkProperties(fs fields) map[string]any {
+	props := map[string]any{}
+	for num, name := range networkPropertyNames {
+		if name == "" {
+			continue
+		}
+		f, ok := fs.get(num)
+		switch {
+		case networkPropertyBools[num]:
+			props[name] = ok && f.u != 0
+		case ok && f.wire == wireBytes:
+			props[name] = string(f.b)
+		default:
+			props[name] = strconv.FormatUint(f.u, 10)
+		}
+	}
+	return props
+}
+
+// Tx is not supported because the gRPC client cannot build or sign
+// transactions. Broadcast a signed transaction with BroadcastTx instead.
+func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, error) {
+	return nil, unsupported("tx " + req.Module + " " + req.Action)
+}
+
+// Simulate is not supported over gRPC.
+func (c *Client) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	return nil, unsupported("simulate")
+}
+
+// GenerateTx is not supported because the gRPC client cannot build
+// transactions.
+func (c *Client) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	return nil, unsupported("generate tx")
+}
+
+// SignTx is not supported because the gRPC client has no keyring.
+func (c *Client) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	return nil, unsupported("sign tx")
+}
+
+// BroadcastTx submits a signed transaction via
+// cosmos.tx.v1beta1.Service/BroadcastTx. Like the REST client it takes the
+// base64 tx bytes (as printed by "sekaid tx encode"), not the signed JSON.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	encoded := strings.TrimSpace(string(tx))
+	if strings.HasPrefix(encoded, "{") {
+		return nil, fmt.Errorf("gRPC broadcast requires a base64-encoded transaction; encode the signed JSON with 'sekai-cli tx encode' first")
+	}
+	txBytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 transaction: %w", err)
+	}
+
+	// BroadcastMode: BLOCK = 1, SYNC = 2, ASYNC = 3. Like REST, block is
+	// sent as sync and callers wait for inclusion themselves.
+	var broadcastMode uint64 = 2
+	if mode == "async" {
+		broadcastMode = 3
+	}
+	fs, err := c.invoke(ctx, "/cosmos.tx.v1beta1.Service/BroadcastTx", message(nil).bytes(1, txBytes).varint(2, broadcastMode))
+	if err != nil {
+		return nil, sdk.ParseInsufficientFunds(fmt.Errorf("failed to broadcast transaction: %w", err))
+	}
+	r, err := fs.msg(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse broadcast response: %w", err)
+	}
+
+	// TxResponse: height = 1, txhash = 2, codespace = 3, code = 4,
+	// data = 5, raw_log = 6, gas_wanted = 9, gas_used = 10.
+	resp := &sdk.TxResponse{
+		Height:    int64(r.uint(1)),
+		TxHash:    r.str(2),
+		Codespace: r.str(3),
+		Code:      uint32(r.uint(4)),
+		Data:      r.str(5),
+		RawLog:    r.str(6),
+		GasWanted: int64(r.uint(9)),
+		GasUsed:   int64(r.uint(10)),
+	}
+	if resp.Code != 0 {
+		return resp, sdk.ParseInsufficientFunds(sdk.NewTxErrorFromResponse("tx", "broadcast", resp))
+	}
+	return resp, nil
+}
+
+// EncodeTx is not supported over gRPC.
+func (c *Client) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	return "", unsupported("encode tx")
+}
+
+// DecodeTx is not supported over gRPC.
+func (c *Client) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	return nil, unsupported("decode tx")
+}
+
+// Keys returns the keyring client.
+// Note: Keys are stored locally, not accessible via gRPC.
+func (c *Client) Keys() sdk.KeysClient {
+	return c.keys
+}
+
+// Status returns the node status from the Tendermint service: node info,
+// the latest block and whether the node is syncing.
+func (c *Client) Status(ctx context.Context) (*sdk.StatusResponse, error) {
+	info, err := c.invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo", nil)
+	if err != nil {
+		return nil, err
+	}
+	// DefaultNodeInfo: network = 4, version = 5, moniker = 7
+	node, err := info.msg(1)
+	if err != nil {
+		return nil, err
+	}
+	status := &sdk.StatusResponse{
+		NodeInfo: sdk.NodeInfo{
+			Network: node.str(4),
+			Moniker: node.str(7),
+			Version: node.str(5),
+		},
+	}
+
+	// GetLatestBlockResponse: block = 2; Block: header = 1;
+	// Header: height = 3, time = 4
+	latest, err := c.invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock", nil)
+	if err != nil {
+		return nil, err
+	}
+	block, err := latest.msg(2)
+	if err != nil {
+		return nil, err
+	}
+	header, err := block.msg(1)
+	if err != nil {
+		return nil, err
+	}
+	blockTime, err := header.msg(4)
+	if err != nil {
+		return nil, err
+	}
+	status.SyncInfo.LatestBlockHeight = int64(header.uint(3))
+	status.SyncInfo.LatestBlockTime = decodeTimestamp(blockTime)
+
+	syncing, err := c.invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetSyncing", nil)
+	if err != nil {
+		return nil, err
+	}
+	status.SyncInfo.CatchingUp = syncing.uint(1) != 0
+	return status, nil
+}
+
+// AppVersion returns the sekaid version of the node, the version of its
+// GetNodeInfo application_version (field 2, version = 3).
+func (c *Client) AppVersion(ctx context.Context) (string, error) {
+	info, err := c.invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo", nil)
+	if err != nil {
+		return "", err
+	}
+	app, err := info.msg(2)
+	if err != nil {
+		return "", err
+	}
+	if v := app.str(3); v != "" {
+		return v, nil
+	}
+	return "", fmt.Errorf("node info has no application version")
+}
+
+// Close releases the client's connections.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// Config returns the client configuration.
+func (c *Client) Config() *Config {
+	return c.config
+}
+
+// Error is a non-OK gRPC status returned by the node.
+type Error struct {
+	// Method is the full gRPC method name
+	Method string
+
+	// Code is the gRPC status code
+	Code int
+
+	// Message is the status message
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: grpc status %d: %s", e.Method, e.Code, e.Message)
+}
+
+// Unwrap makes an Unimplemented status (code 12) match sdk.ErrNotSupported.
+func (e *Error) Unwrap() error {
+	if e.Code == 12 {
+		return sdk.ErrNotSupported
+	}
+	return nil
+}
+
+// invoke calls a unary gRPC method with the encoded request and returns
+// the decoded response message.
+func (c *Client) invoke(ctx context.Context, method string, req message) (fields, error) {
+	c.logf("grpc %s", method)
+
+	frame := make([]byte, 5, 5+len(req))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(req)))
+	frame = append(frame, req...)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+method, bytes.NewReader(frame))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/grpc+proto")
+	httpReq.Header.Set("TE", "trailers")
+	if c.config.Height > 0 {
+		httpReq.Header.Set("x-cosmos-block-height", strconv.FormatInt(c.config.Height, 10))
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		c.logf("error: %v", err)
+		return nil, &sdk.HTTPError{URL: c.baseURL + method, Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &sdk.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       string(body),
+			URL:        c.baseURL + method,
+		}
+	}
+
+	// The status is in the trailers, or in the headers of a response
+	// without a body
+	code, msg := resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")
+	if code == "" {
+		code, msg = resp.Header.Get("Grpc-Status"), resp.Header.Get("Grpc-Message")
+	}
+	c.logf("grpc status %s", getOr(code, "missing"))
+	if code != "0" {
+		n, err := strconv.Atoi(code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: missing grpc status", method)
+		}
+		if m, err := url.PathUnescape(msg); err == nil {
+			msg = m
+		}
+		return nil, &Error{Method: method, Code: n, Message: msg}
+	}
+
+	if len(body) < 5 {
+		return nil, fmt.Errorf("%s: empty response", method)
+	}
+	if body[0] != 0 {
+		return nil, fmt.Errorf("%s: compressed responses are not supported", method)
+	}
+	n := binary.BigEndian.Uint32(body[1:5])
+	if uint64(len(body)-5) < uint64(n) {
+		return nil, fmt.Errorf("%s: %w", method, errTruncated)
+	}
+	fs, err := decode(body[5 : 5+n])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	return fs, nil
+}
+
+// getOr returns s, or def if s is empty.
+func getOr(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// logf logs a debug message if a logger is set.
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.config.Logger != nil {
+		c.config.Logger.Printf(format, args...)
+	}
+}
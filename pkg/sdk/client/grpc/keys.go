@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// keysClient is a gRPC implementation of sdk.KeysClient.
+// Every operation fails with an error wrapping sdk.ErrNotSupported as keys
+// are local.
+type keysClient struct{}
+
+// errNoKeyring is returned by every keyring operation.
+var errNoKeyring = unsupported("keys")
+
+func (k *keysClient) Add(ctx context.Context, name string, opts *sdk.KeyAddOptions) (*sdk.KeyInfo, error) {
+	return nil, errNoKeyring
+}
+
+func (k *keysClient) Delete(ctx context.Context, name string, force bool) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) List(ctx context.Context) ([]sdk.KeyInfo, error) {
+	return nil, errNoKeyring
+}
+
+func (k *keysClient) Show(ctx context.Context, name string) (*sdk.KeyInfo, error) {
+	return nil, errNoKeyring
+}
+
+func (k *keysClient) Export(ctx context.Context, name string) (string, error) {
+	return "", errNoKeyring
+}
+
+func (k *keysClient) ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error) {
+	return "", errNoKeyring
+}
+
+func (k *keysClient) ExportHex(ctx context.Context, name string) (string, error) {
+	return "", errNoKeyring
+}
+
+func (k *keysClient) Import(ctx context.Context, name, armor string) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) Rename(ctx context.Context, oldName, newName string) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) Mnemonic(ctx context.Context, name string) (string, error) {
+	return "", errNoKeyring
+}
+
+func (k *keysClient) ImportHex(ctx context.Context, name, hexKey, keyType string) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) ListKeyTypes(ctx context.Context) ([]string, error) {
+	return nil, errNoKeyring
+}
+
+func (k *keysClient) Migrate(ctx context.Context) error {
+	return errNoKeyring
+}
+
+func (k *keysClient) Parse(ctx context.Context, address string) (*sdk.ParsedAddress, error) {
+	return nil, errNoKeyring
+}
+
+func (k *keysClient) SignMessage(ctx context.Context, name, signer string, data []byte) ([]byte, []byte, error) {
+	return nil, nil, errNoKeyring
+}
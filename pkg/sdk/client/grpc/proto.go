@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Protobuf wire types used by the SEKAI and Cosmos SDK messages.
+const (
+	wireVarint = 0
+	wireI64    = 1
+	wireBytes  = 2
+	wireI32    = 5
+)
+
+var errTruncated = errors.New("truncated protobuf message")
+
+// message builds a protobuf message field by field. Zero values are
+// omitted, as by proto3 encoders.
+type message []byte
+
+// varint appends field num as a varint.
+func (m message) varint(num int, v uint64) message {
+	if v == 0 {
+		return m
+	}
+	m = binary.AppendUvarint(m, uint64(num)<<3|wireVarint)
+	return binary.AppendUvarint(m, v)
+}
+
+// bool appends field num as a bool.
+func (m message) bool(num int, v bool) message {
+	if !v {
+		return m
+	}
+	return m.varint(num, 1)
+}
+
+// bytes appends field num as length-delimited bytes.
+func (m message) bytes(num int, v []byte) message {
+	if len(v) == 0 {
+		return m
+	}
+	m = binary.AppendUvarint(m, uint64(num)<<3|wireBytes)
+	m = binary.AppendUvarint(m, uint64(len(v)))
+	return append(m, v...)
+}
+
+// string appends field num as a string.
+func (m message) string(num int, v string) message {
+	return m.bytes(num, []byte(v))
+}
+
+// field is one decoded field of a protobuf message.
+type field struct {
+	num  int
+	wire int
+	u    uint64 // varint and fixed values
+	b    []byte // length-delimited values
+}
+
+// fields is a decoded protobuf message.
+type fields []field
+
+// decode splits a protobuf message into its fields.
+func decode(b []byte) (fields, error) {
+	var fs fields
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, errTruncated
+		}
+		b = b[n:]
+		f := field{num: int(key >> 3), wire: int(key & 7)}
+		switch f.wire {
+		case wireVarint:
+			f.u, n = binary.Uvarint(b)
+			if n <= 0 {
+				return nil, errTruncated
+			}
+			b = b[n:]
+		case wireI64:
+			if len(b) < 8 {
+				return nil, errTruncated
+			}
+			f.u, b = binary.LittleEndian.Uint64(b), b[8:]
+		case wireI32:
+			if len(b) < 4 {
+				return nil, errTruncated
+			}
+			f.u, b = uint64(binary.LittleEndian.Uint32(b)), b[4:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return nil, errTruncated
+			}
+			f.b, b = b[n:n+int(l)], b[n+int(l):]
+		default:
+			return nil, fmt.Errorf("unsupported protobuf wire type %d", f.wire)
+		}
+		fs = append(fs, f)
+	}
+	return fs, nil
+}
+
+// get returns the last field num, as proto3 does for repeated scalars.
+func (fs fields) get(num int) (field, bool) {
+	for i := len(fs) - 1; i >= 0; i-- {
+		if fs[i].num == num {
+			return fs[i], true
+		}
+	}
+	return field{}, false
+}
+
+// all returns every occurrence of a repeated field num.
+func (fs fields) all(num int) []field {
+	var out []field
+	for _, f := range fs {
+		if f.num == num {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// uint returns field num as a uint64, 0 if unset.
+func (fs fields) uint(num int) uint64 {
+	f, _ := fs.get(num)
+	return f.u
+}
+
+// str returns field num as a string, "" if unset.
+func (fs fields) str(num int) string {
+	f, _ := fs.get(num)
+	return string(f.b)
+}
+
+// msg decodes the embedded message field num; unset is an empty message.
+func (fs fields) msg(num int) (fields, error) {
+	f, ok := fs.get(num)
+	if !ok {
+		return nil, nil
+	}
+	return decode(f.b)
+}
+
+// Coin is cosmos.base.v1beta1.Coin as printed by sekaid.
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// decodeCoin decodes a Coin: denom = 1, amount = 2.
+func decodeCoin(b []byte) (Coin, error) {
+	fs, err := decode(b)
+	if err != nil {
+		return Coin{}, err
+	}
+	return Coin{Denom: fs.str(1), Amount: fs.str(2)}, nil
+}
+
+// decodeCoins decodes the repeated Coin field num.
+func decodeCoins(fs fields, num int) ([]Coin, error) {
+	coins := []Coin{}
+	for _, f := range fs.all(num) {
+		c, err := decodeCoin(f.b)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, c)
+	}
+	return coins, nil
+}
+
+// PageResponse is cosmos.base.query.v1beta1.PageResponse as printed by
+// sekaid.
+type PageResponse struct {
+	NextKey *string `json:"next_key"`
+	Total   string  `json:"total"`
+}
+
+// decodePage decodes the PageResponse field num: next_key = 1, total = 2.
+func decodePage(fs fields, num int) (*PageResponse, error) {
+	page, err := fs.msg(num)
+	if err != nil {
+		return nil, err
+	}
+	resp := &PageResponse{Total: strconv.FormatUint(page.uint(2), 10)}
+	if f, ok := page.get(1); ok && len(f.b) > 0 {
+		key := base64.StdEncoding.EncodeToString(f.b)
+		resp.NextKey = &key
+	}
+	return resp, nil
+}
+
+// pageRequest encodes sekaid-style pagination params (limit, offset, page,
+// page-key, count-total, reverse) as a cosmos.base.query.v1beta1.PageRequest:
+// key = 1, offset = 2, limit = 3, count_total = 4, reverse = 5.
+func pageRequest(params map[string]string) (message, error) {
+	var m message
+	if key := params["page-key"]; key != "" {
+		b, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page-key: %w", err)
+		}
+		m = m.bytes(1, b)
+	}
+	parse := func(name string) (uint64, error) {
+		if params[name] == "" {
+			return 0, nil
+		}
+		v, err := strconv.ParseUint(params[name], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s: %s", name, params[name])
+		}
+		return v, nil
+	}
+	offset, err := parse("offset")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := parse("limit")
+	if err != nil {
+		return nil, err
+	}
+	page, err := parse("page")
+	if err != nil {
+		return nil, err
+	}
+	if page > 1 {
+		if limit == 0 {
+			limit = 100
+		}
+		offset = (page - 1) * limit
+	}
+	m = m.varint(2, offset).varint(3, limit)
+	m = m.bool(4, params["count-total"] == "true")
+	return m.bool(5, params["reverse"] == "true"), nil
+}
+
+// decodeTimestamp decodes a google.protobuf.Timestamp (seconds = 1,
+// nanos = 2) as RFC 3339.
+func decodeTimestamp(fs fields) string {
+	if len(fs) == 0 {
+		return ""
+	}
+	return time.Unix(int64(fs.uint(1)), int64(fs.uint(2))).UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,22 @@
+//go:build !go1.24
+
+package grpc
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+)
+
+// newTransport returns an HTTP/2 transport. Before Go 1.24 the standard
+// library only speaks HTTP/2 over TLS, so plaintext targets need a newer
+// toolchain.
+func newTransport(secure bool, cfg *tls.Config) (http.RoundTripper, error) {
+	if !secure {
+		return nil, fmt.Errorf("plaintext gRPC needs sekai-cli built with Go 1.24 or later; use an https:// or grpcs:// target")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ForceAttemptHTTP2 = true
+	transport.TLSClientConfig = cfg
+	return transport, nil
+}
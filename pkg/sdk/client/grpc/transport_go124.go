@@ -0,0 +1,23 @@
+//go:build go1.24
+
+package grpc
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// newTransport returns an HTTP/2 transport: over TLS for secure targets,
+// else unencrypted HTTP/2 with prior knowledge, as gRPC servers expect.
+func newTransport(secure bool, cfg *tls.Config) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = cfg
+	protocols := new(http.Protocols)
+	if secure {
+		protocols.SetHTTP2(true)
+	} else {
+		protocols.SetUnencryptedHTTP2(true)
+	}
+	transport.Protocols = protocols
+	return transport, nil
+}
@@ -0,0 +1,191 @@
+// Package kube provides a Kubernetes client implementation for SEKAI SDK.
+// It executes sekaid commands inside a pod via kubectl exec, reusing the
+// Docker client's command building so all modules work unchanged.
+package kube
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+)
+
+// DefaultNamespace is the namespace used when none is given.
+const DefaultNamespace = "default"
+
+// Target identifies where sekaid runs in the cluster.
+type Target struct {
+	// Namespace is the pod namespace (default: "default").
+	Namespace string
+
+	// Pod is the pod name.
+	Pod string
+
+	// Container is the container within the pod. Empty uses the pod's
+	// default container.
+	Container string
+
+	// Context is the kubeconfig context. Empty uses the current context.
+	Context string
+}
+
+// Client implements sdk.Client by running sekaid in a Kubernetes pod.
+type Client struct {
+	*docker.Client
+	target Target
+}
+
+// Ensure Client implements sdk.Client.
+var _ sdk.Client = (*Client)(nil)
+
+// NewClient creates a client for the given pod. The options configure
+// sekaid the same way as for the Docker client (chain ID, home, fees, ...).
+func NewClient(target Target, opts ...docker.Option) (*Client, error) {
+	if target.Pod == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+	if target.Namespace == "" {
+		target.Namespace = DefaultNamespace
+	}
+
+	opts = append(opts, docker.WithExecutor(&executor{target: target}))
+	inner, err := docker.NewClient(target.Pod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Client: inner, target: target}, nil
+}
+
+// Target returns the pod the client executes in.
+func (c *Client) Target() Target {
+	return c.target
+}
+
+// executor implements docker.Executor with kubectl exec.
+type executor struct {
+	target Target
+}
+
+// Exec runs binary in the pod.
+func (e *executor) Exec(ctx context.Context, binary string, args ...string) (*docker.ExecResult, error) {
+	return e.run(ctx, binary, nil, args...)
+}
+
+// ExecWithInput runs binary in the pod with input on stdin.
+func (e *executor) ExecWithInput(ctx context.Context, binary, input string, args ...string) (*docker.ExecResult, error) {
+	return e.run(ctx, binary, &input, args...)
+}
+
+// run executes kubectl exec [-i] -n <ns> <pod> [-c <container>] -- binary args...
+func (e *executor) run(ctx context.Context, binary string, input *string, args ...string) (*docker.ExecResult, error) {
+	kubectlArgs := []string{"exec"}
+	if input != nil {
+		kubectlArgs = append(kubectlArgs, "-i")
+	}
+	kubectlArgs = append(kubectlArgs, "-n", e.target.Namespace, e.target.Pod)
+	if e.target.Container != "" {
+		kubectlArgs = append(kubectlArgs, "-c", e.target.Container)
+	}
+	kubectlArgs = append(kubectlArgs, "--", binary)
+	kubectlArgs = append(kubectlArgs, args...)
+
+	cmd, err := kubectl(ctx, e.target.Context, kubectlArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	if input != nil {
+		cmd.Stdin = strings.NewReader(*input)
+	}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+
+	result := &docker.ExecResult{
+		Stdout: strings.TrimSpace(stdout.String()),
+		Stderr: strings.TrimSpace(stderr.String()),
+	}
+
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitErr.ExitCode()
+		} else {
+			result.ExitCode = 1
+		}
+
+		execErr := &sdk.ExecutionError{
+			Command:  binary,
+			Args:     args,
+			ExitCode: result.ExitCode,
+			Stderr:   result.Stderr,
+			Err:      err,
+		}
+
+		// sekaid often reports JSON errors on stdout
+		if result.Stderr == "" && strings.Contains(result.Stdout, "error") {
+			execErr.Stderr = result.Stdout
+		}
+		return result, execErr
+	}
+
+	return result, nil
+}
+
+// kubectl builds a kubectl command for the given kubeconfig context.
+// It fails if kubectl is not on PATH.
+func kubectl(ctx context.Context, kubeContext string, args ...string) (*exec.Cmd, error) {
+	path, err := exec.LookPath("kubectl")
+	if err != nil {
+		return nil, fmt.Errorf("kubectl not found in PATH: %w", err)
+	}
+	if kubeContext != "" {
+		args = append([]string{"--context", kubeContext}, args...)
+	}
+	return exec.CommandContext(ctx, path, args...), nil
+}
+
+// FindSekaiPod attempts to find a running SEKAI pod in the namespace by
+// common naming patterns, like docker.FindSekaiContainer.
+func FindSekaiPod(ctx context.Context, namespace, kubeContext string) (string, error) {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+
+	cmd, err := kubectl(ctx, kubeContext, "get", "pods",
+		"-n", namespace,
+		"--field-selector", "status.phase=Running",
+		"-o", "jsonpath={.items[*].metadata.name}",
+	)
+	if err != nil {
+		return "", err
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+
+	// Common patterns for SEKAI pods
+	patterns := []string{
+		"sekai",
+		"kira",
+		"sekaid",
+		"validator",
+	}
+
+	for _, pod := range strings.Fields(string(output)) {
+		lower := strings.ToLower(pod)
+		for _, pattern := range patterns {
+			if strings.Contains(lower, pattern) {
+				return pod, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no SEKAI pod found in namespace %s", namespace)
+}
@@ -4,6 +4,7 @@ package mock
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -30,6 +31,9 @@ type Client struct {
 	// TxErrors maps transaction keys to errors.
 	TxErrors map[string]error
 
+	// SimulateResponse is the response for Simulate() calls.
+	SimulateResponse *sdk.SimulateResponse
+
 	// StatusResponse is the response for Status() calls.
 	StatusResponse *sdk.StatusResponse
 
@@ -134,6 +138,85 @@ func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, e
 	}, nil
 }
 
+// Simulate executes a mock simulation. It records the call like Tx and
+// returns SimulateResponse if set, or a fixed estimate otherwise.
+func (c *Client) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.txKey(req)
+	c.TxCalls = append(c.TxCalls, TxCall{Request: req, Key: key})
+
+	if err, ok := c.TxErrors[key]; ok {
+		return nil, err
+	}
+	if c.SimulateResponse != nil {
+		return c.SimulateResponse, nil
+	}
+	return sdk.NewSimulateResponse(100000, 1.3, ""), nil
+}
+
+// GenerateTx records the call like Tx and returns a minimal unsigned
+// transaction describing the request.
+func (c *Client) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.txKey(req)
+	c.TxCalls = append(c.TxCalls, TxCall{Request: req, Key: key})
+
+	if err, ok := c.TxErrors[key]; ok {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": []map[string]interface{}{{"module": req.Module, "action": req.Action, "args": req.Args}},
+			"memo":     req.Flags["memo"],
+		},
+		"signatures": []string{},
+	})
+}
+
+// SignTx returns the transaction with a placeholder signature added.
+func (c *Client) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(tx, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+	parsed["signatures"] = []string{"MOCK_SIGNATURE"}
+	return json.Marshal(parsed)
+}
+
+// BroadcastTx returns a default success response.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	if !json.Valid(tx) {
+		return nil, fmt.Errorf("invalid transaction")
+	}
+	return &sdk.TxResponse{TxHash: "MOCK_TX_HASH_broadcast", Code: 0}, nil
+}
+
+// EncodeTx returns the transaction JSON base64-encoded in place of the
+// protobuf bytes.
+func (c *Client) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	if err := sdk.ValidateTxJSON(tx); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(tx), nil
+}
+
+// DecodeTx reverses EncodeTx.
+func (c *Client) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	txBytes, err := sdk.ValidateTxBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	tx, _ := base64.StdEncoding.DecodeString(txBytes)
+	if !json.Valid(tx) {
+		return nil, fmt.Errorf("invalid transaction")
+	}
+	return tx, nil
+}
+
 // Keys returns the mock keys client.
 func (c *Client) Keys() sdk.KeysClient {
 	return c.keys
@@ -226,6 +309,13 @@ func (c *Client) SetTxError(module, action string, err error) {
 	c.TxErrors[key] = err
 }
 
+// SetSimulateResponse sets the response for Simulate() calls.
+func (c *Client) SetSimulateResponse(resp *sdk.SimulateResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.SimulateResponse = resp
+}
+
 // SetStatus sets the mock status response.
 func (c *Client) SetStatus(resp *sdk.StatusResponse) {
 	c.mu.Lock()
@@ -249,6 +339,7 @@ func (c *Client) Reset() {
 	c.QueryErrors = make(map[string]error)
 	c.TxResponses = make(map[string]*sdk.TxResponse)
 	c.TxErrors = make(map[string]error)
+	c.SimulateResponse = nil
 	c.StatusResponse = nil
 	c.StatusError = nil
 	c.QueryCalls = make([]QueryCall, 0)
@@ -370,6 +461,21 @@ func (k *keysClient) Export(ctx context.Context, name string) (string, error) {
 	return fmt.Sprintf("mock_exported_key_%s", name), nil
 }
 
+func (k *keysClient) ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error) {
+	return k.Export(ctx, name)
+}
+
+func (k *keysClient) ExportHex(ctx context.Context, name string) (string, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if k.keys == nil || k.keys[name] == nil {
+		return "", sdk.ErrKeyNotFound
+	}
+
+	return fmt.Sprintf("%064x", len(name)), nil
+}
+
 func (k *keysClient) Import(ctx context.Context, name, armor string) error {
 	k.mu.Lock()
 	defer k.mu.Unlock()
@@ -390,6 +496,10 @@ func (k *keysClient) Import(ctx context.Context, name, armor string) error {
 	return nil
 }
 
+func (k *keysClient) ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error {
+	return k.Import(ctx, name, armor)
+}
+
 func (k *keysClient) Rename(ctx context.Context, oldName, newName string) error {
 	k.mu.Lock()
 	defer k.mu.Unlock()
@@ -434,6 +544,10 @@ func (k *keysClient) Parse(ctx context.Context, address string) (*sdk.ParsedAddr
 	}, nil
 }
 
+func (k *keysClient) SignMessage(ctx context.Context, name, signer string, data []byte) ([]byte, []byte, error) {
+	return nil, nil, fmt.Errorf("message signing not supported in mock client")
+}
+
 // AddKey is a helper to add a key directly for testing.
 func (k *keysClient) AddKey(info *sdk.KeyInfo) {
 	k.mu.Lock()
@@ -5,10 +5,13 @@ package rest
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,14 +38,48 @@ type Config struct {
 
 	// UseINTERX indicates whether to use INTERX API format.
 	UseINTERX bool
+
+	// MaxAttempts is the total number of attempts for GET requests.
+	MaxAttempts int
+
+	// RetryBaseDelay is the delay before the first retry; it doubles
+	// after each further attempt.
+	RetryBaseDelay time.Duration
+
+	// Height runs queries against the state at this block height; 0 means
+	// the latest block.
+	Height int64
+
+	// Logger, if set, receives every request and its raw response, with
+	// secrets redacted.
+	Logger sdk.Logger
+
+	// MaxIdleConnsPerHost is how many keep-alive connections to the node
+	// are kept open for reuse, e.g. by parallel scenario steps.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is how long an unused keep-alive connection is kept.
+	IdleConnTimeout time.Duration
 }
 
+// Connection pool defaults. Go's default of 2 idle connections per host
+// makes parallel requests to one node open a new connection each time.
+const (
+	DefaultMaxIdleConnsPerHost = 16
+	DefaultIdleConnTimeout     = 90 * time.Second
+)
+
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseURL:   "http://localhost:11000",
-		Timeout:   30 * time.Second,
-		UseINTERX: true,
+		BaseURL:        "http://localhost:11000",
+		Timeout:        30 * time.Second,
+		UseINTERX:      true,
+		MaxAttempts:    1,
+		RetryBaseDelay: DefaultRetryBaseDelay,
+
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
 	}
 }
 
@@ -77,7 +114,32 @@ func WithINTERX(useINTERX bool) Option {
 	}
 }
 
-// NewClient creates a new REST API client.
+// WithHeight runs queries at a past block height, sent in the
+// x-cosmos-block-height header.
+func WithHeight(height int64) Option {
+	return func(c *Config) {
+		c.Height = height
+	}
+}
+
+// WithLogger logs requests and their raw responses.
+func WithLogger(logger sdk.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// WithConnectionPool sets how many keep-alive connections to the node are
+// kept for reuse and for how long an unused one is kept.
+func WithConnectionPool(maxIdlePerHost int, idleTimeout time.Duration) Option {
+	return func(c *Config) {
+		c.MaxIdleConnsPerHost = maxIdlePerHost
+		c.IdleConnTimeout = idleTimeout
+	}
+}
+
+// NewClient creates a new REST API client. Its connections are pooled and
+// kept alive, and it is safe for concurrent use.
 func NewClient(baseURL string, opts ...Option) (*Client, error) {
 	if baseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
@@ -90,10 +152,18 @@ func NewClient(baseURL string, opts ...Option) (*Client, error) {
 		opt(cfg)
 	}
 
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	if cfg.MaxIdleConnsPerHost > transport.MaxIdleConns {
+		transport.MaxIdleConns = cfg.MaxIdleConnsPerHost
+	}
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+
 	c := &Client{
 		config: cfg,
 		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
+			Timeout:   cfg.Timeout,
+			Transport: transport,
 		},
 	}
 	c.keys = &keysClient{client: c}
@@ -111,29 +181,9 @@ func (c *Client) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryRe
 	url := c.buildQueryURL(req)
 
 	// Make HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, &sdk.HTTPError{URL: url, Err: err}
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.get(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &sdk.HTTPError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(body),
-			URL:        url,
-		}
+		return nil, err
 	}
 
 	// Check for INTERX error response
@@ -163,6 +213,146 @@ func (c *Client) Tx(ctx context.Context, req *sdk.TxRequest) (*sdk.TxResponse, e
 	return nil, sdk.ErrNotSupported
 }
 
+// Simulate is not supported because the REST client cannot build or sign
+// transactions. Use SimulateTxBytes with an encoded transaction instead.
+func (c *Client) Simulate(ctx context.Context, req *sdk.TxRequest) (*sdk.SimulateResponse, error) {
+	return nil, sdk.ErrNotSupported
+}
+
+// SimulateTxBytes simulates an encoded transaction via the Cosmos
+// /cosmos/tx/v1beta1/simulate endpoint.
+func (c *Client) SimulateTxBytes(ctx context.Context, txBytes []byte, gasAdjustment float64) (*sdk.SimulateResponse, error) {
+	data, err := c.Post(ctx, "/cosmos/tx/v1beta1/simulate", map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+
+	var result struct {
+		GasInfo struct {
+			GasUsed string `json:"gas_used"`
+		} `json:"gas_info"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse simulate response: %w", err)
+	}
+	gas, err := strconv.ParseUint(result.GasInfo.GasUsed, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas_used in simulate response: %q", result.GasInfo.GasUsed)
+	}
+	return sdk.NewSimulateResponse(gas, gasAdjustment, ""), nil
+}
+
+// GenerateTx is not supported because the REST client cannot build
+// transactions.
+func (c *Client) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	return nil, sdk.ErrNotSupported
+}
+
+// SignTx is not supported because the REST client has no keyring.
+func (c *Client) SignTx(ctx context.Context, tx []byte, opts *sdk.SignOptions) ([]byte, error) {
+	return nil, sdk.ErrNotSupported
+}
+
+// BroadcastTx submits a signed transaction via the Cosmos
+// /cosmos/tx/v1beta1/txs endpoint. The endpoint only accepts protobuf
+// encoded transactions, so tx must be the base64 tx bytes (as printed by
+// "sekaid tx encode"), not the signed JSON.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	encoded := strings.TrimSpace(string(tx))
+	if strings.HasPrefix(encoded, "{") {
+		return nil, fmt.Errorf("REST broadcast requires a base64-encoded transaction; encode the signed JSON with 'sekai-cli tx encode' first")
+	}
+	if _, err := base64.StdEncoding.DecodeString(encoded); err != nil {
+		return nil, fmt.Errorf("invalid base64 transaction: %w", err)
+	}
+
+	data, err := c.Post(ctx, "/cosmos/tx/v1beta1/txs", map[string]string{
+		"tx_bytes": encoded,
+		"mode":     restBroadcastMode(mode),
+	})
+	if err != nil {
+		return nil, sdk.ParseInsufficientFunds(fmt.Errorf("failed to broadcast transaction: %w", err))
+	}
+
+	var result struct {
+		TxResponse sdk.TxResponse `json:"tx_response"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse broadcast response: %w", err)
+	}
+	if result.TxResponse.Code != 0 {
+		return &result.TxResponse, sdk.ParseInsufficientFunds(sdk.NewTxErrorFromResponse("tx", "broadcast", &result.TxResponse))
+	}
+	return &result.TxResponse, nil
+}
+
+// EncodeTx encodes a transaction JSON via the Cosmos
+// /cosmos/tx/v1beta1/encode endpoint.
+func (c *Client) EncodeTx(ctx context.Context, tx []byte) (string, error) {
+	if err := sdk.ValidateTxJSON(tx); err != nil {
+		return "", err
+	}
+
+	data, err := c.Post(ctx, "/cosmos/tx/v1beta1/encode", map[string]json.RawMessage{
+		"tx": json.RawMessage(tx),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	var result struct {
+		TxBytes string `json:"tx_bytes"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return "", fmt.Errorf("failed to parse encode response: %w", err)
+	}
+	if result.TxBytes == "" {
+		return "", fmt.Errorf("failed to parse encode response: empty tx_bytes")
+	}
+	return result.TxBytes, nil
+}
+
+// DecodeTx decodes base64 transaction bytes via the Cosmos
+// /cosmos/tx/v1beta1/decode endpoint.
+func (c *Client) DecodeTx(ctx context.Context, txBytes string) ([]byte, error) {
+	txBytes, err := sdk.ValidateTxBytes(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Post(ctx, "/cosmos/tx/v1beta1/decode", map[string]string{
+		"tx_bytes": txBytes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	var result struct {
+		Tx json.RawMessage `json:"tx"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse decode response: %w", err)
+	}
+	if len(result.Tx) == 0 {
+		return nil, fmt.Errorf("failed to parse decode response: missing tx")
+	}
+	return result.Tx, nil
+}
+
+// restBroadcastMode maps a CLI broadcast mode to the Cosmos REST enum.
+// "block" was removed from newer Cosmos SDK releases and is sent as sync;
+// callers wait for inclusion themselves.
+func restBroadcastMode(mode string) string {
+	switch mode {
+	case "async":
+		return "BROADCAST_MODE_ASYNC"
+	default:
+		return "BROADCAST_MODE_SYNC"
+	}
+}
+
 // Keys returns the keyring client.
 // Note: Keys are stored locally, not accessible via REST.
 func (c *Client) Keys() sdk.KeysClient {
@@ -178,42 +368,76 @@ func (c *Client) Status(ctx context.Context) (*sdk.StatusResponse, error) {
 		url = c.config.BaseURL + "/cosmos/base/tendermint/v1beta1/node_info"
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	body, err := c.get(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, &sdk.HTTPError{URL: url, Err: err}
+	// Parse INTERX status response
+	if c.config.UseINTERX {
+		return c.parseINTERXStatus(body)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	// Parse standard Cosmos status response
+	return c.parseCosmosStatus(body)
+}
+
+// NetInfo returns the peer connections of the node. Only INTERX exposes
+// the RPC net_info endpoint.
+func (c *Client) NetInfo(ctx context.Context) (*sdk.NetInfo, error) {
+	if !c.config.UseINTERX {
+		return nil, sdk.ErrNotSupported
+	}
+	body, err := c.get(ctx, c.config.BaseURL+"/api/net_info")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
+	return sdk.ParseNetInfo(body)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, &sdk.HTTPError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(body),
-			URL:        url,
+// AppVersion returns the sekaid version of the node: the sekai_version
+// INTERX reports, or the application version of the Cosmos node_info.
+func (c *Client) AppVersion(ctx context.Context) (string, error) {
+	if c.config.UseINTERX {
+		body, err := c.get(ctx, c.config.BaseURL+"/api/status")
+		if err != nil {
+			return "", err
+		}
+		var resp struct {
+			InterxInfo struct {
+				SekaiVersion string `json:"sekai_version"`
+			} `json:"interx_info"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return "", fmt.Errorf("failed to parse status: %w", err)
+		}
+		if resp.InterxInfo.SekaiVersion == "" {
+			return "", fmt.Errorf("INTERX status has no sekai_version")
 		}
+		return resp.InterxInfo.SekaiVersion, nil
 	}
 
-	// Parse INTERX status response
-	if c.config.UseINTERX {
-		return c.parseINTERXStatus(body)
+	body, err := c.get(ctx, c.config.BaseURL+"/cosmos/base/tendermint/v1beta1/node_info")
+	if err != nil {
+		return "", err
 	}
-
-	// Parse standard Cosmos status response
-	return c.parseCosmosStatus(body)
+	var resp struct {
+		ApplicationVersion struct {
+			Version string `json:"version"`
+		} `json:"application_version"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("failed to parse node info: %w", err)
+	}
+	if resp.ApplicationVersion.Version == "" {
+		return "", fmt.Errorf("node info has no application version")
+	}
+	return resp.ApplicationVersion.Version, nil
 }
 
 // Close releases resources (no-op for REST client).
 func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
 	return nil
 }
 
@@ -240,7 +464,11 @@ func (c *Client) buildQueryURL(req *sdk.QueryRequest) string {
 	var params []string
 
 	// Add simple key-value params
-	for k, v := range req.Params {
+	reqParams := req.Params
+	if !c.config.UseINTERX {
+		reqParams = paginationParams(req.Params)
+	}
+	for k, v := range reqParams {
 		if v != "" {
 			params = append(params, fmt.Sprintf("%s=%s", k, v))
 		}
@@ -262,6 +490,40 @@ func (c *Client) buildQueryURL(req *sdk.QueryRequest) string {
 	return url
 }
 
+// paginationParams translates sekaid-style pagination params (limit, offset,
+// page, page-key, count-total, reverse) to Cosmos REST pagination.* params.
+// A page number is converted to an offset using the limit.
+func paginationParams(in map[string]string) map[string]string {
+	names := map[string]string{
+		"limit":       "pagination.limit",
+		"offset":      "pagination.offset",
+		"page-key":    "pagination.key",
+		"count-total": "pagination.count_total",
+		"reverse":     "pagination.reverse",
+	}
+
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		if k == "page-key" {
+			v = url.QueryEscape(v)
+		}
+		if name, ok := names[k]; ok {
+			out[name] = v
+		} else if k != "page" {
+			out[k] = v
+		}
+	}
+
+	if page, err := strconv.ParseUint(in["page"], 10, 64); err == nil && page > 1 {
+		limit, err := strconv.ParseUint(in["limit"], 10, 64)
+		if err != nil || limit == 0 {
+			limit = 100
+		}
+		out["pagination.offset"] = strconv.FormatUint((page-1)*limit, 10)
+	}
+	return out
+}
+
 // buildINTERXPath builds INTERX API path for a query.
 func (c *Client) buildINTERXPath(req *sdk.QueryRequest) string {
 	// INTERX uses /api/kira/ prefix for most endpoints
@@ -290,6 +552,10 @@ func (c *Client) buildINTERXPath(req *sdk.QueryRequest) string {
 		}
 	case "status":
 		return "/api/status"
+	case "tx":
+		if len(req.RawArgs) > 0 {
+			return "/api/cosmos/txs/" + req.RawArgs[0]
+		}
 	}
 
 	// Default: try to map directly
@@ -302,12 +568,26 @@ func (c *Client) buildCosmosPath(req *sdk.QueryRequest) string {
 	case "bank":
 		switch req.Endpoint {
 		case "balances":
+			if len(req.RawArgs) > 0 && req.Params["denom"] != "" {
+				return "/cosmos/bank/v1beta1/balances/" + req.RawArgs[0] + "/by_denom"
+			}
 			if len(req.RawArgs) > 0 {
 				return "/cosmos/bank/v1beta1/balances/" + req.RawArgs[0]
 			}
 			return "/cosmos/bank/v1beta1/balances"
+		case "spendable-balances":
+			if len(req.RawArgs) > 0 && req.Params["denom"] != "" {
+				return "/cosmos/bank/v1beta1/spendable_balances/" + req.RawArgs[0] + "/by_denom"
+			}
+			if len(req.RawArgs) > 0 {
+				return "/cosmos/bank/v1beta1/spendable_balances/" + req.RawArgs[0]
+			}
 		case "total", "supply":
 			return "/cosmos/bank/v1beta1/supply"
+		case "denom-owners":
+			if len(req.RawArgs) > 0 {
+				return "/cosmos/bank/v1beta1/denom_owners/" + req.RawArgs[0]
+			}
 		}
 	case "auth":
 		switch req.Endpoint {
@@ -322,6 +602,15 @@ func (c *Client) buildCosmosPath(req *sdk.QueryRequest) string {
 		case "validators":
 			return "/cosmos/staking/v1beta1/validators"
 		}
+	case "tx":
+		if len(req.RawArgs) > 0 {
+			return "/cosmos/tx/v1beta1/txs/" + req.RawArgs[0]
+		}
+	case "customevidence", "evidence":
+		if len(req.RawArgs) > 0 {
+			return "/cosmos/evidence/v1beta1/evidence/" + req.RawArgs[0]
+		}
+		return "/cosmos/evidence/v1beta1/evidence"
 	}
 
 	return fmt.Sprintf("/cosmos/%s/v1beta1/%s", req.Module, req.Endpoint)
@@ -408,34 +697,7 @@ func parseHeight(s string) int64 {
 
 // Get makes a GET request and returns the response body.
 func (c *Client) Get(ctx context.Context, path string) ([]byte, error) {
-	url := c.config.BaseURL + path
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, &sdk.HTTPError{URL: url, Err: err}
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, &sdk.HTTPError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(body),
-			URL:        url,
-		}
-	}
-
-	return body, nil
+	return c.get(ctx, c.config.BaseURL+path)
 }
 
 // Post makes a POST request and returns the response body.
@@ -449,6 +711,9 @@ func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byt
 			return nil, fmt.Errorf("failed to marshal data: %w", err)
 		}
 		body = bytes.NewReader(jsonData)
+		c.logf("POST %s %s", url, sdk.RedactText(string(jsonData)))
+	} else {
+		c.logf("POST %s", url)
 	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, body)
@@ -459,6 +724,7 @@ func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byt
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
+		c.logf("error: %v", err)
 		return nil, &sdk.HTTPError{URL: url, Err: err}
 	}
 	defer resp.Body.Close()
@@ -467,6 +733,7 @@ func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byt
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
+	c.logf("%s: %s", resp.Status, sdk.RedactText(string(respBody)))
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, &sdk.HTTPError{
@@ -480,6 +747,13 @@ func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byt
 	return respBody, nil
 }
 
+// logf logs a debug message if a logger is set.
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.config.Logger != nil {
+		c.config.Logger.Printf(format, args...)
+	}
+}
+
 // keysClient is a REST implementation of sdk.KeysClient.
 // Most operations return ErrNotSupported as keys are local.
 type keysClient struct {
@@ -506,10 +780,22 @@ func (k *keysClient) Export(ctx context.Context, name string) (string, error) {
 	return "", sdk.ErrNotSupported
 }
 
+func (k *keysClient) ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error) {
+	return "", sdk.ErrNotSupported
+}
+
+func (k *keysClient) ExportHex(ctx context.Context, name string) (string, error) {
+	return "", sdk.ErrNotSupported
+}
+
 func (k *keysClient) Import(ctx context.Context, name, armor string) error {
 	return sdk.ErrNotSupported
 }
 
+func (k *keysClient) ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error {
+	return sdk.ErrNotSupported
+}
+
 func (k *keysClient) Rename(ctx context.Context, oldName, newName string) error {
 	return sdk.ErrNotSupported
 }
@@ -533,3 +819,7 @@ func (k *keysClient) Migrate(ctx context.Context) error {
 func (k *keysClient) Parse(ctx context.Context, address string) (*sdk.ParsedAddress, error) {
 	return nil, sdk.ErrNotSupported
 }
+
+func (k *keysClient) SignMessage(ctx context.Context, name, signer string, data []byte) ([]byte, []byte, error) {
+	return nil, nil, sdk.ErrNotSupported
+}
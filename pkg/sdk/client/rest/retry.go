@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// DefaultRetryBaseDelay is the delay before the first retry when
+// WithRetry is given a non-positive base delay.
+const DefaultRetryBaseDelay = 500 * time.Millisecond
+
+// WithRetry enables retries with exponential backoff for GET requests.
+// maxAttempts is the total number of attempts (1 disables retries); the
+// delay doubles after each failed attempt starting at baseDelay.
+// Transactions are never retried, so a broadcast is never sent twice.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Config) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		if baseDelay <= 0 {
+			baseDelay = DefaultRetryBaseDelay
+		}
+		c.MaxAttempts = maxAttempts
+		c.RetryBaseDelay = baseDelay
+	}
+}
+
+// heightHeader selects the block height a Cosmos REST query is answered at.
+const heightHeader = "x-cosmos-block-height"
+
+// get performs an idempotent GET request, retrying transient failures
+// according to the retry configuration. The returned error wraps the
+// errors of all attempts.
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	attempts := c.config.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var errs []error
+	delay := c.config.RetryBaseDelay
+	for attempt := 1; ; attempt++ {
+		body, err := c.getOnce(ctx, url)
+		if err == nil {
+			return body, nil
+		}
+		errs = append(errs, fmt.Errorf("attempt %d: %w", attempt, err))
+
+		if attempt >= attempts || !isRetryable(err) || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, retryError(append(errs, ctx.Err()), attempt)
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return nil, retryError(errs, len(errs))
+}
+
+// getOnce performs a single GET request.
+func (c *Client) getOnce(ctx context.Context, url string) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.config.Height > 0 {
+		httpReq.Header.Set(heightHeader, strconv.FormatInt(c.config.Height, 10))
+		c.logf("GET %s (%s: %d)", url, heightHeader, c.config.Height)
+	} else {
+		c.logf("GET %s", url)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		c.logf("error: %v", err)
+		return nil, &sdk.HTTPError{URL: url, Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &sdk.HTTPError{URL: url, Err: fmt.Errorf("failed to read response: %w", err)}
+	}
+	c.logf("%s: %s", resp.Status, sdk.RedactText(string(body)))
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &sdk.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       string(body),
+			URL:        url,
+		}
+	}
+
+	return body, nil
+}
+
+// isRetryable reports whether a request error is transient: a connection
+// failure or a 429/502/503/504 response. Context cancellation is not.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var httpErr *sdk.HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+	if httpErr.Err != nil {
+		return true
+	}
+	switch httpErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryError returns the error of a single failed attempt unchanged, or
+// joins the errors of all attempts.
+func retryError(errs []error, attempts int) error {
+	if len(errs) == 1 {
+		return errors.Unwrap(errs[0])
+	}
+	return fmt.Errorf("request failed after %d attempts: %w", attempts, errors.Join(errs...))
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range of sekaid versions the SDK's commands, flags and output parsing
+// are written against: at least MinSekaidVersion and below
+// MaxSekaidVersion. Other versions may work but are not expected to.
+//
+// The range must cover the sekaid the integration tests run against: the
+// sekai service of github.com/kiracore/sekin's compose file, started by the
+// integration-test job in .github/workflows/ci.yml, which logs its
+// 'sekaid version'. TestStatusAppVersion fails when that version falls
+// outside the range, so move the range with the sekin image.
+const (
+	MinSekaidVersion = "v0.3.0"
+	MaxSekaidVersion = "v0.5.0"
+)
+
+// AppVersionClient is implemented by clients that can report the version
+// of the sekaid application behind the node.
+type AppVersionClient interface {
+	// AppVersion returns the sekaid version, e.g. "v0.4.1".
+	AppVersion(ctx context.Context) (string, error)
+}
+
+// SekaidVersionRange describes the supported sekaid versions.
+func SekaidVersionRange() string {
+	return ">= " + MinSekaidVersion + ", < " + MaxSekaidVersion
+}
+
+// IsSupportedSekaidVersion reports whether version lies within
+// MinSekaidVersion and MaxSekaidVersion. Pre-release and build suffixes
+// are ignored. It returns an error if version is not a semantic version.
+func IsSupportedSekaidVersion(version string) (bool, error) {
+	v, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+	min, _ := parseVersion(MinSekaidVersion)
+	max, _ := parseVersion(MaxSekaidVersion)
+	return compareVersions(v, min) >= 0 && compareVersions(v, max) < 0, nil
+}
+
+// parseVersion parses the major, minor and patch numbers of a version
+// such as "v0.4.1", "0.4" or "v0.4.1-rc.2".
+func parseVersion(version string) ([3]int, error) {
+	var parts [3]int
+	s := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	fields := strings.Split(s, ".")
+	if s == "" || len(fields) > 3 {
+		return parts, fmt.Errorf("invalid version %q", version)
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid version %q", version)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
+
+// compareVersions returns -1, 0 or 1 as a is below, equal to or above b.
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,191 @@
+// Package secp256k1 implements the secp256k1 ECDSA operations needed to
+// verify off-chain messages signed with Cosmos keys: verification of
+// 64-byte r||s signatures over the SHA-256 hash of a message and address
+// derivation from compressed public keys.
+//
+// The arithmetic uses math/big and is not constant time, which is fine
+// for verification since it handles only public data. There is no
+// signing; keys stay in the sekaid keyring.
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/crypto/ripemd160"
+)
+
+// Sizes of public keys and signatures in bytes.
+const (
+	PubKeySize    = 33
+	SignatureSize = 64
+)
+
+// ErrInvalidPubKey indicates a malformed compressed public key.
+var ErrInvalidPubKey = errors.New("invalid secp256k1 public key")
+
+// Curve parameters (SEC 2, section 2.4.1).
+var (
+	curveP, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 16)
+	curveN, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+	curveGx, _ = new(big.Int).SetString("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798", 16)
+	curveGy, _ = new(big.Int).SetString("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8", 16)
+	halfN      = new(big.Int).Rsh(curveN, 1)
+)
+
+// point is an affine curve point; a nil x is the point at infinity.
+type point struct {
+	x, y *big.Int
+}
+
+func (p point) infinity() bool {
+	return p.x == nil
+}
+
+// add returns p + q.
+func add(p, q point) point {
+	if p.infinity() {
+		return q
+	}
+	if q.infinity() {
+		return p
+	}
+	if p.x.Cmp(q.x) == 0 {
+		if p.y.Cmp(q.y) != 0 || p.y.Sign() == 0 {
+			return point{}
+		}
+		return double(p)
+	}
+
+	// lambda = (qy - py) / (qx - px)
+	num := new(big.Int).Sub(q.y, p.y)
+	den := new(big.Int).Sub(q.x, p.x)
+	den.ModInverse(den.Mod(den, curveP), curveP)
+	lambda := num.Mul(num, den)
+	lambda.Mod(lambda, curveP)
+	return fromLambda(lambda, p, q.x)
+}
+
+// double returns 2p.
+func double(p point) point {
+	if p.infinity() || p.y.Sign() == 0 {
+		return point{}
+	}
+
+	// lambda = 3px^2 / 2py (a = 0)
+	num := new(big.Int).Mul(p.x, p.x)
+	num.Mul(num, big.NewInt(3))
+	den := new(big.Int).Lsh(p.y, 1)
+	den.ModInverse(den.Mod(den, curveP), curveP)
+	lambda := num.Mul(num, den)
+	lambda.Mod(lambda, curveP)
+	return fromLambda(lambda, p, p.x)
+}
+
+// fromLambda completes point addition given the slope through p.
+func fromLambda(lambda *big.Int, p point, qx *big.Int) point {
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, p.x)
+	x.Sub(x, qx)
+	x.Mod(x, curveP)
+
+	y := new(big.Int).Sub(p.x, x)
+	y.Mul(y, lambda)
+	y.Sub(y, p.y)
+	y.Mod(y, curveP)
+	return point{x: x, y: y}
+}
+
+// scalarMult returns k*p using double-and-add.
+func scalarMult(k *big.Int, p point) point {
+	result := point{}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = double(result)
+		if k.Bit(i) == 1 {
+			result = add(result, p)
+		}
+	}
+	return result
+}
+
+// generator returns the base point G.
+func generator() point {
+	return point{x: curveGx, y: curveGy}
+}
+
+// decompress decodes a 33-byte compressed public key.
+func decompress(pub []byte) (point, error) {
+	if len(pub) != PubKeySize || (pub[0] != 0x02 && pub[0] != 0x03) {
+		return point{}, ErrInvalidPubKey
+	}
+	x := new(big.Int).SetBytes(pub[1:])
+	if x.Cmp(curveP) >= 0 {
+		return point{}, ErrInvalidPubKey
+	}
+
+	// y^2 = x^3 + 7; p = 3 mod 4, so y = (y^2)^((p+1)/4)
+	y2 := new(big.Int).Exp(x, big.NewInt(3), curveP)
+	y2.Add(y2, big.NewInt(7))
+	y2.Mod(y2, curveP)
+	exp := new(big.Int).Add(curveP, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(y2, exp, curveP)
+	if new(big.Int).Exp(y, big.NewInt(2), curveP).Cmp(y2) != 0 {
+		return point{}, ErrInvalidPubKey
+	}
+	if y.Bit(0) != uint(pub[0]&1) {
+		y.Sub(curveP, y)
+	}
+	return point{x: x, y: y}, nil
+}
+
+// Verify reports whether sig is a valid low-S signature of the SHA-256
+// hash of msg by the compressed public key pub.
+func Verify(pub, msg, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	q, err := decompress(pub)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if r.Sign() == 0 || r.Cmp(curveN) >= 0 || s.Sign() == 0 || s.Cmp(halfN) > 0 {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	z := hashToInt(hash[:])
+
+	w := new(big.Int).ModInverse(s, curveN)
+	u1 := new(big.Int).Mul(z, w)
+	u1.Mod(u1, curveN)
+	u2 := new(big.Int).Mul(r, w)
+	u2.Mod(u2, curveN)
+
+	p := add(scalarMult(u1, generator()), scalarMult(u2, q))
+	if p.infinity() {
+		return false
+	}
+	return new(big.Int).Mod(p.x, curveN).Cmp(r) == 0
+}
+
+// Address returns the 20-byte Cosmos address of a compressed public key:
+// RIPEMD-160(SHA-256(pub)).
+func Address(pub []byte) ([]byte, error) {
+	if _, err := decompress(pub); err != nil {
+		return nil, err
+	}
+	sha := sha256.Sum256(pub)
+	addr := ripemd160.Sum(sha[:])
+	return addr[:], nil
+}
+
+// hashToInt converts a 32-byte hash to an integer (n is 256 bits, so no
+// truncation is needed).
+func hashToInt(hash []byte) *big.Int {
+	return new(big.Int).SetBytes(hash)
+}
@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Logger receives debug output from clients: the commands and requests
+// they issue and the raw responses. A *log.Logger satisfies it. Clients
+// redact secrets before logging.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redacted replaces secret values in debug output.
+const redacted = "[REDACTED]"
+
+// secretFlags are flags whose values are secrets.
+var secretFlags = []string{"passphrase", "password", "mnemonic", "private-key", "priv-key"}
+
+// secretPositionals maps commands to the index of a positional argument
+// holding a secret, e.g. the key in "keys import-hex <name> <hex>".
+var secretPositionals = map[string]int{"import-hex": 1}
+
+// secretOutputFlags are flags that make a command print a secret, e.g.
+// the private key of "keys export --unarmored-hex".
+var secretOutputFlags = []string{"unarmored-hex"}
+
+var (
+	armorPattern  = regexp.MustCompile(`(?s)-----BEGIN [^-]+-----.*?-----END [^-]+-----`)
+	secretPattern = regexp.MustCompile(`(?i)("(?:mnemonic|passphrase|password|armor|priv_key|private_key)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// RedactArgs returns a copy of command arguments with the values of
+// secret flags replaced, for "--flag value" and "--flag=value" forms, and
+// with secret positional arguments such as an imported hex key replaced.
+func RedactArgs(args []string) []string {
+	out := make([]string, len(args))
+	copy(out, args)
+	for i := 0; i < len(out); i++ {
+		if pos, ok := secretPositionals[out[i]]; ok {
+			redactPositional(out[i+1:], pos)
+			continue
+		}
+		if !strings.HasPrefix(out[i], "--") {
+			continue
+		}
+		name, _, hasValue := strings.Cut(strings.TrimPrefix(out[i], "--"), "=")
+		if !isSecretFlag(name) {
+			continue
+		}
+		if hasValue {
+			out[i] = "--" + name + "=" + redacted
+		} else if i+1 < len(out) {
+			out[i+1] = redacted
+			i++
+		}
+	}
+	return out
+}
+
+// redactPositional replaces the positional argument at index pos of the
+// arguments following a command word, skipping flags.
+func redactPositional(args []string, pos int) {
+	for i := range args {
+		if strings.HasPrefix(args[i], "-") {
+			continue
+		}
+		if pos == 0 {
+			args[i] = redacted
+			return
+		}
+		pos--
+	}
+}
+
+// HasSecretOutput reports whether a command prints a secret, so that its
+// output must not be logged at all.
+func HasSecretOutput(args []string) bool {
+	for _, arg := range args {
+		name, _, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if strings.HasPrefix(arg, "--") && slices.Contains(secretOutputFlags, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactText replaces ASCII-armored keys and JSON fields holding
+// mnemonics, passphrases or armor in command output or request bodies.
+func RedactText(s string) string {
+	s = armorPattern.ReplaceAllString(s, redacted)
+	return secretPattern.ReplaceAllString(s, `$1"`+redacted+`"`)
+}
+
+// isSecretFlag reports whether a flag holds a secret.
+func isSecretFlag(name string) bool {
+	for _, secret := range secretFlags {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,8 +1,13 @@
 package sdk
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 // Common SDK errors.
@@ -39,8 +44,244 @@ var (
 
 	// ErrTxFailed indicates a transaction failed.
 	ErrTxFailed = errors.New("transaction failed")
+
+	// ErrTxNotFound indicates a transaction is not (yet) included in a block.
+	ErrTxNotFound = errors.New("transaction not found")
+
+	// ErrNotFound indicates the queried object (account, proposal, ...)
+	// does not exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrInsufficientFees indicates the transaction fee is below the
+	// network minimum.
+	ErrInsufficientFees = errors.New("insufficient fees")
+
+	// ErrAccountSequence indicates the transaction was signed with a stale
+	// account sequence, usually because a previous transaction from the
+	// same account is not yet in a block.
+	ErrAccountSequence = errors.New("account sequence mismatch")
+
+	// ErrConnection indicates the node or container could not be reached.
+	ErrConnection = errors.New("connection failed")
+
+	// ErrHeightUnavailable indicates the node cannot answer a query at the
+	// requested height, because it pruned that state or has not reached it.
+	ErrHeightUnavailable = errors.New("height not available")
+)
+
+// The client error types (ExecutionError, HTTPError, TxError) match these
+// errors with errors.Is by classifying the node's output, HTTP status or
+// transaction code. Unwrap still returns the underlying error.
+
+// IsNotFound reports whether err means a key, transaction or queried object
+// does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrTxNotFound)
+}
+
+// IsInsufficientFees reports whether err means the fee was too low.
+func IsInsufficientFees(err error) bool {
+	return errors.Is(err, ErrInsufficientFees)
+}
+
+// IsInsufficientFunds reports whether err means the account cannot cover
+// the amount or fee.
+func IsInsufficientFunds(err error) bool {
+	return errors.Is(err, ErrInsufficientFunds)
+}
+
+// IsAccountSequence reports whether err is an account sequence mismatch.
+func IsAccountSequence(err error) bool {
+	return errors.Is(err, ErrAccountSequence)
+}
+
+// expectedSequencePattern matches the sequence sekaid reports in an account
+// sequence mismatch, e.g. "account sequence mismatch, expected 5, got 4".
+var expectedSequencePattern = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// ExpectedSequence returns the account sequence the node expected when err
+// is an account sequence mismatch that reports it.
+func ExpectedSequence(err error) (uint64, bool) {
+	if !IsAccountSequence(err) {
+		return 0, false
+	}
+	m := expectedSequencePattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0, false
+	}
+	seq, perr := strconv.ParseUint(m[1], 10, 64)
+	if perr != nil {
+		return 0, false
+	}
+	return seq, true
+}
+
+// Patterns of the amounts sekaid reports when an account cannot cover a
+// transaction, e.g. "spendable balance 10ukex is smaller than 100ukex:
+// insufficient funds" or "insufficient fees; got: 10ukex required: 100ukex".
+// The first group is the available amount, the second the needed one.
+var (
+	balancePattern = regexp.MustCompile(`(?:spendable balance )?(\S*) is smaller than ([^\s:;,]+)`)
+	accountPattern = regexp.MustCompile(`insufficient account funds; (\S*) < ([^\s:;,]+)`)
+	feePattern     = regexp.MustCompile(`got: ?(\S*) required: ?([^\s:;,]+)`)
 )
 
+// InsufficientFundsError is a transaction that failed because the account
+// cannot cover its amount or fee, with the amounts the node reported.
+type InsufficientFundsError struct {
+	// Kind is ErrInsufficientFunds or ErrInsufficientFees
+	Kind error
+
+	// Needed is the amount the transaction required
+	Needed string
+
+	// Available is the amount the account had, "0" for an empty balance
+	Available string
+
+	// Err is the original error with the node's raw log
+	Err error
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("%v: needed %s, available %s", e.Kind, e.Needed, e.Available)
+}
+
+func (e *InsufficientFundsError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches the kind of the failure.
+func (e *InsufficientFundsError) Is(target error) bool {
+	return target == e.Kind
+}
+
+// ParseInsufficientFunds returns err as an *InsufficientFundsError when it
+// is an insufficient funds or fees failure whose message reports the
+// amounts, and err unchanged otherwise.
+func ParseInsufficientFunds(err error) error {
+	var kind error
+	patterns := []*regexp.Regexp{balancePattern, accountPattern}
+	switch {
+	case err == nil:
+		return nil
+	case IsInsufficientFees(err):
+		kind, patterns = ErrInsufficientFees, []*regexp.Regexp{feePattern}
+	case IsInsufficientFunds(err):
+		kind = ErrInsufficientFunds
+	default:
+		return err
+	}
+	var parsed *InsufficientFundsError
+	if errors.As(err, &parsed) {
+		return err
+	}
+	for _, p := range patterns {
+		if m := p.FindStringSubmatch(err.Error()); m != nil {
+			available := m[1]
+			if available == "" {
+				available = "0"
+			}
+			return &InsufficientFundsError{Kind: kind, Needed: m[2], Available: available, Err: err}
+		}
+	}
+	return err
+}
+
+// IsConnection reports whether err means the node could not be reached.
+func IsConnection(err error) bool {
+	return errors.Is(err, ErrConnection) || errors.Is(err, ErrNotConnected)
+}
+
+// IsValidation reports whether err means the request was malformed, e.g.
+// an invalid address or amount.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrInvalidAddress) || errors.Is(err, ErrInvalidAmount)
+}
+
+// ErrorKind returns a short name for the kind of err, e.g. "not_found" or
+// "account_sequence", or "" if it is not classified.
+func ErrorKind(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case IsAccountSequence(err):
+		return "account_sequence"
+	case IsInsufficientFees(err):
+		return "insufficient_fees"
+	case IsInsufficientFunds(err):
+		return "insufficient_funds"
+	case errors.Is(err, ErrUnauthorized):
+		return "unauthorized"
+	case IsValidation(err):
+		return "validation"
+	case IsConnection(err):
+		return "connection"
+	case errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, ErrHeightUnavailable):
+		return "height_unavailable"
+	case IsNotFound(err):
+		return "not_found"
+	default:
+		return ""
+	}
+}
+
+// messageKinds maps fragments of sekaid and container runtime messages to
+// error kinds. The first match wins, so more specific fragments come first.
+var messageKinds = []struct {
+	fragment string
+	kind     error
+}{
+	{"account sequence mismatch", ErrAccountSequence},
+	{"incorrect account sequence", ErrAccountSequence},
+	{"insufficient fee", ErrInsufficientFees},
+	{"insufficient funds", ErrInsufficientFunds},
+	{"insufficient account funds", ErrInsufficientFunds},
+	{"unauthorized", ErrUnauthorized},
+	{"decoding bech32 failed", ErrInvalidAddress},
+	{"invalid address", ErrInvalidAddress},
+	{"invalid coins", ErrInvalidAmount},
+	{"invalid decimal coin", ErrInvalidAmount},
+	{"invalid amount", ErrInvalidAmount},
+	{"connection refused", ErrConnection},
+	{"connection reset", ErrConnection},
+	{"no such host", ErrConnection},
+	{"network is unreachable", ErrConnection},
+	{"cannot connect to the docker daemon", ErrConnection},
+	{"no such container", ErrConnection},
+	{"is not running", ErrConnection},
+	{"version does not exist", ErrHeightUnavailable},
+	{"failed to load state at height", ErrHeightUnavailable},
+	{"lowest height is", ErrHeightUnavailable},
+	{"current blockchain height", ErrHeightUnavailable},
+	{"not found", ErrNotFound},
+	{"does not exist", ErrNotFound},
+	{"doesn't exist", ErrNotFound},
+}
+
+// classifyMessage returns the error kind of a node or runtime message, or
+// nil if it is not recognized.
+func classifyMessage(msg string) error {
+	msg = strings.ToLower(msg)
+	for _, mk := range messageKinds {
+		if strings.Contains(msg, mk.fragment) {
+			return mk.kind
+		}
+	}
+	return nil
+}
+
+// sdkCodeKinds maps Cosmos SDK error codes (codespace "sdk") to error kinds.
+var sdkCodeKinds = map[uint32]error{
+	4:  ErrUnauthorized,
+	5:  ErrInsufficientFunds,
+	7:  ErrInvalidAddress,
+	10: ErrInvalidAmount,
+	13: ErrInsufficientFees,
+	32: ErrAccountSequence,
+}
+
 // QueryError represents an error during a query operation.
 type QueryError struct {
 	// Module is the module that was queried
@@ -77,6 +318,9 @@ type TxError struct {
 
 	// RawLog contains the raw error log from the node
 	RawLog string
+
+	// Codespace is the module namespace of Code (e.g. "sdk")
+	Codespace string
 }
 
 func (e *TxError) Error() string {
@@ -90,6 +334,15 @@ func (e *TxError) Unwrap() error {
 	return e.Err
 }
 
+// Is matches the error kind of the transaction's code or raw log.
+func (e *TxError) Is(target error) bool {
+	kind := classifyMessage(e.RawLog)
+	if kind == nil && (e.Codespace == "" || e.Codespace == "sdk") {
+		kind = sdkCodeKinds[e.Code]
+	}
+	return kind != nil && kind == target
+}
+
 // ExecutionError represents an error during command execution (Docker client).
 type ExecutionError struct {
 	// Command is the command that was executed
@@ -122,6 +375,12 @@ func (e *ExecutionError) Unwrap() error {
 	return e.Err
 }
 
+// Is matches the error kind of the command's output.
+func (e *ExecutionError) Is(target error) bool {
+	kind := classifyMessage(e.Stderr)
+	return kind != nil && kind == target
+}
+
 // HTTPError represents an error during HTTP communication (REST client).
 type HTTPError struct {
 	// StatusCode is the HTTP status code
@@ -151,6 +410,23 @@ func (e *HTTPError) Unwrap() error {
 	return e.Err
 }
 
+// Is matches the error kind of the HTTP status or response body. Requests
+// that got no response are connection errors unless they timed out.
+func (e *HTTPError) Is(target error) bool {
+	var kind error
+	switch {
+	case e.StatusCode == 0:
+		if e.Err != nil && !errors.Is(e.Err, context.DeadlineExceeded) && !errors.Is(e.Err, context.Canceled) {
+			kind = ErrConnection
+		}
+	case e.StatusCode == http.StatusNotFound:
+		kind = ErrNotFound
+	default:
+		kind = classifyMessage(e.Body)
+	}
+	return kind != nil && kind == target
+}
+
 // WrapQueryError wraps an error as a QueryError.
 func WrapQueryError(module, endpoint string, err error) error {
 	if err == nil {
@@ -181,10 +457,11 @@ func NewTxErrorFromResponse(module, action string, resp *TxResponse) error {
 		return nil
 	}
 	return &TxError{
-		Module: module,
-		Action: action,
-		Code:   resp.Code,
-		RawLog: resp.RawLog,
-		Err:    ErrTxFailed,
+		Module:    module,
+		Action:    action,
+		Code:      resp.Code,
+		RawLog:    resp.RawLog,
+		Codespace: resp.Codespace,
+		Err:       ErrTxFailed,
 	}
 }
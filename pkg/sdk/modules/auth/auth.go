@@ -52,18 +52,7 @@ func (m *Module) Account(ctx context.Context, address string) (*AccountInfo, err
 
 // Accounts queries all accounts with pagination.
 func (m *Module) Accounts(ctx context.Context, pagination *sdk.Pagination) (*AccountsResponse, error) {
-	params := make(map[string]string)
-	if pagination != nil {
-		if pagination.Limit > 0 {
-			params["limit"] = fmt.Sprintf("%d", pagination.Limit)
-		}
-		if pagination.Offset > 0 {
-			params["offset"] = fmt.Sprintf("%d", pagination.Offset)
-		}
-		if pagination.Key != "" {
-			params["page-key"] = pagination.Key
-		}
-	}
+	params := pagination.Params()
 
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "auth",
@@ -81,6 +70,24 @@ func (m *Module) Accounts(ctx context.Context, pagination *sdk.Pagination) (*Acc
 	return &result, nil
 }
 
+// AccountPages queries all accounts page by page from pagination, calling
+// fn with each page as it arrives, so callers need not hold every account.
+func (m *Module) AccountPages(ctx context.Context, pagination *sdk.Pagination, fn func(page *AccountsResponse) error) error {
+	return sdk.EachPage(ctx, pagination, func(p *sdk.Pagination) (string, error) {
+		page, err := m.Accounts(ctx, p)
+		if err != nil {
+			return "", err
+		}
+		if err := fn(page); err != nil {
+			return "", err
+		}
+		if page.Pagination == nil {
+			return "", nil
+		}
+		return page.Pagination.NextKey, nil
+	})
+}
+
 // ModuleAccount queries a module account by name.
 func (m *Module) ModuleAccount(ctx context.Context, name string) (*ModuleAccountInfo, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -0,0 +1,266 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// Amino prefixes of public keys in their legacy bech32 encoding: the type
+// prefix followed by the key length.
+var (
+	aminoSecp256k1Prefix = []byte{0xeb, 0x5a, 0xe9, 0x87, 0x21}
+	aminoEd25519Prefix   = []byte{0x16, 0x24, 0xde, 0x64, 0x20}
+)
+
+// accountKinds maps account type names, the last segment of the type URL,
+// to the kind reported by DecodedAccount.
+var accountKinds = map[string]string{
+	"BaseAccount":              "base",
+	"ModuleAccount":            "module",
+	"ContinuousVestingAccount": "continuous_vesting",
+	"DelayedVestingAccount":    "delayed_vesting",
+	"PeriodicVestingAccount":   "periodic_vesting",
+	"PermanentLockedAccount":   "permanent_locked",
+}
+
+// DecodedAccount is an account in human-readable form: the account type is
+// resolved, the public key decoded to bech32 and, for vesting accounts,
+// the vesting schedule spelled out with dates.
+type DecodedAccount struct {
+	Kind          string           `json:"kind"`
+	TypeURL       string           `json:"type_url,omitempty"`
+	Address       string           `json:"address"`
+	AccountNumber string           `json:"account_number"`
+	Sequence      string           `json:"sequence"`
+	PubKey        *DecodedPubKey   `json:"pub_key,omitempty"`
+	Name          string           `json:"name,omitempty"`
+	Permissions   []string         `json:"permissions,omitempty"`
+	Vesting       *VestingSchedule `json:"vesting,omitempty"`
+}
+
+// DecodedPubKey is an account public key with its bech32 encoding:
+// kirapub for secp256k1 account keys and kiravalconspub for ed25519
+// consensus keys.
+type DecodedPubKey struct {
+	Algorithm string `json:"algorithm"`
+	TypeURL   string `json:"type_url,omitempty"`
+	Key       string `json:"key"`
+	Bech32    string `json:"bech32,omitempty"`
+}
+
+// VestingSchedule describes the vesting of a vesting account. Times are
+// RFC 3339 in UTC.
+type VestingSchedule struct {
+	StartTime        string          `json:"start_time,omitempty"`
+	EndTime          string          `json:"end_time,omitempty"`
+	OriginalVesting  []types.Coin    `json:"original_vesting"`
+	DelegatedFree    []types.Coin    `json:"delegated_free,omitempty"`
+	DelegatedVesting []types.Coin    `json:"delegated_vesting,omitempty"`
+	Periods          []VestingPeriod `json:"periods,omitempty"`
+}
+
+// VestingPeriod is one period of a periodic vesting account. Amount vests
+// at End.
+type VestingPeriod struct {
+	Length string       `json:"length"`
+	End    string       `json:"end,omitempty"`
+	Amount []types.Coin `json:"amount"`
+}
+
+// rawAccount covers the JSON of base, module and vesting accounts.
+type rawAccount struct {
+	Type               string          `json:"@type"`
+	Address            string          `json:"address"`
+	PubKey             json.RawMessage `json:"pub_key"`
+	AccountNumber      string          `json:"account_number"`
+	Sequence           string          `json:"sequence"`
+	BaseAccount        *rawAccount     `json:"base_account"`
+	BaseVestingAccount *struct {
+		BaseAccount      *rawAccount  `json:"base_account"`
+		OriginalVesting  []types.Coin `json:"original_vesting"`
+		DelegatedFree    []types.Coin `json:"delegated_free"`
+		DelegatedVesting []types.Coin `json:"delegated_vesting"`
+		EndTime          string       `json:"end_time"`
+	} `json:"base_vesting_account"`
+	Name           string   `json:"name"`
+	Permissions    []string `json:"permissions"`
+	StartTime      string   `json:"start_time"`
+	VestingPeriods []struct {
+		Length string       `json:"length"`
+		Amount []types.Coin `json:"amount"`
+	} `json:"vesting_periods"`
+}
+
+// DecodedAccount queries an account by address and decodes it into
+// human-readable form. Use Account for the raw form.
+func (m *Module) DecodedAccount(ctx context.Context, address string) (*DecodedAccount, error) {
+	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
+		Module:   "auth",
+		Endpoint: "account",
+		RawArgs:  []string{address},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query account: %w", err)
+	}
+
+	// sekaid returns the account unwrapped; some versions wrap it
+	data := resp.Data
+	var wrapped struct {
+		Account json.RawMessage `json:"account"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err == nil && len(wrapped.Account) > 0 {
+		data = wrapped.Account
+	}
+
+	var raw rawAccount
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse account: %w", err)
+	}
+	return decodeAccount(&raw)
+}
+
+// decodeAccount decodes a base, module or vesting account.
+func decodeAccount(raw *rawAccount) (*DecodedAccount, error) {
+	acc := &DecodedAccount{
+		Kind:        accountKind(raw.Type),
+		TypeURL:     raw.Type,
+		Name:        raw.Name,
+		Permissions: raw.Permissions,
+	}
+
+	base := raw
+	if raw.BaseVestingAccount != nil {
+		bva := raw.BaseVestingAccount
+		base = bva.BaseAccount
+		acc.Vesting = &VestingSchedule{
+			StartTime:        unixTime(raw.StartTime),
+			EndTime:          unixTime(bva.EndTime),
+			OriginalVesting:  bva.OriginalVesting,
+			DelegatedFree:    bva.DelegatedFree,
+			DelegatedVesting: bva.DelegatedVesting,
+		}
+		acc.Vesting.Periods = vestingPeriods(raw)
+	} else if raw.BaseAccount != nil {
+		base = raw.BaseAccount
+	}
+	if base == nil || base.Address == "" {
+		return nil, fmt.Errorf("failed to parse account: empty response")
+	}
+
+	acc.Address = base.Address
+	acc.AccountNumber = base.AccountNumber
+	acc.Sequence = base.Sequence
+	if len(base.PubKey) > 0 && string(base.PubKey) != "null" {
+		pubKey, err := DecodePubKey(base.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		acc.PubKey = pubKey
+	}
+	return acc, nil
+}
+
+// DecodePubKey decodes a public key in either the protobuf JSON form
+// ({"@type": ..., "key": ...}) or the amino JSON form ({"type": ...,
+// "value": ...}). Keys of unknown algorithms are returned without bech32.
+func DecodePubKey(data json.RawMessage) (*DecodedPubKey, error) {
+	var pk struct {
+		TypeURL   string `json:"@type"`
+		Key       string `json:"key"`
+		AminoType string `json:"type"`
+		Value     string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &pk); err != nil {
+		return nil, fmt.Errorf("failed to parse pub_key: %w", err)
+	}
+	typeName, key := pk.TypeURL, pk.Key
+	if typeName == "" {
+		typeName, key = pk.AminoType, pk.Value
+	}
+	keyBytes, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode pub_key: %w", err)
+	}
+
+	decoded := &DecodedPubKey{TypeURL: typeName, Key: key}
+	var prefix []byte
+	var hrp string
+	switch lower := strings.ToLower(typeName); {
+	case strings.Contains(lower, "secp256k1") && len(keyBytes) == 33:
+		decoded.Algorithm = "secp256k1"
+		prefix, hrp = aminoSecp256k1Prefix, types.Bech32PrefixAccPub
+	case strings.Contains(lower, "ed25519") && len(keyBytes) == 32:
+		decoded.Algorithm = "ed25519"
+		prefix, hrp = aminoEd25519Prefix, types.Bech32PrefixConsPub
+	default:
+		decoded.Algorithm = "unknown"
+		return decoded, nil
+	}
+
+	bech, err := types.Bech32Encode(hrp, append(append([]byte{}, prefix...), keyBytes...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pub_key: %w", err)
+	}
+	decoded.Bech32 = bech
+	return decoded, nil
+}
+
+// accountKind resolves a type URL such as
+// "/cosmos.vesting.v1beta1.DelayedVestingAccount" to an account kind.
+func accountKind(typeURL string) string {
+	if typeURL == "" {
+		return "base"
+	}
+	name := typeURL[strings.LastIndex(typeURL, ".")+1:]
+	if kind, ok := accountKinds[name]; ok {
+		return kind
+	}
+	return name
+}
+
+// vestingPeriods lists the periods of a periodic vesting account with the
+// time each period ends, counted from the account's start time.
+func vestingPeriods(raw *rawAccount) []VestingPeriod {
+	if len(raw.VestingPeriods) == 0 {
+		return nil
+	}
+	start, err := strconv.ParseInt(raw.StartTime, 10, 64)
+	if err != nil {
+		start = -1
+	}
+
+	periods := make([]VestingPeriod, 0, len(raw.VestingPeriods))
+	for _, p := range raw.VestingPeriods {
+		period := VestingPeriod{Length: p.Length, Amount: p.Amount}
+		length, err := strconv.ParseInt(p.Length, 10, 64)
+		if err != nil {
+			start = -1
+		} else {
+			period.Length = (time.Duration(length) * time.Second).String()
+			if start >= 0 {
+				start += length
+				period.End = time.Unix(start, 0).UTC().Format(time.RFC3339)
+			}
+		}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// unixTime formats a unix timestamp in seconds as RFC 3339. Zero and
+// unparsable values are dropped.
+func unixTime(s string) string {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || sec <= 0 {
+		return ""
+	}
+	return time.Unix(sec, 0).UTC().Format(time.RFC3339)
+}
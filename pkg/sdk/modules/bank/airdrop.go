@@ -0,0 +1,447 @@
+package bank
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// Airdrop defaults.
+const (
+	// DefaultAirdropBatchSize is the number of recipients per multi-send.
+	DefaultAirdropBatchSize = 100
+
+	// DefaultAirdropSequenceRetries is how often a batch signed with a
+	// stale account sequence is sent again.
+	DefaultAirdropSequenceRetries = 3
+
+	// DefaultAirdropRetryDelay is the wait before sending again after an
+	// account sequence mismatch, about one block.
+	DefaultAirdropRetryDelay = 6 * time.Second
+)
+
+// Statuses of an airdrop ledger entry.
+const (
+	// LedgerBroadcast means the batch was broadcast but its inclusion was
+	// not yet confirmed.
+	LedgerBroadcast = "broadcast"
+
+	// LedgerConfirmed means the batch was included and succeeded.
+	LedgerConfirmed = "confirmed"
+
+	// LedgerFailed means the batch was included but failed.
+	LedgerFailed = "failed"
+)
+
+// LedgerEntry is a line of an airdrop ledger. A batch is written with its
+// recipients when broadcast, and its hash again once its result is known.
+type LedgerEntry struct {
+	TxHash     string      `json:"tx_hash"`
+	Status     string      `json:"status"`
+	Recipients []Recipient `json:"recipients,omitempty"`
+	Time       time.Time   `json:"time"`
+}
+
+// AirdropLedger is the state file of an airdrop: an append-only log of
+// JSON lines, so an interrupted write loses at most its last line. It
+// records which recipients were sent, so a re-run skips them.
+type AirdropLedger struct {
+	file    *os.File
+	batches map[string]*LedgerEntry
+	order   []string
+}
+
+// OpenAirdropLedger opens the ledger at path, creating it if needed, and
+// reads the batches it records.
+func OpenAirdropLedger(path string) (*AirdropLedger, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open airdrop state: %w", err)
+	}
+	l := &AirdropLedger{file: f, batches: make(map[string]*LedgerEntry)}
+
+	// end is the offset after the last valid line. A torn last line from
+	// an interrupted write is dropped, and cut from the file so the next
+	// entry is not appended onto it. A whole entry missing only its
+	// newline is kept, and the newline added.
+	r := bufio.NewReader(f)
+	var offset, end int64
+	line := 0
+	unterminated := false
+	for {
+		b, readErr := r.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			f.Close()
+			return nil, fmt.Errorf("failed to read airdrop state: %w", readErr)
+		}
+		if len(b) == 0 {
+			break
+		}
+		line++
+		offset += int64(len(b))
+		complete := b[len(b)-1] == '\n'
+		if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 {
+			var entry LedgerEntry
+			if err := json.Unmarshal(trimmed, &entry); err != nil || entry.TxHash == "" {
+				if _, err := r.Peek(1); err == io.EOF {
+					break
+				}
+				f.Close()
+				return nil, fmt.Errorf("%s: line %d: invalid airdrop state entry", path, line)
+			}
+			l.apply(&entry)
+			unterminated = !complete
+		}
+		if complete {
+			end = offset
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	var repair error
+	if unterminated {
+		_, repair = f.Write([]byte("\n"))
+	} else if offset > end {
+		repair = f.Truncate(end)
+	}
+	if repair != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to repair airdrop state: %w", repair)
+	}
+	return l, nil
+}
+
+// apply adds an entry read from or written to the ledger.
+func (l *AirdropLedger) apply(entry *LedgerEntry) {
+	batch, ok := l.batches[entry.TxHash]
+	if !ok {
+		l.batches[entry.TxHash] = entry
+		l.order = append(l.order, entry.TxHash)
+		return
+	}
+	batch.Status = entry.Status
+	batch.Time = entry.Time
+}
+
+// write appends entry to the ledger file and syncs it to disk.
+func (l *AirdropLedger) write(entry *LedgerEntry) error {
+	entry.Time = time.Now().UTC()
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write airdrop state: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to write airdrop state: %w", err)
+	}
+	l.apply(entry)
+	return nil
+}
+
+// Broadcast records a batch sent to recipients in transaction hash.
+func (l *AirdropLedger) Broadcast(hash string, recipients []Recipient) error {
+	return l.write(&LedgerEntry{TxHash: hash, Status: LedgerBroadcast, Recipients: recipients})
+}
+
+// Resolve records the result of the batch sent in transaction hash,
+// LedgerConfirmed or LedgerFailed.
+func (l *AirdropLedger) Resolve(hash, status string) error {
+	return l.write(&LedgerEntry{TxHash: hash, Status: status})
+}
+
+// Unconfirmed returns the hashes of batches broadcast without a known
+// result, in the order they were sent.
+func (l *AirdropLedger) Unconfirmed() []string {
+	var hashes []string
+	for _, hash := range l.order {
+		if l.batches[hash].Status == LedgerBroadcast {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
+
+// Pending returns the recipients not yet sent in a confirmed or
+// unconfirmed batch. A recipient listed twice with the same amount is sent
+// twice, so each send in the ledger skips one of them.
+func (l *AirdropLedger) Pending(recipients []Recipient) []Recipient {
+	sent := make(map[string]int)
+	for _, batch := range l.batches {
+		if batch.Status == LedgerFailed {
+			continue
+		}
+		for _, r := range batch.Recipients {
+			sent[recipientKey(r)]++
+		}
+	}
+	var pending []Recipient
+	for _, r := range recipients {
+		key := recipientKey(r)
+		if sent[key] > 0 {
+			sent[key]--
+			continue
+		}
+		pending = append(pending, r)
+	}
+	return pending
+}
+
+// Close closes the ledger file.
+func (l *AirdropLedger) Close() error {
+	return l.file.Close()
+}
+
+// recipientKey identifies a recipient and amount in the ledger.
+func recipientKey(r Recipient) string {
+	return r.Address + " " + r.Amount.String()
+}
+
+// AirdropOptions configures an airdrop.
+type AirdropOptions struct {
+	SendOptions
+
+	// BatchSize is the number of recipients per multi-send
+	// (DefaultAirdropBatchSize if 0)
+	BatchSize int
+
+	// SequenceRetries is how often a batch is sent again after an account
+	// sequence mismatch (DefaultAirdropSequenceRetries if 0; -1 disables)
+	SequenceRetries int
+
+	// RetryDelay is the wait before sending again after an account
+	// sequence mismatch (DefaultAirdropRetryDelay if 0)
+	RetryDelay time.Duration
+
+	// Ledger, if set, records each batch so a re-run skips the recipients
+	// already sent
+	Ledger *AirdropLedger
+
+	// Confirm, if set, waits for a broadcast transaction to be included
+	// and reports whether it succeeded. It returns an error if the result
+	// is not known, e.g. on timeout. Without it a batch counts as sent
+	// once the node accepts it.
+	Confirm func(ctx context.Context, hash string) (bool, error)
+
+	// Progress, if set, is called after each batch with the number of
+	// recipients handled and the number to send in this run
+	Progress func(done, total int)
+}
+
+// AirdropFailure is a recipient that could not be sent.
+type AirdropFailure struct {
+	Address string      `json:"address"`
+	Amount  types.Coins `json:"amount"`
+	Error   string      `json:"error"`
+}
+
+// AirdropResult summarizes an airdrop run.
+type AirdropResult struct {
+	// Recipients is the number of recipients in the list
+	Recipients int `json:"recipients"`
+
+	// Skipped is the number already sent by an earlier run
+	Skipped int `json:"skipped"`
+
+	// Sent is the number sent by this run
+	Sent int `json:"sent"`
+
+	// TotalSent is the amount sent by this run
+	TotalSent types.Coins `json:"total_sent"`
+
+	// TxHashes are the transactions of this run that succeeded
+	TxHashes []string `json:"tx_hashes"`
+
+	// Failed are the recipients that failed permanently, e.g. because
+	// the chain rejected the address; they are not recorded as sent
+	Failed []AirdropFailure `json:"failed,omitempty"`
+}
+
+// Airdrop sends each recipient its amount from one account, batching
+// recipients into multi-sends. With a ledger, recipients recorded by an
+// earlier run are skipped and each batch is recorded before its result is
+// awaited, so an interrupted airdrop can be re-run without paying anyone
+// twice. Batches left unconfirmed by an earlier run are checked first.
+//
+// A batch signed with a stale account sequence is sent again. A batch the
+// chain rejects is split and its recipients sent one by one, so a bad
+// recipient fails alone and is reported in the result. Errors that would
+// fail every batch, such as insufficient funds, an unreachable node or an
+// unconfirmed transaction, stop the airdrop and are returned with the
+// result so far.
+func (m *Module) Airdrop(ctx context.Context, from string, recipients []Recipient, opts *AirdropOptions) (*AirdropResult, error) {
+	if opts == nil {
+		opts = &AirdropOptions{}
+	}
+	size := opts.BatchSize
+	if size <= 0 {
+		size = DefaultAirdropBatchSize
+	}
+
+	result := &AirdropResult{Recipients: len(recipients), TxHashes: []string{}}
+	pending := recipients
+	if opts.Ledger != nil {
+		if err := m.resolveUnconfirmed(ctx, opts); err != nil {
+			return result, err
+		}
+		pending = opts.Ledger.Pending(recipients)
+	}
+	result.Skipped = len(recipients) - len(pending)
+
+	done := 0
+	for start := 0; start < len(pending); start += size {
+		batch := pending[min(start, len(pending)):min(start+size, len(pending))]
+		err := m.airdropBatch(ctx, from, batch, opts, result)
+		if err != nil && len(batch) > 1 && !isAirdropFatal(ctx, err) {
+			// Send one by one so only the rejected recipients fail
+			for i := range batch {
+				if err = m.airdropBatch(ctx, from, batch[i:i+1], opts, result); err != nil {
+					if isAirdropFatal(ctx, err) {
+						break
+					}
+					result.Failed = append(result.Failed, AirdropFailure{Address: batch[i].Address, Amount: batch[i].Amount, Error: err.Error()})
+					err = nil
+				}
+			}
+		} else if err != nil && !isAirdropFatal(ctx, err) {
+			result.Failed = append(result.Failed, AirdropFailure{Address: batch[0].Address, Amount: batch[0].Amount, Error: err.Error()})
+			err = nil
+		}
+		if err != nil {
+			return result, err
+		}
+		done += len(batch)
+		if opts.Progress != nil {
+			opts.Progress(done, len(pending))
+		}
+	}
+	return result, nil
+}
+
+// resolveUnconfirmed checks the batches an earlier run broadcast without
+// learning their result. Without Confirm they are assumed sent, since
+// sending them again could pay twice.
+func (m *Module) resolveUnconfirmed(ctx context.Context, opts *AirdropOptions) error {
+	if opts.Confirm == nil {
+		return nil
+	}
+	for _, hash := range opts.Ledger.Unconfirmed() {
+		ok, err := opts.Confirm(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("result of airdrop transaction %s from an earlier run is not known: %w", hash, err)
+		}
+		status := LedgerConfirmed
+		if !ok {
+			status = LedgerFailed
+		}
+		if err := opts.Ledger.Resolve(hash, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// airdropBatch sends one batch, retrying account sequence mismatches, and
+// records it in the ledger and result once it succeeds.
+func (m *Module) airdropBatch(ctx context.Context, from string, batch []Recipient, opts *AirdropOptions, result *AirdropResult) error {
+	retries := opts.SequenceRetries
+	if retries == 0 {
+		retries = DefaultAirdropSequenceRetries
+	}
+	delay := opts.RetryDelay
+	if delay <= 0 {
+		delay = DefaultAirdropRetryDelay
+	}
+
+	send := func() (*sdk.TxResponse, error) {
+		return m.MultiSend(ctx, from, nil, nil, &MultiSendOptions{SendOptions: opts.SendOptions, Recipients: batch})
+	}
+	resp, err := send()
+	for retry := 0; err != nil && sdk.IsAccountSequence(err) && retry < retries; retry++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+			resp, err = send()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Ledger != nil {
+		if err := opts.Ledger.Broadcast(resp.TxHash, batch); err != nil {
+			return err
+		}
+	}
+	if opts.Confirm != nil {
+		ok, err := opts.Confirm(ctx, resp.TxHash)
+		if err != nil {
+			return &airdropUnconfirmedError{hash: resp.TxHash, err: err}
+		}
+		if !ok {
+			if opts.Ledger != nil {
+				if err := opts.Ledger.Resolve(resp.TxHash, LedgerFailed); err != nil {
+					return err
+				}
+			}
+			return fmt.Errorf("%w: transaction %s failed", sdk.ErrTxFailed, resp.TxHash)
+		}
+	}
+	if opts.Ledger != nil {
+		if err := opts.Ledger.Resolve(resp.TxHash, LedgerConfirmed); err != nil {
+			return err
+		}
+	}
+
+	total, err := SumRecipients(batch)
+	if err != nil {
+		return err
+	}
+	if result.TotalSent, err = result.TotalSent.Add(total); err != nil {
+		return err
+	}
+	result.Sent += len(batch)
+	result.TxHashes = append(result.TxHashes, resp.TxHash)
+	return nil
+}
+
+// airdropUnconfirmedError is a batch whose inclusion could not be
+// confirmed; it may still be included, so the airdrop stops.
+type airdropUnconfirmedError struct {
+	hash string
+	err  error
+}
+
+func (e *airdropUnconfirmedError) Error() string {
+	return fmt.Sprintf("airdrop transaction %s not confirmed: %v", e.hash, e.err)
+}
+
+func (e *airdropUnconfirmedError) Unwrap() error {
+	return e.err
+}
+
+// isAirdropFatal reports whether err stops an airdrop rather than failing
+// only the recipients of one batch.
+func isAirdropFatal(ctx context.Context, err error) bool {
+	var unconfirmed *airdropUnconfirmedError
+	return ctx.Err() != nil ||
+		errors.As(err, &unconfirmed) ||
+		sdk.IsConnection(err) ||
+		sdk.IsAccountSequence(err) ||
+		sdk.IsInsufficientFunds(err) ||
+		sdk.IsInsufficientFees(err) ||
+		errors.Is(err, sdk.ErrUnauthorized) ||
+		errors.Is(err, sdk.ErrKeyNotFound)
+}
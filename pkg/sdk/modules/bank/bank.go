@@ -3,8 +3,12 @@ package bank
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 	"github.com/kiracore/sekai-cli/pkg/sdk/types"
@@ -21,6 +25,8 @@ func New(client sdk.Client) *Module {
 }
 
 // Balance queries the balance of an address for a specific denomination.
+// An address that holds none of denom has a zero balance, e.g. "0ukex",
+// rather than an error.
 func (m *Module) Balance(ctx context.Context, address, denom string) (*types.Coin, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "bank",
@@ -33,20 +39,40 @@ func (m *Module) Balance(ctx context.Context, address, denom string) (*types.Coi
 	if err != nil {
 		return nil, fmt.Errorf("failed to query balance: %w", err)
 	}
+	coin, err := parseDenomBalance(resp.Data, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse balance: %w", err)
+	}
+	return coin, nil
+}
 
-	// When querying with --denom, the response is a flat coin object
-	var coin types.Coin
-	if err := json.Unmarshal(resp.Data, &coin); err != nil {
-		// Try wrapped format as fallback
-		var result struct {
-			Balance types.Coin `json:"balance"`
-		}
-		if err := json.Unmarshal(resp.Data, &result); err != nil {
-			return nil, fmt.Errorf("failed to parse balance: %w", err)
-		}
-		return &result.Balance, nil
+// parseDenomBalance parses the balance of one denom. sekaid --denom prints
+// a flat coin, the REST by_denom endpoints wrap it in "balance", and
+// backends without a by-denom query list all coins. A denom that is not
+// held is returned as 0<denom>.
+func parseDenomBalance(data []byte, denom string) (*types.Coin, error) {
+	var result struct {
+		types.Coin
+		Balance  *types.Coin  `json:"balance"`
+		Balances []types.Coin `json:"balances"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
 	}
 
+	coin := result.Coin
+	switch {
+	case result.Balance != nil:
+		coin = *result.Balance
+	case result.Balances != nil:
+		coin, _ = types.Coins(result.Balances).GetCoin(denom)
+	}
+	if coin.Denom == "" {
+		coin.Denom = denom
+	}
+	if coin.Amount == "" {
+		coin.Amount = "0"
+	}
 	return &coin, nil
 }
 
@@ -92,6 +118,57 @@ func (m *Module) SpendableBalances(ctx context.Context, address string) (types.C
 	return types.Coins(result.Balances), nil
 }
 
+// SpendableBalanceByDenom queries the spendable balance of one denom of an
+// address, which is less than its balance while coins are locked, e.g. by
+// vesting. An address with nothing spendable in the denom has 0<denom>.
+func (m *Module) SpendableBalanceByDenom(ctx context.Context, address, denom string) (*types.Coin, error) {
+	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
+		Module:   "bank",
+		Endpoint: "spendable-balances",
+		RawArgs:  []string{address},
+		Params: map[string]string{
+			"denom": denom,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query spendable balance: %w", err)
+	}
+	coin, err := parseDenomBalance(resp.Data, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse spendable balance: %w", err)
+	}
+	return coin, nil
+}
+
+// SweepAmount returns the amount that sends the entire spendable balance
+// of an address once fees are paid. It fails if the address holds nothing,
+// does not hold a fee denom, or would have nothing left after fees.
+func (m *Module) SweepAmount(ctx context.Context, address string, fees types.Coins) (types.Coins, error) {
+	balances, err := m.SpendableBalances(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	balances = types.NewCoins(balances...)
+	if len(balances) == 0 {
+		return nil, fmt.Errorf("%s has no spendable balance to send", address)
+	}
+
+	for _, fee := range fees {
+		if _, ok := balances.GetCoin(fee.Denom); !ok {
+			return nil, fmt.Errorf("cannot pay fee %s: %s holds no %s (balances: %s)", fee, address, fee.Denom, balances)
+		}
+	}
+
+	amount, err := balances.Sub(fees)
+	if err != nil {
+		return nil, fmt.Errorf("cannot pay fee %s: %w", fees, err)
+	}
+	if len(amount) == 0 {
+		return nil, fmt.Errorf("nothing to send: the balance of %s only covers the fee %s", address, fees)
+	}
+	return amount, nil
+}
+
 // TotalSupply queries the total supply of all tokens.
 func (m *Module) TotalSupply(ctx context.Context) (types.Coins, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -151,6 +228,9 @@ type SendOptions struct {
 
 	// BroadcastMode is the broadcast mode
 	BroadcastMode string
+
+	// SignMode is the signing mode (sdk.SignModeDirect or sdk.SignModeAminoJSON)
+	SignMode string
 }
 
 // Send transfers tokens from one account to another.
@@ -173,6 +253,9 @@ func (m *Module) Send(ctx context.Context, from, to string, amount types.Coins,
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -195,11 +278,22 @@ type MultiSendOptions struct {
 	SendOptions
 	// Split splits the amount equally between recipients instead of sending full amount to each
 	Split bool
+
+	// Recipients sends each recipient its own amount. When set, the
+	// toAddresses and amount arguments of MultiSend must be empty.
+	Recipients []Recipient
+}
+
+// Recipient is a multi-send output with its own amount.
+type Recipient struct {
+	Address string      `json:"address"`
+	Amount  types.Coins `json:"amount"`
 }
 
 // MultiSend sends tokens from one account to multiple recipients.
 // By default, sends the full amount to each address.
-// Use Split option to split the amount equally between addresses.
+// Use Split option to split the amount equally between addresses, or
+// Recipients to send a different amount to each.
 func (m *Module) MultiSend(ctx context.Context, from string, toAddresses []string, amount types.Coins, opts *MultiSendOptions) (*sdk.TxResponse, error) {
 	flags := make(map[string]string)
 	boolFlags := make(map[string]bool)
@@ -219,11 +313,21 @@ func (m *Module) MultiSend(ctx context.Context, from string, toAddresses []strin
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 		if opts.Split {
 			boolFlags["split"] = true
 		}
 	}
 
+	if opts != nil && len(opts.Recipients) > 0 {
+		if len(toAddresses) > 0 || len(amount) > 0 || opts.Split {
+			return nil, fmt.Errorf("recipients with their own amounts cannot be combined with addresses, amount or split")
+		}
+		return m.multiSendRecipients(ctx, from, opts.Recipients, flags, opts.BroadcastMode)
+	}
+
 	// Args: from, to1, to2, ..., amount
 	args := []string{from}
 	args = append(args, toAddresses...)
@@ -245,6 +349,201 @@ func (m *Module) MultiSend(ctx context.Context, from string, toAddresses []strin
 	return resp, nil
 }
 
+// multiSendRecipients sends a different amount to each recipient. sekaid's
+// multi-send only sends one amount to every address, so the transaction is
+// generated with a placeholder amount, its outputs are replaced with the
+// recipients, and it is signed and broadcast.
+func (m *Module) multiSendRecipients(ctx context.Context, from string, recipients []Recipient, flags map[string]string, mode string) (*sdk.TxResponse, error) {
+	offline, ok := m.client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, fmt.Errorf("failed to multi-send tokens: %w", sdk.ErrNotSupported)
+	}
+	total, err := SumRecipients(recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	// One unit of each denom, so simulating the placeholder for gas
+	// estimation does not need the full balance.
+	placeholder := make(types.Coins, len(total))
+	for i, c := range total {
+		placeholder[i] = types.NewCoin(c.Denom, 1)
+	}
+	args := []string{from}
+	for _, r := range recipients {
+		args = append(args, r.Address)
+	}
+	args = append(args, placeholder.String())
+
+	unsigned, err := offline.GenerateTx(ctx, &sdk.TxRequest{
+		Module:           "bank",
+		Action:           "multi-send",
+		Args:             args,
+		Signer:           from,
+		Flags:            flags,
+		SkipConfirmation: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to multi-send tokens: %w", err)
+	}
+	tx, err := setMultiSendOutputs(unsigned, recipients, total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to multi-send tokens: %w", err)
+	}
+	signed, err := offline.SignTx(ctx, tx, &sdk.SignOptions{Signer: from})
+	if err != nil {
+		return nil, fmt.Errorf("failed to multi-send tokens: %w", err)
+	}
+	resp, err := offline.BroadcastTx(ctx, signed, mode)
+	if err != nil {
+		return resp, fmt.Errorf("failed to multi-send tokens: %w", err)
+	}
+	return resp, nil
+}
+
+// multiSendMsg is the part of a MsgMultiSend rewritten for recipients.
+type multiSendMsg struct {
+	Inputs  []multiSendIO `json:"inputs"`
+	Outputs []multiSendIO `json:"outputs"`
+}
+
+// multiSendIO is an input or output of a MsgMultiSend.
+type multiSendIO struct {
+	Address string      `json:"address"`
+	Coins   types.Coins `json:"coins"`
+}
+
+// setMultiSendOutputs replaces the outputs of the MsgMultiSend in a
+// generated transaction with recipients and its input with their total,
+// then checks that inputs and outputs balance.
+func setMultiSendOutputs(tx []byte, recipients []Recipient, total types.Coins) ([]byte, error) {
+	var parsed map[string]json.RawMessage
+	if err := json.Unmarshal(tx, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse generated transaction: %w", err)
+	}
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(parsed["body"], &body); err != nil {
+		return nil, fmt.Errorf("failed to parse generated transaction body: %w", err)
+	}
+	var msgs []json.RawMessage
+	if err := json.Unmarshal(body["messages"], &msgs); err != nil || len(msgs) != 1 {
+		return nil, fmt.Errorf("generated transaction is not a single multi-send message")
+	}
+	var fields map[string]json.RawMessage
+	var msg multiSendMsg
+	if err := json.Unmarshal(msgs[0], &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse generated message: %w", err)
+	}
+	if err := json.Unmarshal(msgs[0], &msg); err != nil || len(msg.Inputs) != 1 || fields["outputs"] == nil {
+		return nil, fmt.Errorf("generated transaction is not a single-input multi-send")
+	}
+
+	inputs := []multiSendIO{{Address: msg.Inputs[0].Address, Coins: total}}
+	outputs := make([]multiSendIO, len(recipients))
+	for i, r := range recipients {
+		outputs[i] = multiSendIO{Address: r.Address, Coins: r.Amount}
+	}
+	if err := checkMultiSendBalance(inputs, outputs); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if fields["inputs"], err = json.Marshal(inputs); err != nil {
+		return nil, err
+	}
+	if fields["outputs"], err = json.Marshal(outputs); err != nil {
+		return nil, err
+	}
+	if body["messages"], err = json.Marshal([]map[string]json.RawMessage{fields}); err != nil {
+		return nil, err
+	}
+	if parsed["body"], err = json.Marshal(body); err != nil {
+		return nil, err
+	}
+	return json.Marshal(parsed)
+}
+
+// checkMultiSendBalance checks that the inputs of a multi-send add up to
+// its outputs, as the chain requires.
+func checkMultiSendBalance(inputs, outputs []multiSendIO) error {
+	var in, out types.Coins
+	var err error
+	for _, i := range inputs {
+		if in, err = in.Add(i.Coins); err != nil {
+			return err
+		}
+	}
+	for _, o := range outputs {
+		if out, err = out.Add(o.Coins); err != nil {
+			return err
+		}
+	}
+	if in.String() != out.String() {
+		return fmt.Errorf("multi-send inputs %s do not match outputs %s", in, out)
+	}
+	return nil
+}
+
+// SumRecipients returns the total amount sent to recipients.
+func SumRecipients(recipients []Recipient) (types.Coins, error) {
+	var total types.Coins
+	for _, r := range recipients {
+		var err error
+		if total, err = total.Add(r.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// ParseRecipientsCSV reads multi-send recipients from CSV rows of
+// "address,amount". Amounts with several denoms must be quoted, e.g.
+// kira1...,"100ukex,5samolean". Blank lines, lines starting with # and a
+// leading "address,amount" header are skipped. Errors name the line.
+func ParseRecipientsCSV(r io.Reader) ([]Recipient, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	var recipients []Recipient
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipients CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(recipients) == 0 && len(record) == 2 &&
+			strings.EqualFold(strings.TrimSpace(record[0]), "address") &&
+			strings.EqualFold(strings.TrimSpace(record[1]), "amount") {
+			continue
+		}
+		if len(record) != 2 {
+			return nil, fmt.Errorf("line %d: expected address,amount, got %d fields", line, len(record))
+		}
+
+		address := strings.TrimSpace(record[0])
+		if !types.IsValidAddress(address) {
+			return nil, fmt.Errorf("line %d: invalid address %q", line, address)
+		}
+		amount, err := types.ParseCoins(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount %q: %w", line, record[1], err)
+		}
+		if len(amount) == 0 || slices.ContainsFunc(amount, types.Coin.IsZero) {
+			return nil, fmt.Errorf("line %d: amount must be positive", line)
+		}
+		recipients = append(recipients, Recipient{Address: address, Amount: amount})
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("recipients CSV has no recipients")
+	}
+	return recipients, nil
+}
+
 // DenomMetadataResponse contains denom metadata query response.
 type DenomMetadataResponse struct {
 	Metadatas []DenomMetadata `json:"metadatas"`
@@ -0,0 +1,155 @@
+package bank
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// DenomOwner is an account holding a denom.
+type DenomOwner struct {
+	Address string     `json:"address"`
+	Balance types.Coin `json:"balance"`
+}
+
+// DenomOwnersResponse is one page of the owners of a denom.
+type DenomOwnersResponse struct {
+	DenomOwners []DenomOwner `json:"denom_owners"`
+	Pagination  *struct {
+		NextKey string `json:"next_key,omitempty"`
+		Total   string `json:"total,omitempty"`
+	} `json:"pagination,omitempty"`
+}
+
+// DenomOwners queries one page of the accounts holding denom.
+func (m *Module) DenomOwners(ctx context.Context, denom string, pagination *sdk.Pagination) (*DenomOwnersResponse, error) {
+	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
+		Module:   "bank",
+		Endpoint: "denom-owners",
+		RawArgs:  []string{denom},
+		Params:   pagination.Params(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query denom owners: %w", err)
+	}
+
+	var result DenomOwnersResponse
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse denom owners: %w", err)
+	}
+	return &result, nil
+}
+
+// DenomOwnerPages queries the accounts holding denom page by page from
+// pagination, calling fn with each page as it arrives.
+func (m *Module) DenomOwnerPages(ctx context.Context, denom string, pagination *sdk.Pagination, fn func(page *DenomOwnersResponse) error) error {
+	return sdk.EachPage(ctx, pagination, func(p *sdk.Pagination) (string, error) {
+		page, err := m.DenomOwners(ctx, denom, p)
+		if err != nil {
+			return "", err
+		}
+		if err := fn(page); err != nil {
+			return "", err
+		}
+		if page.Pagination == nil {
+			return "", nil
+		}
+		return page.Pagination.NextKey, nil
+	})
+}
+
+// Holder is an account in a holder report.
+type Holder struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// DefaultHoldersPageSize is the number of owners queried per page by
+// Holders.
+const DefaultHoldersPageSize = 500
+
+// HoldersOptions configures a holder report.
+type HoldersOptions struct {
+	// Min is the smallest amount to report; empty reports every owner
+	Min string
+
+	// Top caps the report at the largest holders; 0 reports all
+	Top int
+
+	// PageSize is the number of owners queried per page
+	// (DefaultHoldersPageSize if 0)
+	PageSize uint64
+
+	// Progress, if set, is called after each page with the number of
+	// owners scanned and holders found so far
+	Progress func(scanned, found int)
+}
+
+// Holders lists the accounts holding at least opts.Min of denom, largest
+// first; accounts with equal amounts are ordered by address. All owners
+// are queried page by page, so this is slow on chains with many accounts.
+func (m *Module) Holders(ctx context.Context, denom string, opts *HoldersOptions) ([]Holder, error) {
+	if opts == nil {
+		opts = &HoldersOptions{}
+	}
+	min := new(big.Int)
+	if opts.Min != "" {
+		if _, ok := min.SetString(opts.Min, 10); !ok || min.Sign() < 0 {
+			return nil, fmt.Errorf("invalid minimum amount: %s", opts.Min)
+		}
+	}
+	if opts.Top < 0 {
+		return nil, fmt.Errorf("invalid top: %d", opts.Top)
+	}
+	pageSize := opts.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultHoldersPageSize
+	}
+
+	type holder struct {
+		Holder
+		amount *big.Int
+	}
+	var holders []holder
+	scanned := 0
+	err := m.DenomOwnerPages(ctx, denom, &sdk.Pagination{Limit: pageSize}, func(page *DenomOwnersResponse) error {
+		for _, owner := range page.DenomOwners {
+			scanned++
+			amount, ok := new(big.Int).SetString(owner.Balance.Amount, 10)
+			if !ok {
+				return fmt.Errorf("invalid balance of %s: %s", owner.Address, owner.Balance.Amount)
+			}
+			if amount.Cmp(min) >= 0 {
+				holders = append(holders, holder{Holder{Address: owner.Address, Amount: owner.Balance.Amount}, amount})
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(scanned, len(holders))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(holders, func(i, j int) bool {
+		if c := holders[i].amount.Cmp(holders[j].amount); c != 0 {
+			return c > 0
+		}
+		return holders[i].Address < holders[j].Address
+	})
+	if opts.Top > 0 && len(holders) > opts.Top {
+		holders = holders[:opts.Top]
+	}
+
+	result := make([]Holder, len(holders))
+	for i, h := range holders {
+		result[i] = h.Holder
+	}
+	return result, nil
+}
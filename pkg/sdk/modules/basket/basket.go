@@ -108,6 +108,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 func buildTxFlags(opts *TxOptions) map[string]string {
@@ -128,6 +129,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -259,29 +263,29 @@ func (m *Module) DisableBasketSwaps(ctx context.Context, from, basketID string,
 // ProposalCreateBasketOpts contains options for creating a basket proposal.
 type ProposalCreateBasketOpts struct {
 	// Basket fields
-	BasketSuffix      string
-	BasketDescription string
-	BasketTokens      string
-	TokensCap         string
+	BasketSuffix      string `json:"basket_suffix,omitempty"`
+	BasketDescription string `json:"basket_description,omitempty"`
+	BasketTokens      string `json:"basket_tokens,omitempty"`
+	TokensCap         string `json:"tokens_cap,omitempty"`
 	// Proposal fields
-	Title       string
-	Description string
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
 	// Mints
-	MintsMin      string
-	MintsMax      string
-	MintsDisabled bool
+	MintsMin      string `json:"mints_min,omitempty"`
+	MintsMax      string `json:"mints_max,omitempty"`
+	MintsDisabled bool   `json:"mints_disabled,omitempty"`
 	// Burns
-	BurnsMin      string
-	BurnsMax      string
-	BurnsDisabled bool
+	BurnsMin      string `json:"burns_min,omitempty"`
+	BurnsMax      string `json:"burns_max,omitempty"`
+	BurnsDisabled bool   `json:"burns_disabled,omitempty"`
 	// Swaps
-	SwapsMin       string
-	SwapsMax       string
-	SwapsDisabled  bool
-	SwapFee        string
-	SlippageFeeMin string
+	SwapsMin       string `json:"swaps_min,omitempty"`
+	SwapsMax       string `json:"swaps_max,omitempty"`
+	SwapsDisabled  bool   `json:"swaps_disabled,omitempty"`
+	SwapFee        string `json:"swap_fee,omitempty"`
+	SlippageFeeMin string `json:"slippage_fee_min,omitempty"`
 	// Limits
-	LimitsPeriod uint64
+	LimitsPeriod uint64 `json:"limits_period,omitempty"`
 }
 
 // ProposalCreateBasket creates a proposal to create a basket.
@@ -376,30 +380,30 @@ func (m *Module) ProposalCreateBasket(ctx context.Context, from string, propOpts
 // ProposalEditBasketOpts contains options for editing a basket proposal.
 type ProposalEditBasketOpts struct {
 	// Basket fields
-	BasketID          uint64
-	BasketSuffix      string
-	BasketDescription string
-	BasketTokens      string
-	TokensCap         string
+	BasketID          uint64 `json:"basket_id,omitempty"`
+	BasketSuffix      string `json:"basket_suffix,omitempty"`
+	BasketDescription string `json:"basket_description,omitempty"`
+	BasketTokens      string `json:"basket_tokens,omitempty"`
+	TokensCap         string `json:"tokens_cap,omitempty"`
 	// Proposal fields
-	Title       string
-	Description string
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
 	// Mints
-	MintsMin      string
-	MintsMax      string
-	MintsDisabled bool
+	MintsMin      string `json:"mints_min,omitempty"`
+	MintsMax      string `json:"mints_max,omitempty"`
+	MintsDisabled bool   `json:"mints_disabled,omitempty"`
 	// Burns
-	BurnsMin      string
-	BurnsMax      string
-	BurnsDisabled bool
+	BurnsMin      string `json:"burns_min,omitempty"`
+	BurnsMax      string `json:"burns_max,omitempty"`
+	BurnsDisabled bool   `json:"burns_disabled,omitempty"`
 	// Swaps
-	SwapsMin       string
-	SwapsMax       string
-	SwapsDisabled  bool
-	SwapFee        string
-	SlippageFeeMin string
+	SwapsMin       string `json:"swaps_min,omitempty"`
+	SwapsMax       string `json:"swaps_max,omitempty"`
+	SwapsDisabled  bool   `json:"swaps_disabled,omitempty"`
+	SwapFee        string `json:"swap_fee,omitempty"`
+	SlippageFeeMin string `json:"slippage_fee_min,omitempty"`
 	// Limits
-	LimitsPeriod uint64
+	LimitsPeriod uint64 `json:"limits_period,omitempty"`
 }
 
 // ProposalEditBasket creates a proposal to edit a basket.
@@ -52,6 +52,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 func buildTxFlags(opts *TxOptions) map[string]string {
@@ -72,6 +73,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -2,9 +2,12 @@
 package custody
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
@@ -83,3 +86,109 @@ func (m *Module) Limits(ctx context.Context, address string) (json.RawMessage, e
 	}
 	return resp.Data, nil
 }
+
+// Summary is an account's custody configuration, merged from the get,
+// custodians, whitelist and limits queries. Settings that are not
+// configured are empty objects and listed in Unconfigured.
+type Summary struct {
+	Address      string            `json:"address"`
+	Settings     json.RawMessage   `json:"settings"`
+	Custodians   json.RawMessage   `json:"custodians"`
+	Whitelist    json.RawMessage   `json:"whitelist"`
+	Limits       json.RawMessage   `json:"limits"`
+	Unconfigured []string          `json:"unconfigured,omitempty"`
+	Note         string            `json:"note,omitempty"`
+	Errors       map[string]string `json:"errors,omitempty"`
+}
+
+// Summary queries all custody settings of an address concurrently. A
+// setting that is not configured, or whose query fails, does not fail the
+// summary; failures are reported in Errors. An error is returned only if
+// every query fails.
+func (m *Module) Summary(ctx context.Context, address string) (*Summary, error) {
+	parts := []struct {
+		name  string
+		query func(context.Context, string) (json.RawMessage, error)
+		data  json.RawMessage
+		err   error
+	}{
+		{name: "settings", query: m.Get},
+		{name: "custodians", query: m.Custodians},
+		{name: "whitelist", query: m.Whitelist},
+		{name: "limits", query: m.Limits},
+	}
+
+	var wg sync.WaitGroup
+	for i := range parts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			parts[i].data, parts[i].err = parts[i].query(ctx, address)
+		}(i)
+	}
+	wg.Wait()
+
+	summary := &Summary{Address: address}
+	failed := 0
+	for i := range parts {
+		p := &parts[i]
+		switch {
+		case p.err != nil && !sdk.IsNotFound(p.err):
+			failed++
+			if summary.Errors == nil {
+				summary.Errors = make(map[string]string)
+			}
+			summary.Errors[p.name] = p.err.Error()
+			p.data = json.RawMessage("{}")
+		case p.err != nil || isEmptyJSON(p.data):
+			summary.Unconfigured = append(summary.Unconfigured, p.name)
+			p.data = json.RawMessage("{}")
+		}
+	}
+	if failed == len(parts) {
+		return nil, fmt.Errorf("failed to query custody summary: %w", parts[0].err)
+	}
+
+	summary.Settings = parts[0].data
+	summary.Custodians = parts[1].data
+	summary.Whitelist = parts[2].data
+	summary.Limits = parts[3].data
+	if len(summary.Unconfigured) > 0 {
+		summary.Note = "not configured: " + strings.Join(summary.Unconfigured, ", ")
+	}
+	return summary, nil
+}
+
+// isEmptyJSON reports whether data holds no setting: it is blank, null,
+// an empty string, array or object, or an object of only such values.
+func isEmptyJSON(data json.RawMessage) bool {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return true
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+	return isEmptyValue(v)
+}
+
+// isEmptyValue is isEmptyJSON for a decoded value.
+func isEmptyValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, field := range v {
+			if !isEmptyValue(field) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
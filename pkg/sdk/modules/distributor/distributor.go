@@ -5,8 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/staking"
 )
 
 // Module provides distributor query functionality.
@@ -79,3 +84,150 @@ func (m *Module) YearStartSnapshot(ctx context.Context) (json.RawMessage, error)
 	}
 	return resp.Data, nil
 }
+
+// maxPerformanceQueries bounds the number of validators queried at the
+// same time by AllSnapshotPerformance.
+const maxPerformanceQueries = 8
+
+// ValidatorPerformance is the snapshot period performance of a validator.
+// Performance is empty, and Error says why, for validators missing from
+// the snapshot.
+type ValidatorPerformance struct {
+	Validator      string `json:"validator"`
+	Moniker        string `json:"moniker,omitempty"`
+	Performance    string `json:"performance,omitempty"`
+	SnapshotPeriod string `json:"snapshot_period,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// AllSnapshotPerformance queries the snapshot period performance of every
+// active validator, listed by the customstaking module, in the order of
+// the validator list. The validators are queried by a bounded pool of
+// workers. A validator the snapshot has no performance for is listed with
+// an error instead of failing the whole query; an error is returned only
+// if all fail.
+func (m *Module) AllSnapshotPerformance(ctx context.Context) ([]ValidatorPerformance, error) {
+	validators, err := activeValidators(ctx, staking.New(m.client))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ValidatorPerformance, len(validators))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < maxPerformanceQueries && w < len(validators); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result[i] = m.validatorPerformance(ctx, validators[i])
+			}
+		}()
+	}
+	for i := range validators {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, perf := range result {
+		if perf.Error == "" {
+			return result, nil
+		}
+	}
+	if len(result) > 0 {
+		return nil, fmt.Errorf("no validator has snapshot performance: %s", result[0].Error)
+	}
+	return result, nil
+}
+
+// validatorPerformance queries the snapshot performance of one validator.
+func (m *Module) validatorPerformance(ctx context.Context, v staking.Validator) ValidatorPerformance {
+	addr := v.GetValKey()
+	if addr == "" {
+		addr = v.Address
+	}
+	perf := ValidatorPerformance{Validator: addr, Moniker: v.Moniker}
+
+	data, err := m.SnapshotPeriodPerformance(ctx, addr)
+	if err != nil {
+		perf.Error = err.Error()
+		return perf
+	}
+	// sekaid encodes the int64 fields as strings
+	var resp struct {
+		Performance    json.Number `json:"performance"`
+		SnapshotPeriod json.Number `json:"snapshot_period"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		perf.Error = fmt.Sprintf("failed to parse performance: %v", err)
+		return perf
+	}
+	perf.Performance = resp.Performance.String()
+	perf.SnapshotPeriod = resp.SnapshotPeriod.String()
+	if perf.Performance == "" {
+		perf.Error = "not in the snapshot"
+	}
+	return perf
+}
+
+// activeValidators lists the active validators, following all pages.
+func activeValidators(ctx context.Context, stakingMod *staking.Module) ([]staking.Validator, error) {
+	var active []staking.Validator
+	key := ""
+	for {
+		resp, err := stakingMod.Validators(ctx, &staking.ValidatorQueryOpts{Pagination: &sdk.Pagination{Key: key}})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Validators {
+			if strings.EqualFold(v.Status, "ACTIVE") {
+				active = append(active, v)
+			}
+		}
+		if resp.Pagination == nil || resp.Pagination.NextKey == "" || resp.Pagination.NextKey == key {
+			return active, nil
+		}
+		key = resp.Pagination.NextKey
+	}
+}
+
+// PerformanceSortKeys lists the fields validator performance can be
+// sorted by.
+var PerformanceSortKeys = []string{"performance", "moniker", "validator"}
+
+// SortPerformance sorts validator performance by one of
+// PerformanceSortKeys, keeping the order of equal entries. Validators
+// missing from the snapshot come last in either order.
+func SortPerformance(perfs []ValidatorPerformance, by string, descending bool) error {
+	var less func(a, b *ValidatorPerformance) bool
+	switch by {
+	case "performance":
+		less = func(a, b *ValidatorPerformance) bool {
+			x, _ := strconv.ParseInt(a.Performance, 10, 64)
+			y, _ := strconv.ParseInt(b.Performance, 10, 64)
+			return x < y
+		}
+	case "moniker":
+		less = func(a, b *ValidatorPerformance) bool { return a.Moniker < b.Moniker }
+	case "validator":
+		less = func(a, b *ValidatorPerformance) bool { return a.Validator < b.Validator }
+	default:
+		return fmt.Errorf("invalid sort key %q (valid: %s)", by, strings.Join(PerformanceSortKeys, ", "))
+	}
+
+	sort.SliceStable(perfs, func(i, j int) bool {
+		a, b := &perfs[i], &perfs[j]
+		if (a.Performance == "") != (b.Performance == "") {
+			return b.Performance == ""
+		}
+		if descending {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+	return nil
+}
@@ -3,6 +3,7 @@ package evidence
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -19,11 +20,19 @@ func New(client sdk.Client) *Module {
 	return &Module{client: client}
 }
 
-// AllEvidence queries all submitted evidence.
+// AllEvidence queries all submitted evidence, such as validator double
+// signs.
 func (m *Module) AllEvidence(ctx context.Context) (json.RawMessage, error) {
+	return m.AllEvidencePage(ctx, nil)
+}
+
+// AllEvidencePage queries a page of the submitted evidence. A nil
+// pagination returns the node's default page.
+func (m *Module) AllEvidencePage(ctx context.Context, pagination *sdk.Pagination) (json.RawMessage, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customevidence",
 		Endpoint: "",
+		Params:   pagination.Params(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query evidence: %w", err)
@@ -31,13 +40,20 @@ func (m *Module) AllEvidence(ctx context.Context) (json.RawMessage, error) {
 	return resp.Data, nil
 }
 
-// Evidence queries evidence by hash.
+// Evidence queries evidence by its hex hash. Unknown evidence fails with
+// an error wrapping sdk.ErrNotFound.
 func (m *Module) Evidence(ctx context.Context, hash string) (json.RawMessage, error) {
+	if _, err := hex.DecodeString(hash); err != nil || hash == "" {
+		return nil, fmt.Errorf("invalid evidence hash %q: must be hex", hash)
+	}
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customevidence",
 		Endpoint: "",
 		RawArgs:  []string{hash},
 	})
+	if sdk.IsNotFound(err) {
+		return nil, fmt.Errorf("evidence %s: %w", hash, sdk.ErrNotFound)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query evidence: %w", err)
 	}
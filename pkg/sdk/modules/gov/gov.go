@@ -4,7 +4,13 @@ package gov
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
@@ -52,6 +58,9 @@ func (m *Module) Proposals(ctx context.Context, opts *ProposalQueryOpts) (*Propo
 		if opts.Status != "" {
 			params["status"] = opts.Status
 		}
+		for k, v := range opts.Pagination.Params() {
+			params[k] = v
+		}
 	}
 
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -67,9 +76,71 @@ func (m *Module) Proposals(ctx context.Context, opts *ProposalQueryOpts) (*Propo
 	if err := json.Unmarshal(resp.Data, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse proposals: %w", err)
 	}
+
+	if opts != nil && opts.Type != "" {
+		want := normalizeProposalType(opts.Type)
+		filtered := result.Proposals[:0]
+		for _, p := range result.Proposals {
+			if strings.EqualFold(p.Type(), want) {
+				filtered = append(filtered, p)
+			}
+		}
+		result.Proposals = filtered
+	}
 	return &result, nil
 }
 
+// ProposalSortKeys lists the fields proposals can be sorted by.
+var ProposalSortKeys = []string{"id", "submit-time", "voting-end"}
+
+// SortProposals sorts proposals by one of ProposalSortKeys, keeping the
+// order of equal proposals. Proposals with a missing or unparsable value
+// come last in either order.
+func SortProposals(proposals []Proposal, by string, descending bool) error {
+	var key func(p *Proposal) (int64, bool)
+	switch by {
+	case "id":
+		key = func(p *Proposal) (int64, bool) {
+			id, err := strconv.ParseInt(p.ProposalID, 10, 64)
+			return id, err == nil
+		}
+	case "submit-time":
+		key = func(p *Proposal) (int64, bool) { return parseProposalTime(p.SubmitTime) }
+	case "voting-end":
+		key = func(p *Proposal) (int64, bool) { return parseProposalTime(p.VotingEndTime) }
+	default:
+		return fmt.Errorf("invalid sort key %q (valid: %s)", by, strings.Join(ProposalSortKeys, ", "))
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		a, aok := key(&proposals[i])
+		b, bok := key(&proposals[j])
+		if !aok || !bok {
+			return aok && !bok
+		}
+		if descending {
+			return a > b
+		}
+		return a < b
+	})
+	return nil
+}
+
+// parseProposalTime parses an RFC 3339 or Unix seconds timestamp into
+// Unix nanoseconds. Zero timestamps are reported as missing.
+func parseProposalTime(s string) (int64, bool) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		if t.IsZero() || t.Unix() <= 0 {
+			return 0, false
+		}
+		return t.UnixNano(), true
+	}
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil && secs > 0 {
+		return time.Unix(secs, 0).UnixNano(), true
+	}
+	return 0, false
+}
+
 // Proposal queries a specific proposal by ID.
 func (m *Module) Proposal(ctx context.Context, proposalID string) (*Proposal, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -97,6 +168,75 @@ func (m *Module) Proposal(ctx context.Context, proposalID string) (*Proposal, er
 	return &result.Proposal, nil
 }
 
+// ErrNotFinal indicates a proposal did not reach a final result before the
+// wait timeout elapsed.
+var ErrNotFinal = errors.New("proposal not yet final")
+
+// Default settings for WaitFinal.
+const (
+	DefaultFinalTimeout      = 30 * time.Minute
+	DefaultFinalPollInterval = 10 * time.Second
+)
+
+// WaitOptions configures WaitFinal.
+type WaitOptions struct {
+	// Timeout is how long to wait for a final result (default: DefaultFinalTimeout)
+	Timeout time.Duration
+
+	// PollInterval is the time between queries (default: DefaultFinalPollInterval)
+	PollInterval time.Duration
+
+	// OnChange is called with the first proposal seen and whenever its
+	// result or execution result changes; prev is nil on the first call.
+	OnChange func(prev, curr *Proposal)
+}
+
+// WaitFinal polls a proposal until it reaches a final result (see
+// Proposal.IsFinal) and returns it. Query errors are retried until the
+// timeout. On timeout the last proposal seen, if any, is returned with an
+// error wrapping ErrNotFinal.
+func (m *Module) WaitFinal(ctx context.Context, proposalID string, opts *WaitOptions) (*Proposal, error) {
+	timeout := DefaultFinalTimeout
+	interval := DefaultFinalPollInterval
+	var onChange func(prev, curr *Proposal)
+	if opts != nil {
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+		onChange = opts.OnChange
+	}
+
+	deadline := time.Now().Add(timeout)
+	var last *Proposal
+	for {
+		proposal, err := m.Proposal(ctx, proposalID)
+		if err == nil {
+			if onChange != nil && (last == nil || last.Result != proposal.Result || last.ExecResult != proposal.ExecResult) {
+				onChange(last, proposal)
+			}
+			last = proposal
+			if proposal.IsFinal() {
+				return proposal, nil
+			}
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			if last == nil {
+				return nil, fmt.Errorf("%w: proposal %s after %s: %v", ErrNotFinal, proposalID, timeout, err)
+			}
+			return last, fmt.Errorf("%w: proposal %s is %s after %s", ErrNotFinal, proposalID, last.Result, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
 // Votes queries votes on a proposal.
 func (m *Module) Votes(ctx context.Context, proposalID string) ([]Vote, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -117,6 +257,109 @@ func (m *Module) Votes(ctx context.Context, proposalID string) ([]Vote, error) {
 	return result.Votes, nil
 }
 
+// Vote thresholds used to project a tally: more than half of the
+// non-abstaining votes must be yes, and a third of all votes vetoes.
+const (
+	tallyPassThreshold = 0.5
+	tallyVetoThreshold = 1.0 / 3
+)
+
+// ProposalTally counts the votes on a proposal and projects whether it
+// would pass if voting ended now. Turnout is measured against the
+// proposal's eligible voters and compared with the network vote quorum,
+// which is queried unless given in opts.
+func (m *Module) ProposalTally(ctx context.Context, proposalID string, opts *TallyOpts) (*ProposalTally, error) {
+	votes, err := m.Votes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	voters, err := m.Voters(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	quorumValue := ""
+	if opts != nil {
+		quorumValue = opts.VoteQuorum
+	}
+	if quorumValue == "" {
+		props, err := m.NetworkProperties(ctx)
+		if err != nil {
+			return nil, err
+		}
+		quorumValue = props.VoteQuorum
+	}
+	quorum, err := parseQuorum(quorumValue)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := &ProposalTally{
+		ProposalID:     proposalID,
+		EligibleVoters: len(voters),
+		Quorum:         strconv.FormatFloat(quorum, 'f', -1, 64),
+	}
+	for _, v := range votes {
+		switch voteOption(v.Option) {
+		case "yes":
+			tally.Yes++
+		case "no":
+			tally.No++
+		case "abstain":
+			tally.Abstain++
+		case "no_with_veto":
+			tally.NoWithVeto++
+		default:
+			continue
+		}
+		tally.TotalVotes++
+	}
+
+	turnout := 0.0
+	if tally.EligibleVoters > 0 {
+		turnout = float64(tally.TotalVotes) / float64(tally.EligibleVoters)
+	}
+	tally.Turnout = strconv.FormatFloat(turnout, 'f', 4, 64)
+	tally.QuorumReached = tally.TotalVotes > 0 && turnout >= quorum
+	if tally.TotalVotes > 0 {
+		tally.Vetoed = float64(tally.NoWithVeto) >= tallyVetoThreshold*float64(tally.TotalVotes)
+	}
+	if cast := tally.TotalVotes - tally.Abstain; cast > 0 {
+		tally.WouldPass = tally.QuorumReached && !tally.Vetoed &&
+			float64(tally.Yes) > tallyPassThreshold*float64(cast)
+	}
+	return tally, nil
+}
+
+// parseQuorum parses a vote quorum given as a fraction ("0.33") or a
+// percentage ("33").
+func parseQuorum(s string) (float64, error) {
+	q, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "%"), 64)
+	if err != nil || q < 0 || q > 100 {
+		return 0, fmt.Errorf("invalid vote quorum: %q", s)
+	}
+	if q > 1 {
+		q /= 100
+	}
+	return q, nil
+}
+
+// voteOption normalizes a vote option such as "VOTE_OPTION_YES" or "1".
+func voteOption(option string) string {
+	switch o := strings.ToLower(strings.TrimPrefix(strings.ToUpper(option), "VOTE_OPTION_")); o {
+	case "yes", "1":
+		return "yes"
+	case "abstain", "2":
+		return "abstain"
+	case "no", "3":
+		return "no"
+	case "no_with_veto", "nowithveto", "veto", "4":
+		return "no_with_veto"
+	default:
+		return o
+	}
+}
+
 // Vote queries a specific vote.
 func (m *Module) Vote(ctx context.Context, proposalID, voter string) (*Vote, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -176,6 +419,45 @@ func (m *Module) Councilors(ctx context.Context) ([]Councilor, error) {
 	return result.Councilors, nil
 }
 
+// CouncilorsByStatus queries the councilors with status, one of
+// CouncilorStatuses. The node does not filter by status, so it is applied
+// to the list of all councilors.
+func (m *Module) CouncilorsByStatus(ctx context.Context, status string) ([]Councilor, error) {
+	if !slices.Contains(CouncilorStatuses, status) {
+		return nil, fmt.Errorf("invalid councilor status %q: expected one of %s", status, strings.Join(CouncilorStatuses, ", "))
+	}
+	councilors, err := m.Councilors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return FilterCouncilors(councilors, status), nil
+}
+
+// FilterCouncilors returns the councilors with status, one of
+// CouncilorStatuses, reusing the backing array of councilors.
+func FilterCouncilors(councilors []Councilor, status string) []Councilor {
+	filtered := councilors[:0]
+	for _, c := range councilors {
+		if c.State() == status {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// CountCouncilors returns the number of councilors in each of
+// CouncilorStatuses.
+func CountCouncilors(councilors []Councilor) map[string]int {
+	counts := make(map[string]int, len(CouncilorStatuses))
+	for _, status := range CouncilorStatuses {
+		counts[status] = 0
+	}
+	for i := range councilors {
+		counts[councilors[i].State()]++
+	}
+	return counts
+}
+
 // Roles queries roles assigned to an address.
 func (m *Module) Roles(ctx context.Context, address string) ([]string, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -321,21 +603,30 @@ func (m *Module) IdentityRecord(ctx context.Context, id string) (*IdentityRecord
 
 // IdentityRecords queries all identity records.
 func (m *Module) IdentityRecords(ctx context.Context) ([]IdentityRecord, error) {
+	result, err := m.IdentityRecordsPage(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.Records, nil
+}
+
+// IdentityRecordsPage queries a page of the identity records. A nil
+// pagination returns the node's default page.
+func (m *Module) IdentityRecordsPage(ctx context.Context, pagination *sdk.Pagination) (*IdentityRecordsResponse, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customgov",
 		Endpoint: "identity-records",
+		Params:   pagination.Params(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query identity records: %w", err)
 	}
 
-	var result struct {
-		Records []IdentityRecord `json:"records"`
-	}
+	var result IdentityRecordsResponse
 	if err := json.Unmarshal(resp.Data, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse identity records: %w", err)
 	}
-	return result.Records, nil
+	return &result, nil
 }
 
 // IdentityRecordsByAddress queries identity records by address.
@@ -378,21 +669,30 @@ func (m *Module) DataRegistry(ctx context.Context, key string) (*DataRegistryEnt
 
 // DataRegistryKeys queries all data registry keys.
 func (m *Module) DataRegistryKeys(ctx context.Context) ([]string, error) {
+	result, err := m.DataRegistryKeysPage(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
+
+// DataRegistryKeysPage queries a page of the data registry keys. A nil
+// pagination returns the node's default page.
+func (m *Module) DataRegistryKeysPage(ctx context.Context, pagination *sdk.Pagination) (*DataRegistryKeysResponse, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customgov",
 		Endpoint: "data-registry-keys",
+		Params:   pagination.Params(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query data registry keys: %w", err)
 	}
 
-	var result struct {
-		Keys []string `json:"keys"`
-	}
+	var result DataRegistryKeysResponse
 	if err := json.Unmarshal(resp.Data, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse data registry keys: %w", err)
 	}
-	return result.Keys, nil
+	return &result, nil
 }
 
 // Polls queries polls by address.
@@ -625,21 +925,30 @@ func (m *Module) ProposerVotersCount(ctx context.Context) (*ProposerVotersCount,
 
 // AllIdentityRecordVerifyRequests queries all identity record verify requests.
 func (m *Module) AllIdentityRecordVerifyRequests(ctx context.Context) ([]IdentityRecordVerifyRequest, error) {
+	result, err := m.AllIdentityRecordVerifyRequestsPage(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.Requests, nil
+}
+
+// AllIdentityRecordVerifyRequestsPage queries a page of the identity
+// record verify requests. A nil pagination returns the node's default page.
+func (m *Module) AllIdentityRecordVerifyRequestsPage(ctx context.Context, pagination *sdk.Pagination) (*IdentityRecordVerifyRequestsResponse, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customgov",
 		Endpoint: "all-identity-record-verify-requests",
+		Params:   pagination.Params(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query identity record verify requests: %w", err)
 	}
 
-	var result struct {
-		Requests []IdentityRecordVerifyRequest `json:"verify_requests"`
-	}
+	var result IdentityRecordVerifyRequestsResponse
 	if err := json.Unmarshal(resp.Data, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse identity record verify requests: %w", err)
 	}
-	return result.Requests, nil
+	return &result, nil
 }
 
 // IdentityRecordVerifyRequest queries identity record verify request by ID.
@@ -709,6 +1018,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 // VoteProposal votes on a proposal.
@@ -747,6 +1057,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -1651,13 +1964,13 @@ func (m *Module) ProposalSetPoorNetworkMsgs(ctx context.Context, from, messages
 
 // ProposalSetExecutionFeesOpts contains options for proposal set execution fees.
 type ProposalSetExecutionFeesOpts struct {
-	Title         string
-	Description   string
-	TxTypes       string
-	ExecutionFees string
-	FailureFees   string
-	Timeouts      string
-	DefaultParams string
+	Title         string `json:"title,omitempty"`
+	Description   string `json:"description,omitempty"`
+	TxTypes       string `json:"tx_types,omitempty"`
+	ExecutionFees string `json:"execution_fees,omitempty"`
+	FailureFees   string `json:"failure_fees,omitempty"`
+	Timeouts      string `json:"timeouts,omitempty"`
+	DefaultParams string `json:"default_params,omitempty"`
 }
 
 // ProposalSetExecutionFees creates a proposal to set execution fees.
@@ -0,0 +1,149 @@
+package gov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// MsgSubmitProposalType is the type URL of the message sekaid accepts for
+// proposals of every type.
+const MsgSubmitProposalType = "/kira.gov.MsgSubmitProposal"
+
+// DefaultProposalGas is the gas limit of a proposal submitted from a file
+// when none is given.
+const DefaultProposalGas = "200000"
+
+// ProposalFile is a proposal of any type, as read from a JSON file. Content
+// is the proposal itself, with its type URL in "@type", e.g.
+// {"@type": "/kira.gov.SetNetworkPropertyProposal", ...}.
+type ProposalFile struct {
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Content     json.RawMessage `json:"content"`
+}
+
+// ContentType returns the type URL of the proposal content.
+func (p *ProposalFile) ContentType() string {
+	var content struct {
+		Type string `json:"@type"`
+	}
+	_ = json.Unmarshal(p.Content, &content)
+	return content.Type
+}
+
+// ParseProposalFile parses a proposal JSON and checks that it has a title
+// and a content object with a type URL.
+func ParseProposalFile(data []byte) (*ProposalFile, error) {
+	var p ProposalFile
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("malformed proposal JSON: %w", err)
+	}
+	if strings.TrimSpace(p.Title) == "" {
+		return nil, fmt.Errorf("proposal has no \"title\"")
+	}
+	if len(p.Content) == 0 || string(p.Content) == "null" {
+		return nil, fmt.Errorf("proposal has no \"content\"")
+	}
+	var content map[string]json.RawMessage
+	if err := json.Unmarshal(p.Content, &content); err != nil {
+		return nil, fmt.Errorf("proposal \"content\" must be an object: %w", err)
+	}
+	if t := p.ContentType(); t == "" || !strings.HasPrefix(t, "/") {
+		return nil, fmt.Errorf("proposal \"content\" needs an \"@type\" type URL, e.g. \"/kira.gov.SetNetworkPropertyProposal\"")
+	}
+	return &p, nil
+}
+
+// SubmitProposal submits a proposal of any type: it builds a
+// MsgSubmitProposal transaction, signs it with from and broadcasts it. The
+// client must be able to sign, so the REST client is not supported. Fees
+// must be set in txOpts; gas defaults to DefaultProposalGas.
+func (m *Module) SubmitProposal(ctx context.Context, from string, proposal *ProposalFile, txOpts *TxOptions) (*sdk.TxResponse, error) {
+	if txOpts == nil || txOpts.Fees == "" {
+		return nil, fmt.Errorf("fees are required to submit a proposal")
+	}
+	fees, err := types.ParseCoins(txOpts.Fees)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fees: %w", err)
+	}
+	gas := txOpts.Gas
+	if gas == "" {
+		gas = DefaultProposalGas
+	}
+	if gas == "auto" {
+		return nil, fmt.Errorf("gas \"auto\" is not supported for proposal files; set a gas limit")
+	}
+
+	proposer := from
+	if types.GetAddressType(from) != "account" {
+		info, err := m.client.Keys().Show(ctx, from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve proposer %s: %w", from, err)
+		}
+		proposer = info.Address
+	}
+
+	tx := map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages": []interface{}{map[string]interface{}{
+				"@type":       MsgSubmitProposalType,
+				"proposer":    proposer,
+				"title":       proposal.Title,
+				"description": proposal.Description,
+				"content":     proposal.Content,
+			}},
+			"memo":                           txOpts.Memo,
+			"timeout_height":                 "0",
+			"extension_options":              []interface{}{},
+			"non_critical_extension_options": []interface{}{},
+		},
+		"auth_info": map[string]interface{}{
+			"signer_infos": []interface{}{},
+			"fee": map[string]interface{}{
+				"amount":    fees,
+				"gas_limit": gas,
+				"payer":     "",
+				"granter":   "",
+			},
+		},
+		"signatures": []interface{}{},
+	}
+	unsigned, err := json.Marshal(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build proposal transaction: %w", err)
+	}
+
+	offline, ok := m.client.(sdk.OfflineTxClient)
+	if !ok {
+		return nil, fmt.Errorf("failed to sign proposal: %w", sdk.ErrNotSupported)
+	}
+	signed, err := offline.SignTx(ctx, unsigned, &sdk.SignOptions{Signer: from})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign proposal: %w", err)
+	}
+	resp, err := offline.BroadcastTx(ctx, signed, txOpts.BroadcastMode)
+	if err != nil {
+		return resp, fmt.Errorf("failed to submit proposal: %w", err)
+	}
+	return resp, nil
+}
+
+// ProposalIDFromEvents returns the proposal ID emitted by a proposal
+// submission, or "" if there is none.
+func ProposalIDFromEvents(events []sdk.TxEvent) string {
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Key == "proposal_id" {
+				return attr.Value
+			}
+		}
+	}
+	return ""
+}
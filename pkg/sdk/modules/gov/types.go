@@ -1,5 +1,11 @@
 package gov
 
+import (
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
 // NetworkProperties contains network configuration.
 type NetworkProperties struct {
 	MinTxFee                    string `json:"min_tx_fee"`
@@ -32,11 +38,22 @@ type NetworkProperties struct {
 type ProposalQueryOpts struct {
 	Voter  string
 	Status string
+	// Type filters by proposal type (e.g. SetNetworkProperty). The node
+	// does not support it, so it is applied to the returned page.
+	Type       string
+	Pagination *sdk.Pagination
 }
 
 // ProposalsResponse contains proposals query response.
 type ProposalsResponse struct {
-	Proposals []Proposal `json:"proposals"`
+	Proposals  []Proposal          `json:"proposals"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// PaginationResponse represents pagination info in response.
+type PaginationResponse struct {
+	NextKey string `json:"next_key,omitempty"`
+	Total   string `json:"total,omitempty"`
 }
 
 // Proposal represents a governance proposal.
@@ -54,6 +71,53 @@ type Proposal struct {
 	MinVotingEndTime string `json:"min_voting_end_time,omitempty"`
 }
 
+// Type returns the proposal type from its content's "@type", e.g.
+// "SetNetworkProperty" for "/kira.gov.SetNetworkPropertyProposal".
+func (p *Proposal) Type() string {
+	content, ok := p.Content.(map[string]any)
+	if !ok {
+		return ""
+	}
+	typeURL, _ := content["@type"].(string)
+	if typeURL == "" {
+		typeURL, _ = content["type"].(string)
+	}
+	return normalizeProposalType(typeURL)
+}
+
+// Proposal results, as reported in Proposal.Result.
+const (
+	ResultUnknown            = "VOTE_RESULT_UNKNOWN"
+	ResultPending            = "VOTE_PENDING"
+	ResultEnactment          = "VOTE_RESULT_ENACTMENT"
+	ResultPassed             = "VOTE_RESULT_PASSED"
+	ResultPassedWithExecFail = "VOTE_RESULT_PASSED_WITH_EXEC_FAIL"
+	ResultRejected           = "VOTE_RESULT_REJECTED"
+	ResultRejectedWithVeto   = "VOTE_RESULT_REJECTED_WITH_VETO"
+	ResultQuorumNotReached   = "VOTE_RESULT_QUORUM_NOT_REACHED"
+)
+
+// IsFinal reports whether the proposal has reached a terminal result: it
+// was enacted, rejected or did not reach quorum. Pending proposals and
+// passed proposals still in their enactment period are not final.
+func (p *Proposal) IsFinal() bool {
+	switch strings.ToUpper(p.Result) {
+	case ResultPassed, ResultPassedWithExecFail, ResultRejected,
+		ResultRejectedWithVeto, ResultQuorumNotReached:
+		return true
+	}
+	return false
+}
+
+// normalizeProposalType strips the package path and "Proposal" suffix from
+// a proposal type.
+func normalizeProposalType(t string) string {
+	if i := strings.LastIndexAny(t, "./"); i >= 0 {
+		t = t[i+1:]
+	}
+	return strings.TrimSuffix(t, "Proposal")
+}
+
 // Vote represents a vote on a proposal.
 type Vote struct {
 	ProposalID string `json:"proposal_id"`
@@ -61,6 +125,33 @@ type Vote struct {
 	Option     string `json:"option"`
 }
 
+// TallyOpts contains options for tallying a proposal.
+type TallyOpts struct {
+	// VoteQuorum is the network's vote quorum, e.g. from a cache. Empty
+	// queries the network properties.
+	VoteQuorum string
+}
+
+// ProposalTally summarizes the votes on a proposal and projects its
+// outcome if voting ended now.
+type ProposalTally struct {
+	ProposalID string `json:"proposal_id"`
+	Yes        int    `json:"yes"`
+	No         int    `json:"no"`
+	Abstain    int    `json:"abstain"`
+	NoWithVeto int    `json:"no_with_veto"`
+	TotalVotes int    `json:"total_votes"`
+	// EligibleVoters is the number of actors allowed to vote.
+	EligibleVoters int `json:"eligible_voters"`
+	// Turnout is TotalVotes / EligibleVoters as a decimal fraction.
+	Turnout string `json:"turnout"`
+	// Quorum is the required turnout as a decimal fraction.
+	Quorum        string `json:"quorum"`
+	QuorumReached bool   `json:"quorum_reached"`
+	Vetoed        bool   `json:"vetoed"`
+	WouldPass     bool   `json:"would_pass"`
+}
+
 // Councilor represents a councilor.
 type Councilor struct {
 	Address string `json:"address"`
@@ -69,6 +160,18 @@ type Councilor struct {
 	Rank    string `json:"rank,omitempty"`
 }
 
+// CouncilorStatuses lists the councilor statuses, as accepted by
+// CouncilorsByStatus.
+var CouncilorStatuses = []string{"active", "inactive", "paused", "jailed"}
+
+// State returns the councilor's status as one of CouncilorStatuses; sekaid
+// prints it as an enum name such as COUNCILOR_ACTIVE.
+func (c *Councilor) State() string {
+	status := strings.ToLower(c.Status)
+	status = strings.TrimPrefix(status, "councilor")
+	return strings.TrimPrefix(status, "_")
+}
+
 // Role represents a governance role.
 type Role struct {
 	ID          uint64           `json:"id"`
@@ -109,6 +212,18 @@ type IdentityRecord struct {
 	Infos     map[string]string `json:"infos,omitempty"`
 }
 
+// IdentityRecordsResponse contains identity records query response.
+type IdentityRecordsResponse struct {
+	Records    []IdentityRecord    `json:"records"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// DataRegistryKeysResponse contains data registry keys query response.
+type DataRegistryKeysResponse struct {
+	Keys       []string            `json:"keys"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
 // DataRegistryEntry represents a data registry entry.
 type DataRegistryEntry struct {
 	Key       string `json:"key"`
@@ -173,3 +288,66 @@ type IdentityRecordVerifyRequest struct {
 	RecordIds []string `json:"record_ids,omitempty"`
 	Tip       string   `json:"tip,omitempty"`
 }
+
+// IdentityRecordVerifyRequestsResponse contains identity record verify
+// requests query response.
+type IdentityRecordVerifyRequestsResponse struct {
+	Requests   []IdentityRecordVerifyRequest `json:"verify_requests"`
+	Pagination *PaginationResponse           `json:"pagination,omitempty"`
+}
+
+// ProposalTypes lists the proposal types known to SEKAI governance.
+// These are the names accepted by set-proposal-durations and returned
+// by the all-proposal-durations query.
+var ProposalTypes = []string{
+	"WhitelistAccountPermission",
+	"BlacklistAccountPermission",
+	"RemoveWhitelistedAccountPermission",
+	"RemoveBlacklistedAccountPermission",
+	"AssignRoleToAccount",
+	"UnassignRoleFromAccount",
+	"SetNetworkProperty",
+	"UpsertDataRegistry",
+	"SetPoorNetworkMessages",
+	"CreateRole",
+	"RemoveRole",
+	"WhitelistRolePermission",
+	"BlacklistRolePermission",
+	"RemoveWhitelistedRolePermission",
+	"RemoveBlacklistedRolePermission",
+	"UpsertTokenInfos",
+	"TokensWhiteBlackChange",
+	"UnjailValidator",
+	"ResetWholeValidatorRank",
+	"SoftwareUpgrade",
+	"CancelSoftwareUpgrade",
+	"SetProposalDurations",
+	"UpsertUBI",
+	"RemoveUBI",
+	"SlashValidator",
+	"UpdateSpendingPool",
+	"SpendingPoolDistribution",
+	"SpendingPoolWithdraw",
+	"CreateBasket",
+	"EditBasket",
+	"BasketWithdrawSurplus",
+	"CollectiveSendDonation",
+	"CollectiveUpdate",
+	"CollectiveRemove",
+	"JoinDapp",
+	"TransitionDapp",
+	"UpsertDapp",
+	"SetExecutionFees",
+	"ResetWholeCouncilorRank",
+	"JailCouncilor",
+}
+
+// IsKnownProposalType reports whether name is a known proposal type.
+func IsKnownProposalType(name string) bool {
+	for _, t := range ProposalTypes {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
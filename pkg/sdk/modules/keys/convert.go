@@ -0,0 +1,79 @@
+package keys
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// AddressPrefixes are the bech32 prefixes ConvertAddress converts between:
+// account, validator operator and consensus addresses.
+var AddressPrefixes = []string{
+	types.Bech32PrefixAccAddr,
+	types.Bech32PrefixValAddr,
+	types.Bech32PrefixConsAddr,
+}
+
+// RelatedAddresses is an address in each of the AddressPrefixes.
+type RelatedAddresses struct {
+	Account   string `json:"account"`
+	Validator string `json:"validator"`
+	Consensus string `json:"consensus"`
+}
+
+// ConvertAddress re-encodes a kira, kiravaloper or kiravalcons address
+// with another of these prefixes. It works offline; the address checksum
+// is verified and mixed-case addresses are rejected.
+func ConvertAddress(address, prefix string) (string, error) {
+	prefix = strings.TrimSuffix(strings.ToLower(prefix), "1")
+	if !isAddressPrefix(prefix) {
+		return "", fmt.Errorf("unsupported prefix %q (use one of %s)", prefix, strings.Join(AddressPrefixes, ", "))
+	}
+	data, err := decodeAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return types.Bech32Encode(prefix, data)
+}
+
+// ConvertAddressAll returns an address with each of the AddressPrefixes.
+func ConvertAddressAll(address string) (*RelatedAddresses, error) {
+	data, err := decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	encoded := make([]string, len(AddressPrefixes))
+	for i, prefix := range AddressPrefixes {
+		if encoded[i], err = types.Bech32Encode(prefix, data); err != nil {
+			return nil, err
+		}
+	}
+	return &RelatedAddresses{
+		Account:   encoded[0],
+		Validator: encoded[1],
+		Consensus: encoded[2],
+	}, nil
+}
+
+// decodeAddress decodes a bech32 address with one of the AddressPrefixes.
+func decodeAddress(address string) ([]byte, error) {
+	hrp, data, err := types.Bech32Decode(strings.TrimSpace(address))
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if !isAddressPrefix(hrp) {
+		return nil, fmt.Errorf("invalid address %q: prefix %q is not one of %s", address, hrp, strings.Join(AddressPrefixes, ", "))
+	}
+	return data, nil
+}
+
+// isAddressPrefix reports whether prefix is one of the AddressPrefixes.
+func isAddressPrefix(prefix string) bool {
+	for _, p := range AddressPrefixes {
+		if p == prefix {
+			return true
+		}
+	}
+	return false
+}
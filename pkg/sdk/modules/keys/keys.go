@@ -3,6 +3,7 @@ package keys
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
@@ -19,10 +20,49 @@ func New(client sdk.Client) *Module {
 }
 
 // Add creates a new key with the given name.
+// For a multisig key, the referenced keys must already exist in the keyring.
 func (m *Module) Add(ctx context.Context, name string, opts *sdk.KeyAddOptions) (*sdk.KeyInfo, error) {
+	if opts != nil && len(opts.Multisig) > 0 {
+		if err := m.validateMultisig(ctx, opts); err != nil {
+			return nil, err
+		}
+	}
 	return m.client.Keys().Add(ctx, name, opts)
 }
 
+// validateMultisig checks the threshold and that every referenced key exists.
+func (m *Module) validateMultisig(ctx context.Context, opts *sdk.KeyAddOptions) error {
+	if opts.Recover {
+		return fmt.Errorf("multisig keys cannot be recovered from a mnemonic")
+	}
+	if opts.MultisigThreshold < 1 {
+		return fmt.Errorf("multisig threshold must be at least 1, got %d", opts.MultisigThreshold)
+	}
+	if opts.MultisigThreshold > len(opts.Multisig) {
+		return fmt.Errorf("multisig threshold %d exceeds number of keys (%d)", opts.MultisigThreshold, len(opts.Multisig))
+	}
+
+	seen := make(map[string]bool, len(opts.Multisig))
+	for _, key := range opts.Multisig {
+		if key == "" {
+			return fmt.Errorf("multisig key name cannot be empty")
+		}
+		if seen[key] {
+			return fmt.Errorf("multisig key %q listed more than once", key)
+		}
+		seen[key] = true
+
+		exists, err := m.Exists(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to look up multisig key %q: %w", key, err)
+		}
+		if !exists {
+			return fmt.Errorf("multisig key %q not found in keyring (import it first)", key)
+		}
+	}
+	return nil
+}
+
 // Delete removes a key by name.
 func (m *Module) Delete(ctx context.Context, name string, force bool) error {
 	return m.client.Keys().Delete(ctx, name, force)
@@ -43,11 +83,50 @@ func (m *Module) Export(ctx context.Context, name string) (string, error) {
 	return m.client.Keys().Export(ctx, name)
 }
 
+// ExportWithPassphrase exports a private key as an ASCII-armored string
+// encrypted with passphrase.
+func (m *Module) ExportWithPassphrase(ctx context.Context, name, passphrase string) (string, error) {
+	exporter, err := m.exporter()
+	if err != nil {
+		return "", err
+	}
+	return exporter.ExportWithPassphrase(ctx, name, passphrase)
+}
+
+// ExportHex exports a private key as unarmored hex.
+func (m *Module) ExportHex(ctx context.Context, name string) (string, error) {
+	exporter, err := m.exporter()
+	if err != nil {
+		return "", err
+	}
+	return exporter.ExportHex(ctx, name)
+}
+
 // Import imports a key from ASCII-armored string.
 func (m *Module) Import(ctx context.Context, name, armor string) error {
 	return m.client.Keys().Import(ctx, name, armor)
 }
 
+// ImportWithPassphrase imports a private key from an ASCII-armored string
+// encrypted with passphrase.
+func (m *Module) ImportWithPassphrase(ctx context.Context, name, armor, passphrase string) error {
+	exporter, err := m.exporter()
+	if err != nil {
+		return err
+	}
+	return exporter.ImportWithPassphrase(ctx, name, armor, passphrase)
+}
+
+// exporter returns the keyring as a KeysExporter, or an error wrapping
+// sdk.ErrNotSupported if it cannot export private keys.
+func (m *Module) exporter() (sdk.KeysExporter, error) {
+	exporter, ok := m.client.Keys().(sdk.KeysExporter)
+	if !ok {
+		return nil, fmt.Errorf("keyring cannot export or import private keys: %w", sdk.ErrNotSupported)
+	}
+	return exporter, nil
+}
+
 // Rename renames a key.
 func (m *Module) Rename(ctx context.Context, oldName, newName string) error {
 	return m.client.Keys().Rename(ctx, oldName, newName)
@@ -98,6 +177,12 @@ type CreateOptions struct {
 
 	// Index is the account index for HD derivation
 	Index uint32
+
+	// Multisig lists existing key names to combine into a multisig key
+	Multisig []string
+
+	// MultisigThreshold is the number of signatures required
+	MultisigThreshold int
 }
 
 // ToSDKOptions converts CreateOptions to sdk.KeyAddOptions.
@@ -112,6 +197,9 @@ func (o *CreateOptions) ToSDKOptions() *sdk.KeyAddOptions {
 		Algorithm: o.Algorithm,
 		NoBackup:  o.NoBackup,
 		Index:     o.Index,
+
+		Multisig:          o.Multisig,
+		MultisigThreshold: o.MultisigThreshold,
 	}
 }
 
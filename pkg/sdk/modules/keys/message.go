@@ -0,0 +1,200 @@
+package keys
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/crypto/secp256k1"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// SignedMessage is an off-chain message signed with a keyring key.
+// PubKey and Signature are base64 encoded.
+type SignedMessage struct {
+	Signer    string `json:"signer"`
+	Message   string `json:"message"`
+	PubKey    string `json:"pub_key"`
+	Signature string `json:"signature"`
+}
+
+// messageSignDoc is the ADR-036 amino JSON sign document. Fields are in
+// sorted order, as required for amino JSON sign bytes.
+type messageSignDoc struct {
+	AccountNumber string           `json:"account_number"`
+	ChainID       string           `json:"chain_id"`
+	Fee           messageSignFee   `json:"fee"`
+	Memo          string           `json:"memo"`
+	Msgs          []messageSignMsg `json:"msgs"`
+	Sequence      string           `json:"sequence"`
+}
+
+type messageSignFee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type messageSignMsg struct {
+	Type  string `json:"type"`
+	Value struct {
+		Data   string `json:"data"`
+		Signer string `json:"signer"`
+	} `json:"value"`
+}
+
+// MessageSignBytes returns the ADR-036 sign bytes for an off-chain
+// message: a sign document with a single MsgSignData and an empty chain
+// ID, zero account number, sequence and fee. Such a document can never be
+// a valid transaction, and wallets implementing ADR-036 produce the same
+// bytes.
+func MessageSignBytes(signer string, data []byte) []byte {
+	msg := messageSignMsg{Type: "sign/MsgSignData"}
+	msg.Value.Data = base64.StdEncoding.EncodeToString(data)
+	msg.Value.Signer = signer
+
+	doc := messageSignDoc{
+		AccountNumber: "0",
+		Fee:           messageSignFee{Amount: []struct{}{}, Gas: "0"},
+		Msgs:          []messageSignMsg{msg},
+		Sequence:      "0",
+	}
+
+	// Marshalling strings and empty slices cannot fail.
+	b, _ := json.Marshal(doc)
+	return b
+}
+
+// SignMessage signs an arbitrary message with a keyring key. The key is
+// used inside the keyring; the signature is checked against the ADR-036
+// sign bytes before it is returned, so a signer that signed a different
+// document is caught here rather than by a verifier.
+func (m *Module) SignMessage(ctx context.Context, keyName, msg string) (*SignedMessage, error) {
+	signer, ok := m.client.Keys().(sdk.KeysSigner)
+	if !ok {
+		return nil, fmt.Errorf("failed to sign message: %w", sdk.ErrNotSupported)
+	}
+	info, err := m.client.Keys().Show(ctx, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pub, err := signer.SignMessage(ctx, keyName, info.Address, []byte(msg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+	if !secp256k1.Verify(pub, MessageSignBytes(info.Address, []byte(msg)), sig) {
+		return nil, fmt.Errorf("failed to sign message: signature does not match the ADR-036 sign document")
+	}
+
+	return &SignedMessage{
+		Signer:    info.Address,
+		Message:   msg,
+		PubKey:    base64.StdEncoding.EncodeToString(pub),
+		Signature: base64.StdEncoding.EncodeToString(sig),
+	}, nil
+}
+
+// VerifyMessage verifies a base64 signature of msg by address. The
+// signer's public key is looked up in the keyring, then on chain (which
+// requires the account to have sent at least one transaction). Use
+// VerifyMessageWithPubKey if the public key is known.
+func (m *Module) VerifyMessage(ctx context.Context, address, msg, signature string) (bool, error) {
+	pubKey, err := m.lookupPubKey(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	return VerifyMessageWithPubKey(address, msg, signature, pubKey)
+}
+
+// VerifyMessageWithPubKey verifies a base64 signature of msg by address
+// using a base64 compressed secp256k1 public key, which must belong to
+// address.
+func VerifyMessageWithPubKey(address, msg, signature, pubKey string) (bool, error) {
+	pub, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid public key: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	derived, err := secp256k1.Address(pub)
+	if err != nil {
+		return false, err
+	}
+	hrp, addr, err := types.Bech32Decode(address)
+	if err != nil {
+		return false, fmt.Errorf("invalid address: %w", err)
+	}
+	if hrp != types.Bech32PrefixAccAddr || string(addr) != string(derived) {
+		return false, fmt.Errorf("public key does not belong to %s", address)
+	}
+
+	return secp256k1.Verify(pub, MessageSignBytes(address, []byte(msg)), sig), nil
+}
+
+// lookupPubKey finds the base64 public key of an address.
+func (m *Module) lookupPubKey(ctx context.Context, address string) (string, error) {
+	info, err := m.client.Keys().Show(ctx, address)
+	if err == nil && info.PubKey != "" {
+		return parsePubKey(info.PubKey)
+	}
+	if err != nil && !errors.Is(err, sdk.ErrKeyNotFound) && !errors.Is(err, sdk.ErrNotSupported) {
+		return "", err
+	}
+
+	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
+		Module:   "auth",
+		Endpoint: "account",
+		RawArgs:  []string{address},
+	})
+	if err != nil {
+		return "", fmt.Errorf("public key of %s not found in keyring and account query failed: %w", address, err)
+	}
+
+	var account struct {
+		PubKey  json.RawMessage `json:"pub_key"`
+		Account struct {
+			PubKey json.RawMessage `json:"pub_key"`
+		} `json:"account"`
+	}
+	if err := json.Unmarshal(resp.Data, &account); err != nil {
+		return "", fmt.Errorf("failed to parse account: %w", err)
+	}
+	raw := account.PubKey
+	if len(raw) == 0 || string(raw) == "null" {
+		raw = account.Account.PubKey
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", fmt.Errorf("public key of %s is unknown (the account has not signed a transaction yet); pass it explicitly", address)
+	}
+	return parsePubKey(string(raw))
+}
+
+// parsePubKey extracts the base64 key from a public key as printed by
+// sekaid ({"@type": ..., "key": ...}) or returns a bare base64 key as is.
+func parsePubKey(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") {
+		return s, nil
+	}
+	var pk struct {
+		Type string `json:"@type"`
+		Key  string `json:"key"`
+	}
+	if err := json.Unmarshal([]byte(s), &pk); err != nil {
+		return "", fmt.Errorf("failed to parse public key: %w", err)
+	}
+	if pk.Key == "" {
+		return "", fmt.Errorf("unsupported public key type %q", pk.Type)
+	}
+	if pk.Type != "" && !strings.HasSuffix(pk.Type, "secp256k1.PubKey") {
+		return "", fmt.Errorf("unsupported public key type %q (only secp256k1 keys can sign messages)", pk.Type)
+	}
+	return pk.Key, nil
+}
@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// Resolver turns key names and address book aliases into addresses.
+// Resolved key names are cached, and it is safe for concurrent use.
+type Resolver struct {
+	keys *Module
+	book map[string]string
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewResolver creates a resolver over the client's keyring and an address
+// book of alias to address. The book may be nil.
+func NewResolver(client sdk.Client, book map[string]string) *Resolver {
+	return &Resolver{
+		keys:  New(client),
+		book:  book,
+		cache: make(map[string]string),
+	}
+}
+
+// Resolve returns the address of a key name or address book alias.
+// Addresses are returned as they are; otherwise the keyring is checked
+// first, then the address book.
+func (r *Resolver) Resolve(ctx context.Context, nameOrAddress string) (string, error) {
+	if types.GetAddressType(nameOrAddress) != "unknown" {
+		return nameOrAddress, nil
+	}
+
+	r.mu.Lock()
+	address, ok := r.cache[nameOrAddress]
+	r.mu.Unlock()
+	if ok {
+		return address, nil
+	}
+
+	address, err := r.keys.GetAddress(ctx, nameOrAddress)
+	if err != nil {
+		bookAddress, ok := r.book[nameOrAddress]
+		if !ok {
+			return "", fmt.Errorf("failed to resolve '%s' as key name or address book alias: %w", nameOrAddress, err)
+		}
+		address = bookAddress
+	}
+
+	r.mu.Lock()
+	r.cache[nameOrAddress] = address
+	r.mu.Unlock()
+	return address, nil
+}
+
+// Forget drops cached key names, e.g. after keys were added or deleted.
+func (r *Resolver) Forget() {
+	r.mu.Lock()
+	r.cache = make(map[string]string)
+	r.mu.Unlock()
+}
@@ -0,0 +1,177 @@
+package layer2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Dapp is a dapp's state, merged from the all-dapps, execution-registrar
+// and transfer-dapps queries. Parts the dapp has not configured are empty
+// objects and listed in Unconfigured; parts whose query failed are listed
+// in Errors.
+type Dapp struct {
+	Name               string            `json:"name"`
+	Registration       json.RawMessage   `json:"registration"`
+	ExecutionRegistrar json.RawMessage   `json:"execution_registrar"`
+	Transfer           json.RawMessage   `json:"transfer"`
+	Unconfigured       []string          `json:"unconfigured,omitempty"`
+	Note               string            `json:"note,omitempty"`
+	Errors             map[string]string `json:"errors,omitempty"`
+}
+
+// Dapp queries the registration, execution registrar and transfer
+// configuration of a dapp concurrently. A dapp that is not registered
+// fails with an error wrapping sdk.ErrNotFound; a registered dapp without
+// a registrar or transfer configuration is returned with those parts
+// listed in Unconfigured. An error is also returned if every query fails.
+func (m *Module) Dapp(ctx context.Context, name string) (*Dapp, error) {
+	var (
+		wg                         sync.WaitGroup
+		dapps, registrar, transfer json.RawMessage
+		dappsErr, regErr, transErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		dapps, dappsErr = m.AllDapps(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		registrar, regErr = m.ExecutionRegistrar(ctx, name)
+	}()
+	go func() {
+		defer wg.Done()
+		transfer, transErr = m.TransferDapps(ctx)
+	}()
+	wg.Wait()
+
+	if dappsErr != nil && regErr != nil && transErr != nil {
+		return nil, fmt.Errorf("failed to query dapp %s: %w", name, dappsErr)
+	}
+
+	dapp := &Dapp{Name: name}
+	addError := func(part string, err error) {
+		if dapp.Errors == nil {
+			dapp.Errors = make(map[string]string)
+		}
+		dapp.Errors[part] = err.Error()
+	}
+
+	// The registrar response repeats the dapp, which stands in for the
+	// registration when all-dapps fails
+	var regResp struct {
+		Dapp               json.RawMessage `json:"dapp"`
+		ExecutionRegistrar json.RawMessage `json:"execution_registrar"`
+	}
+	switch {
+	case regErr != nil && !sdk.IsNotFound(regErr):
+		addError("execution_registrar", regErr)
+	case regErr == nil && json.Unmarshal(registrar, &regResp) == nil && regResp.ExecutionRegistrar != nil:
+		dapp.ExecutionRegistrar = regResp.ExecutionRegistrar
+	case regErr == nil:
+		dapp.ExecutionRegistrar = registrar
+	}
+
+	if dappsErr == nil {
+		dapp.Registration = findByName(dapps, name)
+	} else {
+		addError("registration", dappsErr)
+		dapp.Registration = regResp.Dapp
+	}
+	if dappsErr == nil && isEmptyJSON(dapp.Registration) {
+		return nil, fmt.Errorf("dapp %s is not registered: %w", name, sdk.ErrNotFound)
+	}
+
+	if transErr != nil {
+		addError("transfer", transErr)
+	} else {
+		dapp.Transfer = findByName(transfer, name)
+	}
+
+	parts := []struct {
+		name string
+		data *json.RawMessage
+	}{
+		{"registration", &dapp.Registration},
+		{"execution_registrar", &dapp.ExecutionRegistrar},
+		{"transfer", &dapp.Transfer},
+	}
+	for _, p := range parts {
+		if isEmptyJSON(*p.data) {
+			if _, failed := dapp.Errors[p.name]; !failed {
+				dapp.Unconfigured = append(dapp.Unconfigured, p.name)
+			}
+			*p.data = json.RawMessage("{}")
+		}
+	}
+	if len(dapp.Unconfigured) > 0 {
+		dapp.Note = "not configured: " + strings.Join(dapp.Unconfigured, ", ")
+	}
+	return dapp, nil
+}
+
+// findByName returns the element of a list response, such as
+// {"dapps": [...]}, whose name or dapp_name is name, or nil if there is
+// none.
+func findByName(data json.RawMessage, name string) json.RawMessage {
+	var lists map[string]json.RawMessage
+	if err := json.Unmarshal(data, &lists); err != nil {
+		return nil
+	}
+	for _, raw := range lists {
+		var items []json.RawMessage
+		if json.Unmarshal(raw, &items) != nil {
+			continue
+		}
+		for _, item := range items {
+			var named struct {
+				Name     string `json:"name"`
+				DappName string `json:"dapp_name"`
+			}
+			if json.Unmarshal(item, &named) == nil && (named.Name == name || named.DappName == name) {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+// isEmptyJSON reports whether data holds nothing: it is blank, null, an
+// empty string, array or object, or an object of only such values.
+func isEmptyJSON(data json.RawMessage) bool {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return true
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+	return isEmptyValue(v)
+}
+
+// isEmptyValue is isEmptyJSON for a decoded value.
+func isEmptyValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, field := range v {
+			if !isEmptyValue(field) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
@@ -4,9 +4,17 @@ package multistaking
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
 )
 
 // Module provides multistaking query functionality.
@@ -36,6 +44,31 @@ func (m *Module) Pools(ctx context.Context) (*PoolsResponse, error) {
 	return &result, nil
 }
 
+// PoolsPage queries a page of the staking pools. sekaid returns all pools
+// at once, so the page is cut from them in ID order, with a pool ID as the
+// page key. A nil pagination returns every pool.
+func (m *Module) PoolsPage(ctx context.Context, pagination *sdk.Pagination) (*PoolsResponse, error) {
+	pools, err := m.Pools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pagination == nil {
+		return pools, nil
+	}
+	page, nextKey, err := pagePools(pools.Pools, pagination)
+	if err != nil {
+		return nil, err
+	}
+	result := &PoolsResponse{Pools: page}
+	if nextKey != "" || pagination.CountTotal {
+		result.Pagination = &PaginationResponse{NextKey: nextKey}
+		if pagination.CountTotal {
+			result.Pagination.Total = strconv.Itoa(len(pools.Pools))
+		}
+	}
+	return result, nil
+}
+
 // Undelegations queries all undelegations for a delegator and validator.
 func (m *Module) Undelegations(ctx context.Context, delegator, valAddr string) (*UndelegationsResponse, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -110,6 +143,272 @@ func (m *Module) StakingPoolDelegators(ctx context.Context, validator string) ([
 	return result.Delegators, nil
 }
 
+// DelegatorDelegations lists the delegator's positions in all staking pools
+// together with its outstanding rewards. sekaid has no single query for
+// this, so the delegators of each pool are checked concurrently (see
+// delegatorPools). Pagination
+// applies to the pools in ID order, with a pool ID as the page key. A
+// delegator without delegations gets an empty list rather than an error.
+func (m *Module) DelegatorDelegations(ctx context.Context, delegator string, pagination *sdk.Pagination) (*DelegatorDelegationsResponse, error) {
+	pools, err := m.Pools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, nextKey, err := pagePools(pools.Pools, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DelegatorDelegationsResponse{
+		Delegator:   delegator,
+		Delegations: []Delegation{},
+		Rewards:     []Reward{},
+	}
+	if nextKey != "" || (pagination != nil && pagination.CountTotal) {
+		result.Pagination = &PaginationResponse{NextKey: nextKey}
+		if pagination != nil && pagination.CountTotal {
+			result.Pagination.Total = strconv.Itoa(len(pools.Pools))
+		}
+	}
+
+	held, err := m.delegatorPools(ctx, delegator, page)
+	if err != nil {
+		return nil, err
+	}
+	if len(held) == 0 {
+		return result, nil
+	}
+
+	balances, err := bank.New(m.client).Balances(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range held {
+		result.Delegations = append(result.Delegations, poolDelegation(pool, balances))
+	}
+
+	rewards, err := m.OutstandingRewards(ctx, delegator)
+	if err != nil && !sdk.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && rewards.Rewards != nil {
+		result.Rewards = rewards.Rewards
+	}
+	return result, nil
+}
+
+// maxPoolQueries bounds the number of pools queried at the same time.
+const maxPoolQueries = 8
+
+// delegatorPools returns the pools, out of pools, that delegator has
+// delegated to. The delegators of each pool are queried by a bounded pool
+// of workers, keeping the order of pools.
+func (m *Module) delegatorPools(ctx context.Context, delegator string, pools []StakingPool) ([]StakingPool, error) {
+	member := make([]bool, len(pools))
+	errs := make([]error, len(pools))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < maxPoolQueries && w < len(pools); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				pool := pools[i]
+				delegators, err := m.StakingPoolDelegators(ctx, pool.Validator)
+				if err != nil {
+					// Pools without delegators may be reported as not found
+					if !sdk.IsNotFound(err) {
+						errs[i] = fmt.Errorf("pool %s: %w", pool.ID, err)
+					}
+					continue
+				}
+				for _, d := range delegators {
+					if d.Delegator == delegator {
+						member[i] = true
+						break
+					}
+				}
+			}
+		}()
+	}
+	for i := range pools {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("failed to query delegations of %s: %w", delegator, err)
+	}
+
+	var held []StakingPool
+	for i, pool := range pools {
+		if member[i] {
+			held = append(held, pool)
+		}
+	}
+	return held, nil
+}
+
+// TotalRewards sums the outstanding rewards of delegator per denom and
+// counts the pools it has delegated to. sekaid accrues the rewards of all
+// pools to the delegator, so the pools are enumerated to report which of
+// them contribute, and the outstanding rewards are queried once.
+func (m *Module) TotalRewards(ctx context.Context, delegator string) (*TotalRewards, error) {
+	pools, err := m.Pools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	held, err := m.delegatorPools(ctx, delegator, pools.Pools)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TotalRewards{
+		Delegator: delegator,
+		Total:     []Reward{},
+		Pools:     len(held),
+		PoolIDs:   []string{},
+	}
+	for _, pool := range held {
+		result.PoolIDs = append(result.PoolIDs, pool.ID)
+	}
+	if len(held) == 0 {
+		return result, nil
+	}
+
+	rewards, err := m.OutstandingRewards(ctx, delegator)
+	if err != nil {
+		if sdk.IsNotFound(err) {
+			return result, nil
+		}
+		return nil, err
+	}
+	result.Total, err = sumRewards(rewards.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sumRewards adds up reward entries per denom, sorted by denom. Decimal
+// amounts are kept exact.
+func sumRewards(rewards []Reward) ([]Reward, error) {
+	sums := make(map[string]*big.Rat)
+	for _, r := range rewards {
+		amount, ok := new(big.Rat).SetString(r.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid reward amount %q for %s", r.Amount, r.Denom)
+		}
+		if sum, ok := sums[r.Denom]; ok {
+			sum.Add(sum, amount)
+		} else {
+			sums[r.Denom] = amount
+		}
+	}
+	denoms := make([]string, 0, len(sums))
+	for denom := range sums {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	total := make([]Reward, 0, len(denoms))
+	for _, denom := range denoms {
+		sum := sums[denom]
+		amount := sum.RatString()
+		if !sum.IsInt() {
+			amount = strings.TrimRight(sum.FloatString(18), "0")
+		}
+		total = append(total, Reward{Denom: denom, Amount: amount})
+	}
+	return total, nil
+}
+
+// pagePools returns the page of pools selected by pagination, in ID order,
+// and the ID of the first pool after the page.
+func pagePools(pools []StakingPool, pagination *sdk.Pagination) ([]StakingPool, string, error) {
+	sorted := append([]StakingPool(nil), pools...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, errA := strconv.ParseUint(sorted[i].ID, 10, 64)
+		b, errB := strconv.ParseUint(sorted[j].ID, 10, 64)
+		if errA != nil || errB != nil {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return a < b
+	})
+	if pagination == nil {
+		return sorted, "", nil
+	}
+	if pagination.Reverse {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+
+	start := pagination.Offset
+	if pagination.Page > 0 {
+		start = (pagination.Page - 1) * pagination.Limit
+	}
+	if pagination.Key != "" {
+		found := false
+		for i, pool := range sorted {
+			if pool.ID == pagination.Key {
+				start += uint64(i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, "", fmt.Errorf("invalid page key %q: no pool with that ID", pagination.Key)
+		}
+	}
+	if start >= uint64(len(sorted)) {
+		return []StakingPool{}, "", nil
+	}
+	end := uint64(len(sorted))
+	if pagination.Limit > 0 && start+pagination.Limit < end {
+		end = start + pagination.Limit
+	}
+	nextKey := ""
+	if end < uint64(len(sorted)) {
+		nextKey = sorted[end].ID
+	}
+	return sorted[start:end], nextKey, nil
+}
+
+// poolDelegation builds the delegation of the holder of balances in pool.
+// Share tokens are named v<pool>/<denom> and are worth
+// shares * total staked / total shares of the denom.
+func poolDelegation(pool StakingPool, balances types.Coins) Delegation {
+	d := Delegation{Pool: pool.ID, Validator: pool.Validator}
+	prefix := "v" + pool.ID + "/"
+	for _, c := range balances {
+		if !strings.HasPrefix(c.Denom, prefix) {
+			continue
+		}
+		d.Shares = append(d.Shares, Coin{Denom: c.Denom, Amount: c.Amount})
+
+		shares, ok1 := new(big.Int).SetString(c.Amount, 10)
+		staked, ok2 := new(big.Int).SetString(coinAmount(pool.TotalStakingTokens, strings.TrimPrefix(c.Denom, prefix)), 10)
+		total, ok3 := new(big.Int).SetString(coinAmount(pool.TotalShareTokens, c.Denom), 10)
+		if !ok1 || !ok2 || !ok3 || total.Sign() == 0 {
+			continue
+		}
+		amount := new(big.Int).Div(new(big.Int).Mul(shares, staked), total)
+		d.Amount = append(d.Amount, Coin{Denom: strings.TrimPrefix(c.Denom, prefix), Amount: amount.String()})
+	}
+	return d
+}
+
+// coinAmount returns the amount of denom in coins, or "" if absent.
+func coinAmount(coins []Coin, denom string) string {
+	for _, c := range coins {
+		if c.Denom == denom {
+			return c.Amount
+		}
+	}
+	return ""
+}
+
 // TxOptions contains common transaction options.
 type TxOptions struct {
 	Fees          string
@@ -117,6 +416,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 // Delegate delegates tokens to a validator pool.
@@ -138,6 +438,9 @@ func (m *Module) Delegate(ctx context.Context, from, validator, coins string, op
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -173,6 +476,9 @@ func (m *Module) Undelegate(ctx context.Context, from, validator, coins string,
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -208,6 +514,9 @@ func (m *Module) ClaimRewards(ctx context.Context, from, validator string, opts
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -224,6 +533,46 @@ func (m *Module) ClaimRewards(ctx context.Context, from, validator string, opts
 	return resp, nil
 }
 
+// ClaimAll claims the rewards of every pool delegator has delegated to,
+// signing with from. sekaid takes one pool per claim, so the claims are
+// broadcast one after another; the client's sequence retry keeps back to
+// back transactions from failing. A failed claim does not stop the others;
+// the result lists the outcome of each pool. progress, if not nil, is
+// called after each claim.
+func (m *Module) ClaimAll(ctx context.Context, from, delegator string, opts *TxOptions, progress func(PoolClaim)) (*ClaimAllResult, error) {
+	pools, err := m.Pools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	held, err := m.delegatorPools(ctx, delegator, pools.Pools)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ClaimAllResult{Delegator: delegator, Claims: []PoolClaim{}}
+	for _, pool := range held {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		claim := PoolClaim{Pool: pool.ID, Validator: pool.Validator}
+		resp, err := m.ClaimRewards(ctx, from, pool.Validator, opts)
+		if resp != nil {
+			claim.TxHash = resp.TxHash
+		}
+		if err != nil {
+			claim.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Claims = append(result.Claims, claim)
+		if progress != nil {
+			progress(claim)
+		}
+	}
+	return result, nil
+}
+
 // ClaimUndelegation claims a matured undelegation.
 func (m *Module) ClaimUndelegation(ctx context.Context, from string, undelegationID string, opts *TxOptions) (*sdk.TxResponse, error) {
 	flags := make(map[string]string)
@@ -243,6 +592,9 @@ func (m *Module) ClaimUndelegation(ctx context.Context, from string, undelegatio
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -278,6 +630,9 @@ func (m *Module) ClaimMaturedUndelegations(ctx context.Context, from string, opt
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -312,6 +667,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -1,5 +1,7 @@
 package multistaking
 
+import "encoding/json"
+
 // StakingPool represents a staking pool.
 type StakingPool struct {
 	ID                 string `json:"id"`
@@ -20,7 +22,8 @@ type Coin struct {
 
 // PoolsResponse contains the pools query response.
 type PoolsResponse struct {
-	Pools []StakingPool `json:"pools"`
+	Pools      []StakingPool       `json:"pools"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
 }
 
 // Undelegation represents an undelegation request.
@@ -48,6 +51,32 @@ type Reward struct {
 	Amount string `json:"amount"`
 }
 
+// TotalRewards is a delegator's outstanding rewards summed per denom.
+type TotalRewards struct {
+	Delegator string   `json:"delegator"`
+	Total     []Reward `json:"total"`
+	// Pools is the number of pools the delegator has delegated to.
+	Pools   int      `json:"pools"`
+	PoolIDs []string `json:"pool_ids"`
+}
+
+// PoolClaim is the outcome of claiming the rewards of one pool.
+type PoolClaim struct {
+	Pool      string `json:"pool"`
+	Validator string `json:"validator"`
+	TxHash    string `json:"txhash,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ClaimAllResult summarizes claiming the rewards of all of a delegator's
+// pools.
+type ClaimAllResult struct {
+	Delegator string      `json:"delegator"`
+	Claims    []PoolClaim `json:"claims"`
+	Succeeded int         `json:"succeeded"`
+	Failed    int         `json:"failed"`
+}
+
 // CompoundInfo represents compound information.
 type CompoundInfo struct {
 	AllowCompound bool `json:"all_compound"`
@@ -59,3 +88,39 @@ type StakingPoolDelegator struct {
 	Pool      string `json:"pool,omitempty"`
 	Amount    string `json:"amount"`
 }
+
+// UnmarshalJSON accepts a delegator object or, as sekaid returns it, a bare
+// delegator address.
+func (d *StakingPoolDelegator) UnmarshalJSON(data []byte) error {
+	var addr string
+	if err := json.Unmarshal(data, &addr); err == nil {
+		*d = StakingPoolDelegator{Delegator: addr}
+		return nil
+	}
+	type plain StakingPoolDelegator
+	return json.Unmarshal(data, (*plain)(d))
+}
+
+// Delegation is a delegator's position in one staking pool.
+type Delegation struct {
+	Pool      string `json:"pool"`
+	Validator string `json:"validator"`
+	// Shares are the pool's share tokens (v<pool>/<denom>) held by the delegator.
+	Shares []Coin `json:"shares,omitempty"`
+	// Amount is the staked value of Shares at the pool's current rate.
+	Amount []Coin `json:"amount,omitempty"`
+}
+
+// DelegatorDelegationsResponse lists a delegator's positions across pools.
+type DelegatorDelegationsResponse struct {
+	Delegator   string              `json:"delegator"`
+	Delegations []Delegation        `json:"delegations"`
+	Rewards     []Reward            `json:"rewards"`
+	Pagination  *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// PaginationResponse represents pagination info in response.
+type PaginationResponse struct {
+	NextKey string `json:"next_key,omitempty"`
+	Total   string `json:"total,omitempty"`
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
@@ -31,3 +33,75 @@ func (m *Module) Subspace(ctx context.Context, subspace, key string) (json.RawMe
 	}
 	return resp.Data, nil
 }
+
+// subspaceKeys are the parameter keys of the subspaces sekaid registers
+// with the params module. KIRA's staking, slashing and distribution are
+// custom modules with their own queries, not params subspaces.
+var subspaceKeys = map[string][]string{
+	"auth": {"MaxMemoCharacters", "TxSigLimit", "TxSizeCostPerByte", "SigVerifyCostED25519", "SigVerifyCostSecp256k1"},
+	"bank": {"SendEnabled", "DefaultSendEnabled"},
+}
+
+// Subspaces returns the names of the known parameter subspaces, sorted.
+func Subspaces() []string {
+	names := make([]string, 0, len(subspaceKeys))
+	for name := range subspaceKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SubspaceKeys returns the parameter keys of a known subspace.
+func SubspaceKeys(subspace string) ([]string, bool) {
+	keys, ok := subspaceKeys[subspace]
+	return keys, ok
+}
+
+// SubspaceParams are the parameters of a subspace.
+type SubspaceParams struct {
+	Subspace string `json:"subspace"`
+
+	// Params maps each key to its value, decoded from the JSON string
+	// sekaid returns where possible
+	Params map[string]json.RawMessage `json:"params"`
+}
+
+// Params queries all parameters of a known subspace, or only the given
+// keys. Keys are required for subspaces that are not known.
+func (m *Module) Params(ctx context.Context, subspace string, keys ...string) (*SubspaceParams, error) {
+	if len(keys) == 0 {
+		known, ok := subspaceKeys[subspace]
+		if !ok {
+			return nil, fmt.Errorf("unknown params subspace %q (known: %s); pass a key to query it anyway", subspace, strings.Join(Subspaces(), ", "))
+		}
+		keys = known
+	}
+
+	result := &SubspaceParams{Subspace: subspace, Params: make(map[string]json.RawMessage, len(keys))}
+	for _, key := range keys {
+		data, err := m.Subspace(ctx, subspace, key)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", subspace, key, err)
+		}
+		result.Params[key] = paramValue(data)
+	}
+	return result, nil
+}
+
+// paramValue extracts the value of a subspace query response, which sekaid
+// returns as {"subspace", "key", "value"} with the value JSON-encoded in a
+// string.
+func paramValue(data json.RawMessage) json.RawMessage {
+	var resp struct {
+		Value *string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil || resp.Value == nil {
+		return data
+	}
+	if json.Valid([]byte(*resp.Value)) {
+		return json.RawMessage(*resp.Value)
+	}
+	quoted, _ := json.Marshal(*resp.Value)
+	return quoted
+}
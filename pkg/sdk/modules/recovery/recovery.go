@@ -9,7 +9,7 @@ import (
 	"github.com/kiracore/sekai-cli/pkg/sdk"
 )
 
-// Module provides recovery query functionality.
+// Module provides recovery query and transaction functionality.
 type Module struct {
 	client sdk.Client
 }
@@ -70,3 +70,104 @@ func (m *Module) RRHolders(ctx context.Context, rrToken string) (json.RawMessage
 	}
 	return resp.Data, nil
 }
+
+// TxOptions contains common transaction options.
+type TxOptions struct {
+	Fees          string
+	Gas           string
+	GasAdjustment float64
+	Memo          string
+	BroadcastMode string
+	SignMode      string
+}
+
+func buildTxFlags(opts *TxOptions) map[string]string {
+	flags := make(map[string]string)
+	if opts != nil {
+		if opts.Fees != "" {
+			flags["fees"] = opts.Fees
+		}
+		if opts.Gas != "" {
+			flags["gas"] = opts.Gas
+		}
+		if opts.GasAdjustment > 0 {
+			flags["gas-adjustment"] = fmt.Sprintf("%.2f", opts.GasAdjustment)
+		}
+		if opts.Memo != "" {
+			flags["note"] = opts.Memo
+		}
+		if opts.BroadcastMode != "" {
+			flags["broadcast-mode"] = opts.BroadcastMode
+		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
+	}
+	return flags
+}
+
+// tx runs the recovery transaction action signed by from; what describes
+// it in errors.
+func (m *Module) tx(ctx context.Context, action, what, from string, args []string, opts *TxOptions) (*sdk.TxResponse, error) {
+	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
+		Module:           "recovery",
+		Action:           action,
+		Args:             args,
+		Signer:           from,
+		Flags:            buildTxFlags(opts),
+		SkipConfirmation: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", what, err)
+	}
+	return resp, nil
+}
+
+// RegisterRecoverySecret registers the recovery secret of the from
+// account: the challenge is the hash of the secret, and nonce and proof
+// show that the secret is known without revealing it.
+// Args: [challenge] [nonce] [proof]
+func (m *Module) RegisterRecoverySecret(ctx context.Context, from, challenge, nonce, proof string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "register-recovery-secret", "register recovery secret", from, []string{challenge, nonce, proof}, opts)
+}
+
+// RotateRecoveryAddress moves the account address, whose recovery secret
+// proof proves, with its balances and roles to recovery. The fee is paid
+// by from, which may be any account.
+// Args: [address] [recovery] [proof]
+func (m *Module) RotateRecoveryAddress(ctx context.Context, from, address, recovery, proof string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "rotate-recovery-address", "rotate recovery address", from, []string{address, recovery, proof}, opts)
+}
+
+// IssueRecoveryTokens issues the recovery (RR) tokens of the validator
+// from.
+func (m *Module) IssueRecoveryTokens(ctx context.Context, from string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "issue-recovery-tokens", "issue recovery tokens", from, nil, opts)
+}
+
+// BurnRecoveryTokens burns rrCoin, an amount of recovery tokens held by
+// from, for the matching share of the validator's stake.
+// Args: [rr-coin]
+func (m *Module) BurnRecoveryTokens(ctx context.Context, from, rrCoin string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "burn-recovery-tokens", "burn recovery tokens", from, []string{rrCoin}, opts)
+}
+
+// RegisterRRTokenHolder registers from as a holder of recovery tokens, to
+// receive a share of the validator's rewards.
+func (m *Module) RegisterRRTokenHolder(ctx context.Context, from string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "register-rrtoken-holder", "register RR token holder", from, nil, opts)
+}
+
+// ClaimRRHolderRewards claims the rewards of from as a recovery token
+// holder.
+func (m *Module) ClaimRRHolderRewards(ctx context.Context, from string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "claim-rrholder-rewards", "claim RR holder rewards", from, nil, opts)
+}
+
+// RotateValidatorByHalfRRTokenHolder rotates the validator address to
+// recovery, signed by from holding more than half of the validator's
+// recovery tokens.
+// Args: [address] [recovery]
+func (m *Module) RotateValidatorByHalfRRTokenHolder(ctx context.Context, from, address, recovery string, opts *TxOptions) (*sdk.TxResponse, error) {
+	return m.tx(ctx, "rotate-validator-by-half-rr-token-holder", "rotate validator", from, []string{address, recovery}, opts)
+}
@@ -0,0 +1,154 @@
+package slashing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/staking"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// Jail states reported by JailStatus.
+const (
+	JailStateNotJailed  = "not_jailed"
+	JailStateWaiting    = "waiting"
+	JailStateUnjailable = "can_unjail"
+	JailStateExpired    = "unjail_window_passed"
+	JailStateTombstoned = "tombstoned"
+)
+
+// JailStatus tells whether a validator is jailed and when it may unjail.
+// Times are RFC 3339 in UTC; durations are rounded to seconds.
+type JailStatus struct {
+	ConsAddress  string `json:"cons_address"`
+	ValAddress   string `json:"val_address,omitempty"`
+	Moniker      string `json:"moniker,omitempty"`
+	Jailed       bool   `json:"jailed"`
+	Tombstoned   bool   `json:"tombstoned"`
+	State        string `json:"state"`
+	Message      string `json:"message"`
+	MissedBlocks string `json:"missed_blocks"`
+	JailedUntil  string `json:"jailed_until,omitempty"`
+
+	// UnjailIn is the time left until unjailing is permitted
+	UnjailIn string `json:"unjail_in,omitempty"`
+
+	// UnjailDeadline is when the unjail window closes, after which the
+	// validator can only be unjailed by governance. It is set when the
+	// network's unjail_max_time is known.
+	UnjailDeadline string `json:"unjail_deadline,omitempty"`
+	DeadlineIn     string `json:"deadline_in,omitempty"`
+}
+
+// JailStatus reports the jail status of a validator by consensus
+// (kiravalcons) or operator (kiravaloper) address. unjailMaxTime is the
+// network's unjail_max_time; zero leaves the unjail deadline out.
+func (m *Module) JailStatus(ctx context.Context, address string, unjailMaxTime time.Duration) (*JailStatus, error) {
+	val, consAddr, err := m.resolveValidator(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	info, err := m.SigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+	si := info.ValSigningInfo
+	now := time.Now()
+
+	status := &JailStatus{
+		ConsAddress:  consAddr,
+		Tombstoned:   si.Tombstoned,
+		MissedBlocks: si.MissedBlocksCounter,
+	}
+	if val != nil {
+		status.ValAddress = val.GetValKey()
+		status.Moniker = val.Moniker
+	}
+
+	jailedUntil, err := time.Parse(time.RFC3339Nano, si.JailedUntil)
+	if err != nil || jailedUntil.Unix() <= 0 {
+		jailedUntil = time.Time{}
+	} else {
+		status.JailedUntil = jailedUntil.UTC().Format(time.RFC3339)
+	}
+	// The validator's status is authoritative; without it a jail period
+	// that has not ended means jailed
+	if val != nil {
+		status.Jailed = strings.EqualFold(val.Status, "JAILED")
+	} else {
+		status.Jailed = jailedUntil.After(now)
+	}
+	status.Jailed = status.Jailed || si.Tombstoned
+
+	switch {
+	case si.Tombstoned:
+		status.State = JailStateTombstoned
+		status.Message = "tombstoned: the validator can never be unjailed"
+		return status, nil
+	case !status.Jailed:
+		status.State = JailStateNotJailed
+		status.Message = "not jailed"
+		return status, nil
+	}
+
+	var deadline time.Time
+	if unjailMaxTime > 0 && !jailedUntil.IsZero() {
+		deadline = jailedUntil.Add(unjailMaxTime)
+		status.UnjailDeadline = deadline.UTC().Format(time.RFC3339)
+	}
+	switch {
+	case jailedUntil.After(now):
+		status.State = JailStateWaiting
+		status.UnjailIn = roundDuration(jailedUntil.Sub(now))
+		status.Message = fmt.Sprintf("jailed: unjail is permitted in %s", status.UnjailIn)
+	case !deadline.IsZero() && !deadline.After(now):
+		status.State = JailStateExpired
+		status.Message = "jailed: the unjail window has passed, unjailing requires a governance proposal"
+	default:
+		status.State = JailStateUnjailable
+		status.Message = "jailed: unjail is permitted now"
+	}
+	if !deadline.IsZero() && deadline.After(now) {
+		status.DeadlineIn = roundDuration(deadline.Sub(now))
+	}
+	return status, nil
+}
+
+// resolveValidator finds the validator of an operator or consensus address
+// and its consensus address. A consensus address of a validator that is
+// not listed is returned without the validator.
+func (m *Module) resolveValidator(ctx context.Context, address string) (*staking.Validator, string, error) {
+	stakingMod := staking.New(m.client)
+	switch types.GetAddressType(address) {
+	case "validator":
+		val, err := stakingMod.Validator(ctx, &staking.ValidatorQueryOpts{ValAddr: address})
+		if err != nil {
+			return nil, "", err
+		}
+		consAddr, err := val.ConsAddress()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to resolve the consensus address of %s: %w", address, err)
+		}
+		return val, consAddr, nil
+	case "consensus":
+		vals, err := stakingMod.Validators(ctx, nil)
+		if err != nil {
+			return nil, address, nil
+		}
+		for i := range vals.Validators {
+			if consAddr, err := vals.Validators[i].ConsAddress(); err == nil && consAddr == address {
+				return &vals.Validators[i], address, nil
+			}
+		}
+		return nil, address, nil
+	default:
+		return nil, "", fmt.Errorf("%q is not a consensus (kiravalcons) or operator (kiravaloper) address", address)
+	}
+}
+
+// roundDuration formats d rounded to seconds.
+func roundDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
@@ -40,7 +40,14 @@ type SigningInfoResponse struct {
 
 // SigningInfosResponse contains all signing infos.
 type SigningInfosResponse struct {
-	Info []SigningInfo `json:"info"`
+	Info       []SigningInfo       `json:"info"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+// PaginationResponse represents pagination info in response.
+type PaginationResponse struct {
+	NextKey string `json:"next_key,omitempty"`
+	Total   string `json:"total,omitempty"`
 }
 
 // SigningInfo queries signing info for a validator.
@@ -69,9 +76,16 @@ func (m *Module) SigningInfo(ctx context.Context, consAddress string) (*SigningI
 
 // SigningInfos queries signing infos for all validators.
 func (m *Module) SigningInfos(ctx context.Context) (*SigningInfosResponse, error) {
+	return m.SigningInfosPage(ctx, nil)
+}
+
+// SigningInfosPage queries a page of the validator signing infos. A nil
+// pagination returns the node's default page.
+func (m *Module) SigningInfosPage(ctx context.Context, pagination *sdk.Pagination) (*SigningInfosResponse, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
 		Module:   "customslashing",
 		Endpoint: "signing-infos",
+		Params:   pagination.Params(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query signing infos: %w", err)
@@ -93,6 +93,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 // ClaimSpendingPool claims from a spending pool.
@@ -115,6 +116,9 @@ func (m *Module) ClaimSpendingPool(ctx context.Context, from, poolName string, o
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -152,6 +156,9 @@ func (m *Module) DepositSpendingPool(ctx context.Context, from, poolName, amount
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -186,6 +193,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -283,23 +293,23 @@ func (m *Module) RegisterSpendingPoolBeneficiary(ctx context.Context, from, pool
 
 // ProposalUpdateSpendingPoolOpts contains options for updating a spending pool proposal.
 type ProposalUpdateSpendingPoolOpts struct {
-	Name                      string
-	Title                     string
-	Description               string
-	ClaimStart                int32
-	ClaimEnd                  int32
-	Rates                     string
-	VoteQuorum                int32
-	VotePeriod                int32
-	VoteEnactment             int32
-	OwnerAccounts             string
-	OwnerRoles                string
-	BeneficiaryAccounts       string
-	BeneficiaryRoles          string
-	BeneficiaryAccountWeights string
-	BeneficiaryRoleWeights    string
-	DynamicRate               bool
-	DynamicRatePeriod         string
+	Name                      string `json:"name,omitempty"`
+	Title                     string `json:"title,omitempty"`
+	Description               string `json:"description,omitempty"`
+	ClaimStart                int32  `json:"claim_start,omitempty"`
+	ClaimEnd                  int32  `json:"claim_end,omitempty"`
+	Rates                     string `json:"rates,omitempty"`
+	VoteQuorum                int32  `json:"vote_quorum,omitempty"`
+	VotePeriod                int32  `json:"vote_period,omitempty"`
+	VoteEnactment             int32  `json:"vote_enactment,omitempty"`
+	OwnerAccounts             string `json:"owner_accounts,omitempty"`
+	OwnerRoles                string `json:"owner_roles,omitempty"`
+	BeneficiaryAccounts       string `json:"beneficiary_accounts,omitempty"`
+	BeneficiaryRoles          string `json:"beneficiary_roles,omitempty"`
+	BeneficiaryAccountWeights string `json:"beneficiary_account_weights,omitempty"`
+	BeneficiaryRoleWeights    string `json:"beneficiary_role_weights,omitempty"`
+	DynamicRate               bool   `json:"dynamic_rate,omitempty"`
+	DynamicRatePeriod         string `json:"dynamic_rate_period,omitempty"`
 }
 
 // ProposalUpdateSpendingPool creates a proposal to update a spending pool.
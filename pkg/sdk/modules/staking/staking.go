@@ -41,6 +41,9 @@ func (m *Module) Validators(ctx context.Context, opts *ValidatorQueryOpts) (*Val
 		if opts.Proposer != "" {
 			params["proposer"] = opts.Proposer
 		}
+		for k, v := range opts.Pagination.Params() {
+			params[k] = v
+		}
 	}
 
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -59,6 +62,31 @@ func (m *Module) Validators(ctx context.Context, opts *ValidatorQueryOpts) (*Val
 	return &result, nil
 }
 
+// Monikers maps the account, operator (kiravaloper) and consensus
+// (kiravalcons) addresses of all validators to their monikers.
+func (m *Module) Monikers(ctx context.Context) (map[string]string, error) {
+	vals, err := m.Validators(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	monikers := make(map[string]string, 3*len(vals.Validators))
+	for i := range vals.Validators {
+		val := &vals.Validators[i]
+		if val.Moniker == "" {
+			continue
+		}
+		for _, addr := range []string{val.Address, val.GetValKey()} {
+			if addr != "" {
+				monikers[addr] = val.Moniker
+			}
+		}
+		if consAddr, err := val.ConsAddress(); err == nil {
+			monikers[consAddr] = val.Moniker
+		}
+	}
+	return monikers, nil
+}
+
 // Validator queries a single validator by address, val-address, or moniker.
 func (m *Module) Validator(ctx context.Context, opts *ValidatorQueryOpts) (*Validator, error) {
 	params := make(map[string]string)
@@ -109,6 +137,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 // ClaimValidatorSeatOpts contains options for claiming a validator seat.
@@ -144,6 +173,9 @@ func (m *Module) ClaimValidatorSeat(ctx context.Context, from string, seatOpts *
 		if txOpts.BroadcastMode != "" {
 			flags["broadcast-mode"] = txOpts.BroadcastMode
 		}
+		if txOpts.SignMode != "" {
+			flags["sign-mode"] = txOpts.SignMode
+		}
 	}
 
 	resp, err := m.client.Tx(ctx, &sdk.TxRequest{
@@ -178,6 +210,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -1,5 +1,15 @@
 package staking
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
 // Validator represents a validator.
 type Validator struct {
 	Address   string           `json:"address,omitempty"`
@@ -24,6 +34,60 @@ func (v *Validator) GetValKey() string {
 	return v.ValKeyAlt
 }
 
+// ConsAddress returns the consensus (kiravalcons) address of the validator
+// from its proposer address or consensus public key.
+func (v *Validator) ConsAddress() (string, error) {
+	if v.Proposer != "" {
+		addr, err := types.ConsAddressFromHex(v.Proposer)
+		if err == nil {
+			return string(addr), nil
+		}
+	}
+	pubKey := v.PubKey
+	if pubKey == nil {
+		pubKey = v.PubKeyAlt
+	}
+	key, err := consPubKeyBytes(pubKey)
+	if err != nil {
+		return "", err
+	}
+	addr, err := types.ConsAddressFromPubKey(key)
+	return string(addr), err
+}
+
+// consPubKeyBytes extracts an ed25519 key from a validator's public key,
+// which sekaid reports as {"@type": ..., "key": <base64>}, possibly as a
+// JSON string, or as a kiravalconspub bech32 string.
+func consPubKeyBytes(pubKey any) ([]byte, error) {
+	if s, ok := pubKey.(string); ok {
+		if strings.HasPrefix(s, types.Bech32PrefixConsPub+"1") {
+			_, data, err := types.Bech32Decode(s)
+			if err != nil {
+				return nil, err
+			}
+			// Drop the amino prefix of the key type and length
+			if len(data) == 37 {
+				data = data[5:]
+			}
+			return data, nil
+		}
+		var obj any
+		if err := json.Unmarshal([]byte(s), &obj); err != nil {
+			return nil, fmt.Errorf("unrecognized public key %q", s)
+		}
+		pubKey = obj
+	}
+	obj, ok := pubKey.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("validator has no public key")
+	}
+	key, _ := obj["key"].(string)
+	if key == "" {
+		key, _ = obj["value"].(string)
+	}
+	return base64.StdEncoding.DecodeString(key)
+}
+
 // IdentityRecord represents an identity record embedded in validator.
 type IdentityRecord struct {
 	ID        string   `json:"id"`
@@ -49,10 +113,11 @@ type PaginationResponse struct {
 
 // ValidatorQueryOpts contains options for querying validators.
 type ValidatorQueryOpts struct {
-	Address  string
-	ValAddr  string
-	Moniker  string
-	Status   string
-	PubKey   string
-	Proposer string
+	Address    string
+	ValAddr    string
+	Moniker    string
+	Status     string
+	PubKey     string
+	Proposer   string
+	Pagination *sdk.Pagination
 }
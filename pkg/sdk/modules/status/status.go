@@ -78,6 +78,54 @@ func (m *Module) IsSyncing(ctx context.Context) (bool, error) {
 	return status.SyncInfo.CatchingUp, nil
 }
 
+// Summary is a digest of the node status for operators.
+type Summary struct {
+	// Sync is "synced" or "catching up"
+	Sync              string `json:"sync"`
+	CatchingUp        bool   `json:"catching_up"`
+	ChainID           string `json:"chain_id"`
+	Moniker           string `json:"moniker"`
+	Version           string `json:"version"`
+	LatestBlockHeight int64  `json:"latest_block_height"`
+	LatestBlockTime   string `json:"latest_block_time"`
+	ValidatorAddress  string `json:"validator_address,omitempty"`
+	VotingPower       int64  `json:"voting_power"`
+	// PeerCount is nil when the client cannot report peers
+	PeerCount *int `json:"peer_count,omitempty"`
+}
+
+// Summary returns a flattened digest of the node status, with the peer
+// count when the client implements sdk.NetInfoClient.
+func (m *Module) Summary(ctx context.Context) (*Summary, error) {
+	st, err := m.client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		Sync:              "synced",
+		CatchingUp:        st.SyncInfo.CatchingUp,
+		ChainID:           st.NodeInfo.Network,
+		Moniker:           st.NodeInfo.Moniker,
+		Version:           st.NodeInfo.Version,
+		LatestBlockHeight: st.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:   st.SyncInfo.LatestBlockTime,
+		ValidatorAddress:  st.ValidatorInfo.Address,
+		VotingPower:       st.ValidatorInfo.VotingPower,
+	}
+	if st.SyncInfo.CatchingUp {
+		summary.Sync = "catching up"
+	}
+
+	// Peers are best effort: not every backend exposes net_info.
+	if nc, ok := m.client.(sdk.NetInfoClient); ok {
+		if info, err := nc.NetInfo(ctx); err == nil {
+			summary.PeerCount = &info.PeerCount
+		}
+	}
+	return summary, nil
+}
+
 // NetworkProperties queries the network properties.
 func (m *Module) NetworkProperties(ctx context.Context) (*NetworkProperties, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
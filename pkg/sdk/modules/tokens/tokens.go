@@ -5,8 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
 )
 
 // Module provides tokens query functionality.
@@ -36,6 +43,89 @@ func (m *Module) AllRates(ctx context.Context) (*AllRatesResponse, error) {
 	return &result, nil
 }
 
+// FilteredRates queries all token rates and keeps those passing opts. The
+// node has no filters, so they are applied to the returned rates; nil
+// returns them all.
+func (m *Module) FilteredRates(ctx context.Context, opts *RatesQueryOpts) (*AllRatesResponse, error) {
+	result, err := m.AllRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil {
+		result.Data = slices.DeleteFunc(result.Data, func(r TokenRateWithSupply) bool {
+			return !opts.matches(&r.Data)
+		})
+	}
+	return result, nil
+}
+
+// matches reports whether a rate passes all of the filters.
+func (o *RatesQueryOpts) matches(r *TokenRate) bool {
+	if o.StakingOnly && !r.StakeEnabled {
+		return false
+	}
+	if o.FeePaymentsOnly && !r.FeeEnabled {
+		return false
+	}
+	return strings.HasPrefix(r.Denom, o.DenomPrefix)
+}
+
+// RateSortKeys lists the fields token rates can be sorted by.
+var RateSortKeys = []string{"fee-rate", "denom"}
+
+// SortRates sorts token rates by one of RateSortKeys, keeping the order of
+// equal rates. Rates with an unparsable fee rate come last in either order.
+func SortRates(rates []TokenRateWithSupply, by string, descending bool) error {
+	var less func(a, b *TokenRate) bool
+	switch by {
+	case "fee-rate":
+		less = func(a, b *TokenRate) bool {
+			x, _ := strconv.ParseFloat(a.FeeRate, 64)
+			y, _ := strconv.ParseFloat(b.FeeRate, 64)
+			return x < y
+		}
+	case "denom":
+		less = func(a, b *TokenRate) bool { return a.Denom < b.Denom }
+	default:
+		return fmt.Errorf("invalid sort key %q (valid: %s)", by, strings.Join(RateSortKeys, ", "))
+	}
+
+	valid := func(r *TokenRate) bool {
+		_, err := strconv.ParseFloat(r.FeeRate, 64)
+		return by != "fee-rate" || err == nil
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		a, b := &rates[i].Data, &rates[j].Data
+		if valid(a) != valid(b) {
+			return valid(a)
+		}
+		if descending {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+	return nil
+}
+
+// DenomRegistry builds a denom registry from all token rates, mapping each
+// base denom to its display symbol and decimals.
+func (m *Module) DenomRegistry(ctx context.Context) (types.DenomRegistry, error) {
+	rates, err := m.AllRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := make(types.DenomRegistry, len(rates.Data))
+	for _, r := range rates.Data {
+		registry[r.Data.Denom] = types.DenomInfo{
+			Denom:    r.Data.Denom,
+			Symbol:   r.Data.Symbol,
+			Decimals: r.Data.Decimals,
+		}
+	}
+	return registry, nil
+}
+
 // Rate queries a token rate by denom.
 func (m *Module) Rate(ctx context.Context, denom string) (*TokenRate, error) {
 	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
@@ -110,6 +200,97 @@ func (m *Module) TokenBlackWhites(ctx context.Context) (*TokenBlackWhites, error
 	return &result.Data, nil
 }
 
+// TokenInfo queries the rate, supply, black/white list status and bank
+// metadata of a token concurrently and merges them. Parts that fail are
+// listed in TokenInfo.Missing; an error is returned only if all fail.
+func (m *Module) TokenInfo(ctx context.Context, denom string) (*TokenInfo, error) {
+	var (
+		wg          sync.WaitGroup
+		rate        *TokenRate
+		rates       map[string]TokenRateWithSupply
+		blackWhites *TokenBlackWhites
+		metadata    *bank.DenomMetadataResponse
+		errs        [4]error
+	)
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		rate, errs[0] = m.Rate(ctx, denom)
+	}()
+	go func() {
+		defer wg.Done()
+		rates, errs[1] = m.RatesByDenom(ctx, denom)
+	}()
+	go func() {
+		defer wg.Done()
+		blackWhites, errs[2] = m.TokenBlackWhites(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		metadata, errs[3] = bank.New(m.client).DenomMetadata(ctx, denom)
+	}()
+	wg.Wait()
+
+	if errs[0] != nil && errs[1] != nil && errs[2] != nil && errs[3] != nil {
+		return nil, fmt.Errorf("failed to query token info for %s: %w", denom, errs[0])
+	}
+
+	info := &TokenInfo{Denom: denom}
+	missing := func(part string, err error) {
+		info.Missing = append(info.Missing, fmt.Sprintf("%s: %v", part, err))
+	}
+
+	// rates-by-denom also holds the rate, in case the rate query failed
+	withSupply, ok := rates[denom]
+	switch {
+	case errs[0] == nil && rate.Denom != "":
+		info.Rate = rate
+	case ok:
+		info.Rate = &withSupply.Data
+	case errs[0] != nil:
+		missing("rate", errs[0])
+	default:
+		missing("rate", fmt.Errorf("no rate for %s", denom))
+	}
+	if info.Rate != nil {
+		info.FeePayments = &info.Rate.FeeEnabled
+	}
+
+	switch {
+	case errs[1] != nil:
+		missing("supply", errs[1])
+	case !ok || withSupply.Supply.Amount == "":
+		missing("supply", fmt.Errorf("no supply for %s", denom))
+	default:
+		info.Supply = &withSupply.Supply
+	}
+
+	if errs[2] != nil {
+		missing("black/white lists", errs[2])
+	} else {
+		whitelisted := slices.Contains(blackWhites.Whitelisted, denom)
+		blacklisted := slices.Contains(blackWhites.Blacklisted, denom)
+		info.Whitelisted = &whitelisted
+		info.Blacklisted = &blacklisted
+	}
+
+	if errs[3] != nil {
+		missing("metadata", errs[3])
+	} else {
+		for i := range metadata.Metadatas {
+			if metadata.Metadatas[i].Base == denom {
+				info.Metadata = &metadata.Metadatas[i]
+				break
+			}
+		}
+		if info.Metadata == nil {
+			missing("metadata", fmt.Errorf("no bank metadata for %s", denom))
+		}
+	}
+
+	return info, nil
+}
+
 // TxOptions contains common transaction options.
 type TxOptions struct {
 	Fees          string
@@ -117,6 +298,7 @@ type TxOptions struct {
 	GasAdjustment float64
 	Memo          string
 	BroadcastMode string
+	SignMode      string
 }
 
 func buildTxFlags(opts *TxOptions) map[string]string {
@@ -137,6 +319,9 @@ func buildTxFlags(opts *TxOptions) map[string]string {
 		if opts.BroadcastMode != "" {
 			flags["broadcast-mode"] = opts.BroadcastMode
 		}
+		if opts.SignMode != "" {
+			flags["sign-mode"] = opts.SignMode
+		}
 	}
 	return flags
 }
@@ -281,42 +466,42 @@ func (m *Module) UpsertRate(ctx context.Context, from string, rateOpts *UpsertRa
 // ProposalUpsertRateOpts contains options for a proposal to upsert token rate.
 type ProposalUpsertRateOpts struct {
 	// Basic token info
-	Denom       string
-	Decimals    uint32
-	FeeRate     string
-	FeePayments bool
+	Denom       string `json:"denom,omitempty"`
+	Decimals    uint32 `json:"decimals,omitempty"`
+	FeeRate     string `json:"fee_rate,omitempty"`
+	FeePayments bool   `json:"fee_payments,omitempty"`
 
 	// Token metadata
-	Name    string
-	Symbol  string
-	Icon    string
-	Website string
-	Social  string
+	Name    string `json:"name,omitempty"`
+	Symbol  string `json:"symbol,omitempty"`
+	Icon    string `json:"icon,omitempty"`
+	Website string `json:"website,omitempty"`
+	Social  string `json:"social,omitempty"`
 
 	// Staking
-	StakeToken bool
-	StakeCap   string
-	StakeMin   string
+	StakeToken bool   `json:"stake_token,omitempty"`
+	StakeCap   string `json:"stake_cap,omitempty"`
+	StakeMin   string `json:"stake_min,omitempty"`
 
 	// Supply
-	Supply    string
-	SupplyCap string
+	Supply    string `json:"supply,omitempty"`
+	SupplyCap string `json:"supply_cap,omitempty"`
 
 	// NFT
-	NftHash     string
-	NftMetadata string
+	NftHash     string `json:"nft_hash,omitempty"`
+	NftMetadata string `json:"nft_metadata,omitempty"`
 
 	// Other
-	Owner             string
-	OwnerEditDisabled bool
-	Invalidated       bool
-	TokenRate         string
-	TokenType         string
-	MintingFee        string
+	Owner             string `json:"owner,omitempty"`
+	OwnerEditDisabled bool   `json:"owner_edit_disabled,omitempty"`
+	Invalidated       bool   `json:"invalidated,omitempty"`
+	TokenRate         string `json:"token_rate,omitempty"`
+	TokenType         string `json:"token_type,omitempty"`
+	MintingFee        string `json:"minting_fee,omitempty"`
 
 	// Proposal fields
-	Title       string
-	Description string
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
 // ProposalUpsertRate creates a proposal to upsert a token rate.
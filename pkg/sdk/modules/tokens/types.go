@@ -1,5 +1,7 @@
 package tokens
 
+import "github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+
 // TokenRate represents a token rate configuration.
 type TokenRate struct {
 	Denom             string `json:"denom"`
@@ -39,6 +41,17 @@ type Coin struct {
 	Amount string `json:"amount"`
 }
 
+// RatesQueryOpts contains filters for FilteredRates. Filters combine: a rate
+// must pass all of them.
+type RatesQueryOpts struct {
+	// StakingOnly keeps tokens with staking enabled.
+	StakingOnly bool
+	// FeePaymentsOnly keeps tokens that can pay transaction fees.
+	FeePaymentsOnly bool
+	// DenomPrefix keeps denoms starting with the prefix.
+	DenomPrefix string
+}
+
 // AllRatesResponse contains the all-rates query response.
 type AllRatesResponse struct {
 	Data []TokenRateWithSupply `json:"data"`
@@ -49,3 +62,16 @@ type TokenBlackWhites struct {
 	Whitelisted []string `json:"whitelisted,omitempty"`
 	Blacklisted []string `json:"blacklisted,omitempty"`
 }
+
+// TokenInfo aggregates what is known about a token. Parts that could not
+// be queried are left empty and listed in Missing.
+type TokenInfo struct {
+	Denom       string              `json:"denom"`
+	Rate        *TokenRate          `json:"rate,omitempty"`
+	FeePayments *bool               `json:"fee_payments,omitempty"`
+	Whitelisted *bool               `json:"whitelisted,omitempty"`
+	Blacklisted *bool               `json:"blacklisted,omitempty"`
+	Supply      *Coin               `json:"supply,omitempty"`
+	Metadata    *bank.DenomMetadata `json:"metadata,omitempty"`
+	Missing     []string            `json:"missing,omitempty"`
+}
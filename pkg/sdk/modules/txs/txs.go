@@ -0,0 +1,155 @@
+// Package txs provides transaction lookup functionality.
+package txs
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// ErrInvalidHash indicates a malformed transaction hash.
+var ErrInvalidHash = errors.New("invalid transaction hash")
+
+// ErrNotConfirmed indicates a transaction was not included in a block
+// before the wait timeout elapsed. It may still be confirmed later.
+var ErrNotConfirmed = errors.New("transaction not yet confirmed")
+
+// Default wait settings.
+const (
+	DefaultWaitTimeout  = 60 * time.Second
+	DefaultPollInterval = 2 * time.Second
+)
+
+// Module provides transaction query functionality.
+type Module struct {
+	client sdk.Client
+}
+
+// New creates a new txs module.
+func New(client sdk.Client) *Module {
+	return &Module{client: client}
+}
+
+// TxResult is the result of an included transaction.
+type TxResult struct {
+	Height    int64         `json:"height,string"`
+	TxHash    string        `json:"txhash"`
+	Code      uint32        `json:"code"`
+	Codespace string        `json:"codespace,omitempty"`
+	RawLog    string        `json:"raw_log"`
+	GasWanted int64         `json:"gas_wanted,string"`
+	GasUsed   int64         `json:"gas_used,string"`
+	Timestamp string        `json:"timestamp,omitempty"`
+	Events    []sdk.TxEvent `json:"events,omitempty"`
+}
+
+// Success reports whether the transaction was executed successfully.
+func (r *TxResult) Success() bool {
+	return r.Code == 0
+}
+
+// Tx queries a transaction by hash.
+// It returns sdk.ErrTxNotFound if the transaction is not (yet) included in a
+// block, and ErrInvalidHash if hash is not a 32-byte hex string.
+func (m *Module) Tx(ctx context.Context, hash string) (*TxResult, error) {
+	hash = strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if err := ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Query(ctx, &sdk.QueryRequest{
+		Module:  "tx",
+		RawArgs: []string{hash},
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("%w: %s (it may still be in the mempool, try again shortly)", sdk.ErrTxNotFound, hash)
+		}
+		return nil, fmt.Errorf("failed to query tx: %w", err)
+	}
+
+	// REST wraps the result in tx_response; sekaid returns it directly.
+	var wrapped struct {
+		TxResponse *TxResult `json:"tx_response"`
+	}
+	if err := json.Unmarshal(resp.Data, &wrapped); err == nil && wrapped.TxResponse != nil {
+		return wrapped.TxResponse, nil
+	}
+
+	var result TxResult
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse tx: %w", err)
+	}
+	return &result, nil
+}
+
+// WaitOptions configures Wait.
+type WaitOptions struct {
+	// Timeout is how long to wait for inclusion (default: DefaultWaitTimeout)
+	Timeout time.Duration
+
+	// PollInterval is how often to query the tx (default: DefaultPollInterval)
+	PollInterval time.Duration
+}
+
+// Wait polls for a transaction until it is included in a block or the
+// timeout elapses. A failed transaction is still returned as a result;
+// check TxResult.Success. On timeout the error wraps ErrNotConfirmed.
+func (m *Module) Wait(ctx context.Context, hash string, opts *WaitOptions) (*TxResult, error) {
+	timeout := DefaultWaitTimeout
+	interval := DefaultPollInterval
+	if opts != nil {
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		result, err := m.Tx(ctx, hash)
+		switch {
+		case err == nil && result.Height > 0:
+			return result, nil
+		case errors.Is(err, ErrInvalidHash):
+			return nil, err
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return nil, fmt.Errorf("%w: %s after %s", ErrNotConfirmed, hash, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ValidateHash checks that hash is a 64-character hex transaction hash.
+func ValidateHash(hash string) error {
+	if len(hash) != 64 {
+		return fmt.Errorf("%w: expected 64 hex characters, got %d", ErrInvalidHash, len(hash))
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return fmt.Errorf("%w: %s is not hex", ErrInvalidHash, hash)
+	}
+	return nil
+}
+
+// isNotFound reports whether a query error means the tx does not exist.
+func isNotFound(err error) bool {
+	var httpErr *sdk.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "not found")
+}
@@ -0,0 +1,117 @@
+package upgrade
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+)
+
+// Countdown is the time and blocks left until an upgrade plan triggers,
+// counted from the latest block of a node.
+type Countdown struct {
+	// Plan is the plan name, empty if no plan is set
+	Plan string `json:"plan,omitempty"`
+
+	// Status summarizes the countdown, e.g. "in 2h30m0s" or "passed"
+	Status string `json:"status"`
+
+	// Passed is true once the upgrade time and height are both reached
+	Passed bool `json:"passed"`
+
+	UpgradeTime   string `json:"upgrade_time,omitempty"`
+	TimeRemaining string `json:"time_remaining,omitempty"`
+
+	UpgradeHeight   int64  `json:"upgrade_height,omitempty"`
+	BlocksRemaining *int64 `json:"blocks_remaining,omitempty"`
+
+	LatestHeight    int64  `json:"latest_height"`
+	LatestBlockTime string `json:"latest_block_time"`
+
+	// CatchingUp is true if the node is syncing, so its latest block, and
+	// the countdown, lag behind the network
+	CatchingUp bool `json:"catching_up,omitempty"`
+}
+
+// NewCountdown computes the countdown to plan from the latest block in
+// status. The upgrade time is the plan's min upgrade time, in Unix seconds
+// or RFC 3339; a time or height of 0 is unset. A nil plan gives a
+// countdown with the status "no upgrade plan set".
+func NewCountdown(plan *Plan, status *sdk.StatusResponse) (*Countdown, error) {
+	c := &Countdown{
+		LatestHeight:    status.SyncInfo.LatestBlockHeight,
+		LatestBlockTime: status.SyncInfo.LatestBlockTime,
+		CatchingUp:      status.SyncInfo.CatchingUp,
+	}
+	if plan == nil || plan.Name == "" {
+		c.Status = "no upgrade plan set"
+		return c, nil
+	}
+	c.Plan = plan.Name
+
+	upgradeTime, err := parsePlanTime(plan.UpgradeTime)
+	if err != nil {
+		return nil, err
+	}
+	height := int64(0)
+	if plan.Height != "" {
+		if height, err = strconv.ParseInt(plan.Height, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid upgrade height: %s", plan.Height)
+		}
+	}
+
+	var pending []string
+	if !upgradeTime.IsZero() {
+		now, err := time.Parse(time.RFC3339Nano, status.SyncInfo.LatestBlockTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latest block time: %s", status.SyncInfo.LatestBlockTime)
+		}
+		remaining := upgradeTime.Sub(now).Round(time.Second)
+		c.UpgradeTime = upgradeTime.UTC().Format(time.RFC3339)
+		if remaining > 0 {
+			c.TimeRemaining = remaining.String()
+			pending = append(pending, "in "+c.TimeRemaining)
+		} else {
+			c.TimeRemaining = "0s"
+		}
+	}
+	if height > 0 {
+		blocks := max(height-c.LatestHeight, 0)
+		c.UpgradeHeight, c.BlocksRemaining = height, &blocks
+		if blocks > 0 {
+			pending = append(pending, fmt.Sprintf("in %d blocks", blocks))
+		}
+	}
+
+	switch {
+	case upgradeTime.IsZero() && height == 0:
+		c.Status = "no upgrade time or height set"
+	case len(pending) == 0:
+		c.Passed = true
+		c.Status = "passed"
+	default:
+		c.Status = strings.Join(pending, " and ")
+	}
+	return c, nil
+}
+
+// parsePlanTime parses a plan's upgrade time, in Unix seconds or RFC 3339;
+// empty and 0 give the zero time.
+func parsePlanTime(s string) (time.Time, error) {
+	if s == "" || s == "0" {
+		return time.Time{}, nil
+	}
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid upgrade time: %s", s)
+	}
+	if t.Unix() <= 0 {
+		return time.Time{}, nil
+	}
+	return t, nil
+}
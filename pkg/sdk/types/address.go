@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -132,6 +135,34 @@ func IsValidConsAddress(addr string) bool {
 	return pattern.MatchString(addr)
 }
 
+// ConsAddressFromPubKey returns the consensus address of an ed25519
+// consensus public key: the first 20 bytes of its SHA-256 hash.
+func ConsAddressFromPubKey(pubKey []byte) (ConsAddress, error) {
+	if len(pubKey) != 32 {
+		return "", fmt.Errorf("invalid ed25519 public key: %d bytes", len(pubKey))
+	}
+	hash := sha256.Sum256(pubKey)
+	addr, err := Bech32Encode(Bech32PrefixConsAddr, hash[:20])
+	if err != nil {
+		return "", err
+	}
+	return ConsAddress(addr), nil
+}
+
+// ConsAddressFromHex returns the consensus address of a hex-encoded
+// address, as Tendermint reports block proposers.
+func ConsAddressFromHex(s string) (ConsAddress, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil || len(data) != 20 {
+		return "", fmt.Errorf("invalid hex consensus address %q", s)
+	}
+	addr, err := Bech32Encode(Bech32PrefixConsAddr, data)
+	if err != nil {
+		return "", err
+	}
+	return ConsAddress(addr), nil
+}
+
 // GetAddressType returns the type of address based on its prefix.
 func GetAddressType(addr string) string {
 	addr = strings.ToLower(addr)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"sort"
 	"strconv"
@@ -131,6 +132,63 @@ func (cs Coins) GetCoin(denom string) (Coin, bool) {
 	return Coin{}, false
 }
 
+// Add returns the sum of cs and other, one coin per denom.
+func (cs Coins) Add(other Coins) (Coins, error) {
+	amounts := make(map[string]*big.Int, len(cs)+len(other))
+	for _, c := range append(append(Coins{}, cs...), other...) {
+		amount, ok := new(big.Int).SetString(c.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount: %s", c)
+		}
+		if have, ok := amounts[c.Denom]; ok {
+			have.Add(have, amount)
+		} else {
+			amounts[c.Denom] = amount
+		}
+	}
+
+	result := make(Coins, 0, len(amounts))
+	for denom, amount := range amounts {
+		if amount.Sign() > 0 {
+			result = append(result, Coin{Denom: denom, Amount: amount.String()})
+		}
+	}
+	return result.Sort(), nil
+}
+
+// Sub returns cs minus other, omitting denoms that drop to zero. It returns
+// an error if other holds a denom that cs does not cover.
+func (cs Coins) Sub(other Coins) (Coins, error) {
+	amounts := make(map[string]*big.Int, len(cs))
+	for _, c := range cs {
+		amount, ok := new(big.Int).SetString(c.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount: %s", c)
+		}
+		amounts[c.Denom] = amount
+	}
+
+	for _, c := range other {
+		amount, ok := new(big.Int).SetString(c.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount: %s", c)
+		}
+		have, ok := amounts[c.Denom]
+		if !ok || have.Cmp(amount) < 0 {
+			return nil, fmt.Errorf("insufficient %s: have %s, need %s", c.Denom, cs.AmountOf(c.Denom), c.Amount)
+		}
+		have.Sub(have, amount)
+	}
+
+	result := make(Coins, 0, len(amounts))
+	for denom, amount := range amounts {
+		if amount.Sign() > 0 {
+			result = append(result, Coin{Denom: denom, Amount: amount.String()})
+		}
+	}
+	return result.Sort(), nil
+}
+
 // ParseCoin parses a coin string like "100ukex" into a Coin.
 func ParseCoin(s string) (Coin, error) {
 	s = strings.TrimSpace(s)
@@ -152,7 +210,8 @@ func ParseCoin(s string) (Coin, error) {
 	}, nil
 }
 
-// ParseCoins parses a comma-separated list of coins.
+// ParseCoins parses a comma-separated list of coins (e.g.,
+// "100ukex,50samolean"). Each denom may appear only once.
 func ParseCoins(s string) (Coins, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
@@ -161,12 +220,17 @@ func ParseCoins(s string) (Coins, error) {
 
 	parts := strings.Split(s, ",")
 	coins := make(Coins, 0, len(parts))
+	seen := make(map[string]bool, len(parts))
 
 	for _, part := range parts {
 		coin, err := ParseCoin(strings.TrimSpace(part))
 		if err != nil {
 			return nil, err
 		}
+		if seen[coin.Denom] {
+			return nil, fmt.Errorf("duplicate denom in coins: %s", coin.Denom)
+		}
+		seen[coin.Denom] = true
 		coins = append(coins, coin)
 	}
 
@@ -179,6 +243,23 @@ type DecCoin struct {
 	Amount string `json:"amount"` // Decimal string like "100.5"
 }
 
+// ParseDecCoin parses a coin string with a decimal amount like "1.5kex".
+func ParseDecCoin(s string) (DecCoin, error) {
+	s = strings.TrimSpace(s)
+	re := regexp.MustCompile(`^(\d+(?:\.\d+)?)([a-zA-Z][a-zA-Z0-9/]*)$`)
+	matches := re.FindStringSubmatch(s)
+	if matches == nil {
+		return DecCoin{}, fmt.Errorf("invalid coin format: %s", s)
+	}
+	return DecCoin{Amount: matches[1], Denom: matches[2]}, nil
+}
+
+// String returns the string representation of a decimal coin (e.g.,
+// "1.5kex").
+func (dc DecCoin) String() string {
+	return dc.Amount + dc.Denom
+}
+
 // DecCoins is a collection of DecCoin.
 type DecCoins []DecCoin
 
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DenomInfo describes how a base denomination is displayed to humans.
+type DenomInfo struct {
+	// Denom is the base denomination (e.g., "ukex")
+	Denom string `json:"denom"`
+
+	// Symbol is the display symbol (e.g., "KEX")
+	Symbol string `json:"symbol"`
+
+	// Decimals is the number of decimals between base and display units
+	Decimals int `json:"decimals"`
+}
+
+// DenomRegistry maps base denominations to their display information.
+type DenomRegistry map[string]DenomInfo
+
+// Resolve returns display info for a denom.
+// Unknown denoms resolve to themselves with zero decimals.
+func (r DenomRegistry) Resolve(denom string) DenomInfo {
+	if info, ok := r[denom]; ok {
+		if info.Symbol == "" {
+			info.Symbol = denom
+		}
+		return info
+	}
+	return DenomInfo{Denom: denom, Symbol: denom}
+}
+
+// DisplayAmount converts a base amount to display units.
+// The amount may be an integer or decimal string.
+func (r DenomRegistry) DisplayAmount(denom, amount string) (*big.Rat, error) {
+	return ScaleAmount(amount, r.Resolve(denom).Decimals)
+}
+
+// Humanize formats a base amount for display (e.g., "1,234.5 KEX").
+// If the amount cannot be parsed it is returned unchanged with its denom.
+func (r DenomRegistry) Humanize(denom, amount string) string {
+	info := r.Resolve(denom)
+	value, err := ScaleAmount(amount, info.Decimals)
+	if err != nil {
+		return amount + denom
+	}
+	return FormatAmount(value, info.Decimals) + " " + info.Symbol
+}
+
+// Convert converts a coin between the units of one token: its base denom
+// and its symbol, matched case-insensitively (e.g., 1kex to 1000000ukex).
+// The coin and the result must both be whole numbers of base units.
+func (r DenomRegistry) Convert(coin DecCoin, to string) (DecCoin, error) {
+	fromDenom, fromDecimals, err := r.unit(coin.Denom)
+	if err != nil {
+		return DecCoin{}, err
+	}
+	toDenom, toDecimals, err := r.unit(to)
+	if err != nil {
+		return DecCoin{}, err
+	}
+	if fromDenom != toDenom {
+		return DecCoin{}, fmt.Errorf("cannot convert %s to %s: different tokens", coin.Denom, to)
+	}
+
+	base, err := ScaleAmount(coin.Amount, -fromDecimals)
+	if err != nil {
+		return DecCoin{}, err
+	}
+	if !base.IsInt() {
+		return DecCoin{}, fmt.Errorf("%s is not a whole number of %s", coin, fromDenom)
+	}
+	value, _ := ScaleAmount(base.Num().String(), toDecimals)
+	amount := value.FloatString(toDecimals)
+	if strings.Contains(amount, ".") {
+		amount = strings.TrimRight(strings.TrimRight(amount, "0"), ".")
+	}
+	return DecCoin{Denom: to, Amount: amount}, nil
+}
+
+// unit returns the base denom of the token denom names, by base denom or by
+// symbol, and the decimals of denom relative to it.
+func (r DenomRegistry) unit(denom string) (string, int, error) {
+	if _, ok := r[denom]; ok {
+		return denom, 0, nil
+	}
+	base, decimals := "", 0
+	for _, info := range r {
+		if info.Symbol == "" || !strings.EqualFold(info.Symbol, denom) {
+			continue
+		}
+		if base != "" {
+			return "", 0, fmt.Errorf("ambiguous denom %s: symbol of %s and %s", denom, base, info.Denom)
+		}
+		base, decimals = info.Denom, info.Decimals
+	}
+	if base == "" {
+		return "", 0, fmt.Errorf("unknown denom: %s", denom)
+	}
+	return base, decimals, nil
+}
+
+// ScaleAmount parses amount and divides it by 10^decimals; negative
+// decimals multiply.
+func ScaleAmount(amount string, decimals int) (*big.Rat, error) {
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+	if decimals > 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+		value.Quo(value, new(big.Rat).SetInt(scale))
+	} else if decimals < 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-decimals)), nil)
+		value.Mul(value, new(big.Rat).SetInt(scale))
+	}
+	return value, nil
+}
+
+// FormatAmount formats a value with thousands separators and at most
+// precision decimal places, trimming trailing zeros (e.g., "1,234.5").
+func FormatAmount(value *big.Rat, precision int) string {
+	s := value.FloatString(precision)
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	fracPart = strings.TrimRight(fracPart, "0")
+
+	var sb strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+
+	if fracPart != "" {
+		return sign + sb.String() + "." + fracPart
+	}
+	return sign + sb.String()
+}
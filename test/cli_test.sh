@@ -34,6 +34,24 @@ run_test() {
     fi
 }
 
+run_exit_test() {
+    local name="$1"
+    local expected="$2"
+    local cmd="$3"
+    echo -n "Testing: $name... "
+    eval "$cmd" >/dev/null 2>&1
+    local code=$?
+    if [ "$code" -eq "$expected" ]; then
+        echo -e "${GREEN}PASS${NC}"
+        ((PASSED++))
+    else
+        echo -e "${RED}FAIL${NC}"
+        echo "  Command: $cmd"
+        echo "  Expected exit code $expected, got $code"
+        ((FAILED++))
+    fi
+}
+
 skip_test() {
     local name="$1"
     local reason="$2"
@@ -57,6 +75,16 @@ echo -e "${CYAN}=== BASIC COMMANDS ===${NC}"
 run_test "version" "$CLI version"
 run_test "help" "$CLI --help"
 
+# =============================================
+echo -e "\n${CYAN}=== EXIT CODES ===${NC}"
+# =============================================
+run_exit_test "unknown command" 2 "$CLI no-such-command"
+run_exit_test "unknown flag" 2 "$CLI status --no-such-flag"
+run_exit_test "invalid flag value" 2 "$CLI status --watch soon"
+run_exit_test "unreachable node" 3 "$CLI --rest http://127.0.0.1:1 status"
+run_exit_test "missing key" 5 "$CLI keys show no-such-key"
+run_exit_test "switch takes no value" 2 "$CLI query bank balances --all-accounts --stream ukex"
+
 # =============================================
 echo -e "\n${CYAN}=== STATUS ===${NC}"
 # =============================================
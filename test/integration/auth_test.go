@@ -2,6 +2,7 @@
 package integration
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
@@ -30,6 +31,30 @@ func TestAuthAccount(t *testing.T) {
 	t.Logf("Account: %s, Number: %s, Sequence: %s", result.Address, result.AccountNumber, result.Sequence)
 }
 
+// TestAuthDecodedAccount tests querying an account in decoded form.
+func TestAuthDecodedAccount(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := auth.New(client)
+	result, err := mod.DecodedAccount(ctx, testAddr)
+	requireNoError(t, err, "Failed to query decoded account")
+	requireNotNil(t, result, "Decoded account is nil")
+
+	requireEqual(t, testAddr, result.Address, "Address mismatch")
+	requireEqual(t, "base", result.Kind, "Kind mismatch")
+	if result.PubKey != nil {
+		requireTrue(t, strings.HasPrefix(result.PubKey.Bech32, "kirapub1"), "Pubkey should be bech32 encoded")
+	}
+
+	t.Logf("Account: %s, Kind: %s", result.Address, result.Kind)
+}
+
 // TestAuthAccounts tests querying all accounts.
 func TestAuthAccounts(t *testing.T) {
 	skipIfContainerNotRunning(t)
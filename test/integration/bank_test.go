@@ -2,9 +2,20 @@
 package integration
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/keys"
 	"github.com/kiracore/sekai-cli/pkg/sdk/types"
@@ -49,6 +60,66 @@ func TestBankBalance(t *testing.T) {
 	t.Logf("Address %s has %s ukex", testAddr, result.Amount)
 }
 
+// TestBankHolders tests the holder report: the test account holds ukex,
+// holders are sorted largest first, and --top caps the report.
+func TestBankHolders(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := bank.New(client)
+	pages := 0
+	holders, err := mod.Holders(ctx, "ukex", &bank.HoldersOptions{
+		Min:      "1",
+		PageSize: 2,
+		Progress: func(scanned, found int) { pages++ },
+	})
+	requireNoError(t, err, "Failed to query holders")
+	requireTrue(t, len(holders) > 0, "Expected at least one ukex holder")
+	requireTrue(t, pages > 0, "Progress should be reported for each page")
+
+	found := false
+	for i, h := range holders {
+		if h.Address == testAddr {
+			found = true
+		}
+		if i > 0 {
+			prev, _ := new(big.Int).SetString(holders[i-1].Amount, 10)
+			cur, _ := new(big.Int).SetString(h.Amount, 10)
+			requireTrue(t, prev.Cmp(cur) >= 0, "Holders should be sorted largest first")
+		}
+	}
+	requireTrue(t, found, "Test account should hold ukex")
+
+	top, err := mod.Holders(ctx, "ukex", &bank.HoldersOptions{Top: 1})
+	requireNoError(t, err, "Failed to query top holder")
+	requireEqual(t, 1, len(top), "--top 1 should return one holder")
+	requireEqual(t, holders[0].Amount, top[0].Amount, "Top holder should hold the largest amount")
+}
+
+// TestBankBalanceMissingDenom tests that a denom the address does not hold
+// has a zero balance rather than an error.
+func TestBankBalanceMissingDenom(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := bank.New(client)
+	result, err := mod.Balance(ctx, testAddr, "nosuchdenom")
+	requireNoError(t, err, "Failed to query balance of a missing denom")
+	requireNotNil(t, result, "Balance is nil")
+
+	requireEqual(t, "0nosuchdenom", result.String(), "Missing denom should have a zero balance")
+}
+
 // TestBankSpendableBalances tests querying spendable balances.
 func TestBankSpendableBalances(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -69,6 +140,30 @@ func TestBankSpendableBalances(t *testing.T) {
 	}
 }
 
+// TestBankSpendableBalanceByDenom tests querying the spendable balance of
+// one denom, including a denom the address does not hold.
+func TestBankSpendableBalanceByDenom(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := bank.New(client)
+	result, err := mod.SpendableBalanceByDenom(ctx, testAddr, "ukex")
+	requireNoError(t, err, "Failed to query spendable balance")
+	requireNotNil(t, result, "Spendable balance is nil")
+	requireEqual(t, "ukex", result.Denom, "Denom mismatch")
+
+	missing, err := mod.SpendableBalanceByDenom(ctx, testAddr, "nosuchdenom")
+	requireNoError(t, err, "Failed to query spendable balance of a missing denom")
+	requireEqual(t, "0nosuchdenom", missing.String(), "Missing denom should have a zero spendable balance")
+
+	t.Logf("Address %s can spend %s ukex", testAddr, result.Amount)
+}
+
 // TestBankTotalSupply tests querying total supply of all tokens.
 func TestBankTotalSupply(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -234,3 +329,416 @@ func TestBankMultiSend(t *testing.T) {
 	requireNoError(t, err, "Failed to query recipient2 balance")
 	t.Logf("Recipient2 balance after: %s ukex", balance2.Amount)
 }
+
+// TestBankMultiSendRecipients tests sending a different amount to each recipient.
+func TestBankMultiSendRecipients(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	bankMod := bank.New(client)
+	keysMod := keys.New(client)
+
+	recipient1Name := generateUniqueID("msfile1")
+	recipient2Name := generateUniqueID("msfile2")
+	info1, err := keysMod.Add(ctx, recipient1Name, nil)
+	requireNoError(t, err, "Failed to create recipient1 key")
+	defer func() { _ = keysMod.Delete(ctx, recipient1Name, true) }()
+	info2, err := keysMod.Add(ctx, recipient2Name, nil)
+	requireNoError(t, err, "Failed to create recipient2 key")
+	defer func() { _ = keysMod.Delete(ctx, recipient2Name, true) }()
+
+	csvData := fmt.Sprintf("address,amount\n%s,300ukex\n%s,700ukex\n", info1.Address, info2.Address)
+	recipients, err := bank.ParseRecipientsCSV(strings.NewReader(csvData))
+	requireNoError(t, err, "Failed to parse recipients CSV")
+	requireEqual(t, 2, len(recipients), "Recipient count mismatch")
+
+	resp, err := bankMod.MultiSend(ctx, TestKey, nil, nil, &bank.MultiSendOptions{Recipients: recipients})
+	requireNoError(t, err, "Failed to multi-send tokens")
+	requireTxSuccess(t, resp, "Multi-send transaction failed")
+	t.Logf("Multi-send TX hash: %s", resp.TxHash)
+
+	time.Sleep(7 * time.Second)
+
+	balance1, err := bankMod.Balance(ctx, info1.Address, "ukex")
+	requireNoError(t, err, "Failed to query recipient1 balance")
+	requireEqual(t, "300", balance1.Amount, "Recipient1 balance mismatch")
+	balance2, err := bankMod.Balance(ctx, info2.Address, "ukex")
+	requireNoError(t, err, "Failed to query recipient2 balance")
+	requireEqual(t, "700", balance2.Amount, "Recipient2 balance mismatch")
+
+	_, err = bank.ParseRecipientsCSV(strings.NewReader(info1.Address + ",100ukex\nkira1bad,5ukex\n"))
+	requireError(t, err, "Invalid address should be rejected")
+	requireTrue(t, strings.Contains(err.Error(), "line 2"), "Error should name the line")
+}
+
+// coreClient exposes only the methods of sdk.Client, like a client that
+// implements none of the optional interfaces.
+type coreClient struct {
+	sdk.Client
+}
+
+// TestBankMultiSendRecipientsUnsupported tests that a per-recipient
+// multi-send, which is built and signed in steps, fails with
+// sdk.ErrNotSupported on a client that cannot sign transactions offline.
+func TestBankMultiSendRecipientsUnsupported(t *testing.T) {
+	client := coreClient{Client: mock.NewClient()}
+	recipients := []bank.Recipient{{Address: "kira1w508d6qejxtdg4y5r3zarvary0c5xw7k2ja5w4", Amount: types.NewCoins(types.NewCoin("ukex", 1))}}
+
+	_, err := bank.New(client).MultiSend(context.Background(), TestKey, nil, nil, &bank.MultiSendOptions{Recipients: recipients})
+	requireError(t, err, "Multi-send should fail without an offline tx client")
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Error should be not supported: "+err.Error())
+}
+
+// airdropClient is a mock client for airdrops: it generates multi-send
+// transactions, rejects those paying kira1rejected, fails the first
+// seqFailures broadcasts with an account sequence mismatch, and counts the
+// sends to each address.
+type airdropClient struct {
+	*mock.Client
+	seqFailures int
+	broadcasts  int
+	sent        map[string]int
+}
+
+func (c *airdropClient) GenerateTx(ctx context.Context, req *sdk.TxRequest) ([]byte, error) {
+	return []byte(`{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgMultiSend","inputs":[{"address":"kira1sender","coins":[]}],"outputs":[]}]},"signatures":[]}`), nil
+}
+
+func (c *airdropClient) BroadcastTx(ctx context.Context, tx []byte, mode string) (*sdk.TxResponse, error) {
+	if c.seqFailures > 0 {
+		c.seqFailures--
+		return nil, fmt.Errorf("%w, expected 5, got 4", sdk.ErrAccountSequence)
+	}
+	var parsed struct {
+		Body struct {
+			Messages []struct {
+				Outputs []struct {
+					Address string `json:"address"`
+				} `json:"outputs"`
+			} `json:"messages"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(tx, &parsed); err != nil {
+		return nil, err
+	}
+	outputs := parsed.Body.Messages[0].Outputs
+	for _, o := range outputs {
+		if o.Address == "kira1rejected" {
+			resp := &sdk.TxResponse{Code: 7, RawLog: "invalid address"}
+			return resp, sdk.NewTxErrorFromResponse("bank", "multi-send", resp)
+		}
+	}
+	c.broadcasts++
+	for _, o := range outputs {
+		c.sent[o.Address]++
+	}
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("%064X", c.broadcasts)}, nil
+}
+
+// TestBankAirdropResume tests that an airdrop batches recipients, retries
+// an account sequence mismatch, reports a rejected recipient, and when
+// interrupted resumes from its ledger without paying anyone twice.
+func TestBankAirdropResume(t *testing.T) {
+	client := &airdropClient{Client: mock.NewClient(), seqFailures: 1, sent: map[string]int{}}
+	var recipients []bank.Recipient
+	for _, addr := range []string{"kira1a", "kira1b", "kira1rejected", "kira1d", "kira1e"} {
+		amount, err := types.ParseCoins("10ukex")
+		requireNoError(t, err, "Invalid amount")
+		recipients = append(recipients, bank.Recipient{Address: addr, Amount: amount})
+	}
+	statePath := filepath.Join(t.TempDir(), "airdrop.state")
+	ctx := context.Background()
+
+	// The second batch sent is not confirmed, as if interrupted
+	unconfirmed := fmt.Sprintf("%064X", 2)
+	interrupted := true
+	run := func() (*bank.AirdropResult, error) {
+		ledger, err := bank.OpenAirdropLedger(statePath)
+		requireNoError(t, err, "Failed to open ledger")
+		defer ledger.Close()
+		return bank.New(client).Airdrop(ctx, "sender", recipients, &bank.AirdropOptions{
+			BatchSize:  2,
+			RetryDelay: time.Millisecond,
+			Ledger:     ledger,
+			Confirm: func(ctx context.Context, hash string) (bool, error) {
+				if hash == unconfirmed && interrupted {
+					return false, errors.New("timed out")
+				}
+				return true, nil
+			},
+		})
+	}
+
+	result, err := run()
+	requireError(t, err, "Unconfirmed batch should stop the airdrop")
+	requireEqual(t, 2, result.Sent, "First run should send the first batch")
+	requireEqual(t, 1, len(result.Failed), "Rejected recipient should fail")
+	requireEqual(t, "kira1rejected", result.Failed[0].Address, "Failed recipient mismatch")
+
+	interrupted = false
+	result, err = run()
+	requireNoError(t, err, "Resumed airdrop failed")
+	requireEqual(t, 3, result.Skipped, "Sent and unconfirmed recipients should be skipped")
+	requireEqual(t, 1, result.Sent, "Only the last recipient should be sent")
+	requireEqual(t, "10ukex", result.TotalSent.String(), "Total sent mismatch")
+	requireEqual(t, 1, len(result.Failed), "Rejected recipient should fail again")
+
+	for _, addr := range []string{"kira1a", "kira1b", "kira1d", "kira1e"} {
+		requireEqual(t, 1, client.sent[addr], "Recipient should be paid once: "+addr)
+	}
+
+	// Everything but the rejected recipient is recorded
+	ledger, err := bank.OpenAirdropLedger(statePath)
+	requireNoError(t, err, "Failed to reopen ledger")
+	defer ledger.Close()
+	pending := ledger.Pending(recipients)
+	requireEqual(t, 1, len(pending), "Only the rejected recipient should be pending")
+	requireEqual(t, 0, len(ledger.Unconfirmed()), "No batch should be left unconfirmed")
+}
+
+// TestBankAirdropTornLedger tests that a line torn by an interrupted write
+// is cut from the ledger, so entries appended after it are read back on
+// every later resume.
+func TestBankAirdropTornLedger(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "airdrop.state")
+	amount, err := types.ParseCoins("10ukex")
+	requireNoError(t, err, "Invalid amount")
+	recipients := []bank.Recipient{{Address: "kira1a", Amount: amount}, {Address: "kira1b", Amount: amount}, {Address: "kira1c", Amount: amount}}
+
+	ledger, err := bank.OpenAirdropLedger(statePath)
+	requireNoError(t, err, "Failed to open ledger")
+	requireNoError(t, ledger.Broadcast("HASH1", recipients[:1]), "Failed to record batch")
+	requireNoError(t, ledger.Close(), "Failed to close ledger")
+
+	// Tear a line, as if interrupted while writing
+	f, err := os.OpenFile(statePath, os.O_WRONLY|os.O_APPEND, 0o600)
+	requireNoError(t, err, "Failed to open state file")
+	_, err = f.WriteString(`{"tx_hash":"HASH2","status":"broad`)
+	requireNoError(t, err, "Failed to tear line")
+	f.Close()
+
+	ledger, err = bank.OpenAirdropLedger(statePath)
+	requireNoError(t, err, "Torn last line should be dropped")
+	requireNoError(t, ledger.Broadcast("HASH3", recipients[1:2]), "Failed to record batch")
+	requireNoError(t, ledger.Close(), "Failed to close ledger")
+
+	for i := 0; i < 2; i++ {
+		ledger, err = bank.OpenAirdropLedger(statePath)
+		requireNoError(t, err, "Failed to reopen ledger")
+		pending := ledger.Pending(recipients)
+		requireEqual(t, 1, len(pending), "Batches after the torn line should be kept")
+		requireEqual(t, "kira1c", pending[0].Address, "Pending recipient mismatch")
+		requireEqual(t, 2, len(ledger.Unconfirmed()), "Unconfirmed batch count mismatch")
+		requireNoError(t, ledger.Close(), "Failed to close ledger")
+	}
+}
+
+// TestBankSendAll tests sweeping an account's spendable balance minus fees.
+func TestBankSendAll(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	bankMod := bank.New(client)
+	keysMod := keys.New(client)
+
+	// Fund a temporary key
+	sweepKeyName := generateUniqueID("sweep")
+	keyInfo, err := keysMod.Add(ctx, sweepKeyName, nil)
+	requireNoError(t, err, "Failed to create sweep key")
+	defer func() { _ = keysMod.Delete(ctx, sweepKeyName, true) }()
+
+	fees := types.NewCoins(types.NewCoin("ukex", 100))
+
+	// An empty account has nothing to sweep
+	_, err = bankMod.SweepAmount(ctx, keyInfo.Address, fees)
+	requireError(t, err, "Sweeping an empty account should fail")
+
+	resp, err := bankMod.Send(ctx, TestKey, keyInfo.Address, types.NewCoins(types.NewCoin("ukex", 1000)), nil)
+	requireNoError(t, err, "Failed to fund sweep key")
+	requireTxSuccess(t, resp, "Funding transaction failed")
+	time.Sleep(7 * time.Second)
+
+	// A fee denom the account does not hold is rejected
+	_, err = bankMod.SweepAmount(ctx, keyInfo.Address, types.NewCoins(types.NewCoin("unknowndenom", 1)))
+	requireError(t, err, "Sweeping with an unheld fee denom should fail")
+
+	amount, err := bankMod.SweepAmount(ctx, keyInfo.Address, fees)
+	requireNoError(t, err, "Failed to compute sweep amount")
+	requireEqual(t, "900", amount.AmountOf("ukex"), "Sweep amount should be balance minus fees")
+
+	resp, err = bankMod.Send(ctx, sweepKeyName, getTestAddress(t), amount, &bank.SendOptions{Fees: fees.String()})
+	requireNoError(t, err, "Failed to sweep balance")
+	requireTxSuccess(t, resp, "Sweep transaction failed")
+	time.Sleep(7 * time.Second)
+
+	balances, err := bankMod.SpendableBalances(ctx, keyInfo.Address)
+	requireNoError(t, err, "Failed to query balances after sweep")
+	requireTrue(t, balances.IsZero(), "Account should be empty after sweep")
+}
+
+// TestBankSendInsufficientFunds tests that a send exceeding the balance
+// fails with the needed and available amounts.
+func TestBankSendInsufficientFunds(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	bankMod := bank.New(client)
+	keysMod := keys.New(client)
+
+	poorKeyName := generateUniqueID("poor")
+	keyInfo, err := keysMod.Add(ctx, poorKeyName, nil)
+	requireNoError(t, err, "Failed to create key")
+	defer func() { _ = keysMod.Delete(ctx, poorKeyName, true) }()
+
+	resp, err := bankMod.Send(ctx, TestKey, keyInfo.Address, types.NewCoins(types.NewCoin("ukex", 1000)), nil)
+	requireNoError(t, err, "Failed to fund key")
+	requireTxSuccess(t, resp, "Funding transaction failed")
+	time.Sleep(7 * time.Second)
+
+	_, err = bankMod.Send(ctx, poorKeyName, getTestAddress(t), types.NewCoins(types.NewCoin("ukex", 1000000)), &bank.SendOptions{Fees: TestFees})
+	requireError(t, err, "Sending more than the balance should fail")
+	requireTrue(t, sdk.IsInsufficientFunds(err), "Error should be insufficient funds: "+err.Error())
+	requireEqual(t, "insufficient_funds", sdk.ErrorKind(err), "Error kind mismatch")
+
+	var funds *sdk.InsufficientFundsError
+	requireTrue(t, errors.As(err, &funds), "Error should report the amounts: "+err.Error())
+	requireTrue(t, strings.HasSuffix(funds.Needed, "ukex"), "Needed amount should be in ukex")
+	t.Logf("Needed %s, available %s", funds.Needed, funds.Available)
+}
+
+// TestBankSendSequenceRetry tests that back-to-back sends from one key
+// succeed when the client retries account sequence mismatches.
+func TestBankSendSequenceRetry(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client, err := docker.NewClient(TestContainer,
+		docker.WithChainID(TestChainID),
+		docker.WithKeyringBackend("test"),
+		docker.WithHome(TestHome),
+		docker.WithFees(TestFees),
+		docker.WithSequenceRetry(true),
+	)
+	requireNoError(t, err, "Failed to create docker client")
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	bankMod := bank.New(client)
+	keysMod := keys.New(client)
+
+	recipientKeyName := generateUniqueID("seqretry")
+	keyInfo, err := keysMod.Add(ctx, recipientKeyName, nil)
+	requireNoError(t, err, "Failed to create recipient key")
+	defer func() { _ = keysMod.Delete(ctx, recipientKeyName, true) }()
+
+	// The second send is signed before the first is in a block, so it
+	// initially reuses the first one's sequence
+	amount := types.NewCoins(types.NewCoin("ukex", 100))
+	for i := 0; i < 2; i++ {
+		resp, err := bankMod.Send(ctx, TestKey, keyInfo.Address, amount, nil)
+		requireNoError(t, err, "Back-to-back send should be retried with the expected sequence")
+		requireTxSuccess(t, resp, "Send transaction failed")
+	}
+	time.Sleep(7 * time.Second)
+
+	balance, err := bankMod.Balance(ctx, keyInfo.Address, "ukex")
+	requireNoError(t, err, "Failed to query recipient balance")
+	requireEqual(t, "200", balance.Amount, "Recipient should have received both sends")
+}
+
+// TestBankSendSimulate tests estimating gas for a send without broadcasting.
+func TestBankSendSimulate(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	sim, ok := client.(sdk.TxSimulator)
+	requireTrue(t, ok, "Docker client should simulate transactions")
+
+	amount := types.NewCoins(types.NewCoin("ukex", 1000))
+	result, err := sim.Simulate(ctx, &sdk.TxRequest{
+		Module: "bank",
+		Action: "send",
+		Args:   []string{TestKey, testAddr, amount.String()},
+		Signer: TestKey,
+	})
+	requireNoError(t, err, "Failed to simulate send")
+	requireNotNil(t, result, "Simulate result is nil")
+
+	if result.GasEstimate == 0 {
+		t.Fatal("Expected non-zero gas estimate")
+	}
+	if result.GasLimit < result.GasEstimate {
+		t.Fatalf("Gas limit %d is below estimate %d", result.GasLimit, result.GasEstimate)
+	}
+	t.Logf("Gas estimate: %d, adjusted limit: %d", result.GasEstimate, result.GasLimit)
+}
+
+// TestBankSendGenerateSignBroadcast tests the offline signing workflow.
+func TestBankSendGenerateSignBroadcast(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	otc, ok := client.(sdk.OfflineTxClient)
+	requireTrue(t, ok, "Docker client should sign transactions offline")
+
+	amount := types.NewCoins(types.NewCoin("ukex", 1000))
+	unsigned, err := otc.GenerateTx(ctx, &sdk.TxRequest{
+		Module: "bank",
+		Action: "send",
+		Args:   []string{TestKey, testAddr, amount.String()},
+		Signer: TestKey,
+	})
+	requireNoError(t, err, "Failed to generate unsigned tx")
+	requireTrue(t, strings.Contains(string(unsigned), "\"signatures\":[]"), "Generated tx should be unsigned")
+
+	signed, err := otc.SignTx(ctx, unsigned, &sdk.SignOptions{Signer: TestKey})
+	requireNoError(t, err, "Failed to sign tx")
+	requireTrue(t, !strings.Contains(string(signed), "\"signatures\":[]"), "Signed tx should carry a signature")
+
+	resp, err := otc.BroadcastTx(ctx, signed, "sync")
+	requireNoError(t, err, "Failed to broadcast tx")
+	requireTxSuccess(t, resp, "Broadcast tx failed")
+	t.Logf("Broadcast tx: %s", resp.TxHash)
+}
+
+// TestBankSendSignModeAminoJSON tests that a send signed in amino-json mode
+// is accepted, and that unknown sign modes are rejected.
+func TestBankSendSignModeAminoJSON(t *testing.T) {
+	requireNoError(t, sdk.ValidateSignMode(sdk.SignModeAminoJSON), "amino-json should be a valid sign mode")
+	requireError(t, sdk.ValidateSignMode("textual"), "Unknown sign mode should be rejected")
+
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	amount := types.NewCoins(types.NewCoin("ukex", 1000))
+	resp, err := bank.New(client).Send(ctx, TestKey, testAddr, amount, &bank.SendOptions{SignMode: sdk.SignModeAminoJSON})
+	requireNoError(t, err, "Failed to send tokens with amino-json signing")
+	requireTxSuccess(t, resp, "amino-json signed send failed")
+}
@@ -0,0 +1,108 @@
+// Package integration provides integration tests for the crypto packages
+// used to verify off-chain messages.
+package integration
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/crypto/ripemd160"
+	"github.com/kiracore/sekai-cli/pkg/sdk/crypto/secp256k1"
+)
+
+// mustHex decodes a hex test vector.
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	requireNoError(t, err, "Invalid hex test vector")
+	return b
+}
+
+// TestRIPEMD160 tests the RIPEMD-160 test vectors from the algorithm's
+// reference publication.
+func TestRIPEMD160(t *testing.T) {
+	vectors := []struct {
+		input string
+		sum   string
+	}{
+		{"", "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		{"a", "0bdc9d2d256b3ee9daae347be6f4dc835a467ffe"},
+		{"abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{"message digest", "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		{"abcdefghijklmnopqrstuvwxyz", "f71c27109c692c1b56bbdceb5b9d2865b3708dbc"},
+		{"abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "12a053384a9c0c88e405a06c27dcf49ada62eb2b"},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", "b0e20b6e3116640286ed3a87a5713079b21f5189"},
+		{strings.Repeat("1234567890", 8), "9b752e45573d4b39f4dbd3323cab82bf63326bfb"},
+		{strings.Repeat("a", 1000000), "52783243c1697bdbe16d37f97f68f08325dc1528"},
+	}
+
+	for _, v := range vectors {
+		sum := ripemd160.Sum([]byte(v.input))
+		name := v.input
+		if len(name) > 20 {
+			name = name[:20] + "..."
+		}
+		requireEqual(t, v.sum, hex.EncodeToString(sum[:]), "RIPEMD-160 of "+name)
+	}
+}
+
+// TestSecp256k1Verify tests verification against deterministic (RFC 6979,
+// SHA-256, low-S) secp256k1 signatures. The vectors are the widely used
+// ones for private keys 1, n-1 and a random key.
+func TestSecp256k1Verify(t *testing.T) {
+	const (
+		pubKey1    = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+		pubKeyNeg1 = "0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+		pubKeyAlan = "0292df7b245b81aa637ab4e867c8d511008f79161a97d64f2ac709600352f7acbc"
+	)
+	vectors := []struct {
+		pub, msg, sig string
+	}{
+		{pubKey1, "Satoshi Nakamoto",
+			"934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8" +
+				"2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5"},
+		{pubKey1, "All those moments will be lost in time, like tears in rain. Time to die...",
+			"8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b" +
+				"547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21"},
+		{pubKeyNeg1, "Satoshi Nakamoto",
+			"fd567d121db66e382991534ada77a6bd3106f0a1098c231e47993447cd6af2d0" +
+				"6b39cd0eb1bc8603e159ef5c20a5c8ad685a45b06ce9bebed3f153d10d93bed5"},
+		{pubKeyAlan, "Alan Turing",
+			"7063ae83e7f62bbb171798131b4a0564b956930092b33b07b395615d9ec7e15c" +
+				"58dfcc1e00a35e1572f366ffe34ba0fc47db1e7189759b9fb233c5b05ab388ea"},
+	}
+
+	for _, v := range vectors {
+		pub, sig := mustHex(t, v.pub), mustHex(t, v.sig)
+		requireTrue(t, secp256k1.Verify(pub, []byte(v.msg), sig), "Valid signature rejected: "+v.msg)
+		requireTrue(t, !secp256k1.Verify(pub, []byte(v.msg+"."), sig), "Signature of a different message accepted: "+v.msg)
+	}
+
+	// The key of another vector must not verify
+	requireTrue(t, !secp256k1.Verify(mustHex(t, pubKeyAlan), []byte("Satoshi Nakamoto"), mustHex(t, vectors[0].sig)),
+		"Signature accepted for the wrong public key")
+
+	// The high-S twin of a valid signature (s' = n - s) is malleable and
+	// rejected, as by Cosmos SDK chains
+	highS := mustHex(t, vectors[0].sig[:64]+"dbbd3162d46e9f9bef7feb87c16dc13b4f6568a87f4e83f728e2443ba586675c")
+	requireTrue(t, !secp256k1.Verify(mustHex(t, pubKey1), []byte("Satoshi Nakamoto"), highS), "High-S signature accepted")
+
+	requireTrue(t, !secp256k1.Verify(mustHex(t, pubKey1), []byte("Satoshi Nakamoto"), mustHex(t, vectors[0].sig)[:63]),
+		"Truncated signature accepted")
+}
+
+// TestSecp256k1Address tests address derivation, RIPEMD-160(SHA-256(pub)),
+// which for the generator point is its well-known Bitcoin hash160.
+func TestSecp256k1Address(t *testing.T) {
+	addr, err := secp256k1.Address(mustHex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+	requireNoError(t, err, "Failed to derive address")
+	requireEqual(t, "751e76e8199196d454941c45d1b3a323f1433bd6", hex.EncodeToString(addr), "Address mismatch")
+
+	// x = 5 has no point on the curve
+	_, err = secp256k1.Address(mustHex(t, "02"+strings.Repeat("00", 31)+"05"))
+	requireError(t, err, "Public key off the curve should be rejected")
+
+	_, err = secp256k1.Address(mustHex(t, "04"+strings.Repeat("11", 32)))
+	requireError(t, err, "Public key with a bad prefix should be rejected")
+}
@@ -107,3 +107,23 @@ func TestCustodyCustodiansPool(t *testing.T) {
 
 	t.Logf("Custody pool for %s: %s", testAddr, string(result))
 }
+
+// TestCustodySummary tests querying all custody settings at once.
+func TestCustodySummary(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := custody.New(client)
+	result, err := mod.Summary(ctx, testAddr)
+	requireNoError(t, err, "Summary failed")
+	requireEqual(t, testAddr, result.Address, "summary address mismatch")
+	requireNotNil(t, result.Settings, "settings should never be nil")
+	requireNotNil(t, result.Limits, "limits should never be nil")
+
+	t.Logf("Custody summary for %s: unconfigured=%v, errors=%v", testAddr, result.Unconfigured, result.Errors)
+}
@@ -2,9 +2,11 @@
 package integration
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
 )
 
@@ -51,6 +53,30 @@ func TestGovProposals(t *testing.T) {
 	}
 }
 
+// TestGovProposalsByType tests filtering proposals by type and sorting them.
+func TestGovProposalsByType(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := gov.New(client)
+	result, err := mod.Proposals(ctx, &gov.ProposalQueryOpts{Type: "SetNetworkProperty"})
+	requireNoError(t, err, "Failed to query proposals by type")
+	for _, prop := range result.Proposals {
+		requireEqual(t, "SetNetworkProperty", prop.Type(), "Proposal type")
+	}
+
+	err = gov.SortProposals(result.Proposals, "id", true)
+	requireNoError(t, err, "Failed to sort proposals")
+	t.Logf("Found %d SetNetworkProperty proposals", len(result.Proposals))
+
+	err = gov.SortProposals(result.Proposals, "votes", false)
+	requireError(t, err, "Expected invalid sort key to fail")
+}
+
 // TestGovCouncilors tests querying councilors.
 func TestGovCouncilors(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -70,6 +96,45 @@ func TestGovCouncilors(t *testing.T) {
 	}
 }
 
+// TestGovCouncilorsStatus tests filtering councilors by status, whichever
+// way sekaid prints it, and counting them by status.
+func TestGovCouncilorsStatus(t *testing.T) {
+	client := mock.NewClient()
+	requireNoError(t, client.SetQueryResponse("customgov", "councilors", map[string]interface{}{
+		"councilors": []map[string]string{
+			{"address": "kira1a", "status": "COUNCILOR_ACTIVE"},
+			{"address": "kira1b", "status": "COUNCILOR_JAILED"},
+			{"address": "kira1c", "status": "active"},
+			{"address": "kira1d", "status": "COUNCILOR_PAUSED"},
+		},
+	}), "Failed to set query response")
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := gov.New(client)
+	all, err := mod.Councilors(ctx)
+	requireNoError(t, err, "Failed to query councilors")
+	counts := gov.CountCouncilors(all)
+	requireEqual(t, 2, counts["active"], "Active count mismatch")
+	requireEqual(t, 1, counts["paused"], "Paused count mismatch")
+	requireEqual(t, 1, counts["jailed"], "Jailed count mismatch")
+	requireEqual(t, 0, counts["inactive"], "Inactive count mismatch")
+
+	active, err := mod.CouncilorsByStatus(ctx, "active")
+	requireNoError(t, err, "Failed to query active councilors")
+	requireEqual(t, 2, len(active), "Active councilors mismatch")
+	requireEqual(t, "kira1c", active[1].Address, "Active councilor mismatch")
+
+	jailed, err := mod.CouncilorsByStatus(ctx, "jailed")
+	requireNoError(t, err, "Failed to query jailed councilors")
+	requireEqual(t, 1, len(jailed), "Jailed councilors mismatch")
+	requireEqual(t, "kira1b", jailed[0].Address, "Jailed councilor mismatch")
+
+	_, err = mod.CouncilorsByStatus(ctx, "retired")
+	requireError(t, err, "Unknown status should be rejected")
+}
+
 // TestGovAllRoles tests querying all roles.
 func TestGovAllRoles(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -538,6 +603,42 @@ func TestGovProposal(t *testing.T) {
 	t.Logf("Proposal %s: %s (%s)", result.ProposalID, result.Title, result.Status)
 }
 
+// TestGovProposalWaitFinal tests waiting for a proposal to reach a final result.
+func TestGovProposalWaitFinal(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := gov.New(client)
+	proposals, err := mod.Proposals(ctx, nil)
+	requireNoError(t, err, "Failed to query proposals")
+	if len(proposals.Proposals) == 0 {
+		t.Log("No proposals found, skipping")
+		return
+	}
+
+	propID := proposals.Proposals[0].ProposalID
+	changes := 0
+	result, err := mod.WaitFinal(ctx, propID, &gov.WaitOptions{
+		Timeout:      5 * time.Second,
+		PollInterval: time.Second,
+		OnChange:     func(prev, curr *gov.Proposal) { changes++ },
+	})
+	if errors.Is(err, gov.ErrNotFinal) {
+		requireNotNil(t, result, "Last seen proposal should be returned on timeout")
+		t.Logf("Proposal %s not final yet: %s", propID, result.Result)
+		return
+	}
+	requireNoError(t, err, "Failed to wait for proposal")
+	requireTrue(t, result.IsFinal(), "Proposal should be final")
+	requireTrue(t, changes > 0, "OnChange should be called for the first result")
+
+	t.Logf("Proposal %s final: %s", propID, result.Result)
+}
+
 // TestGovVote tests querying a specific vote.
 func TestGovVote(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -608,6 +709,38 @@ func TestGovVoters(t *testing.T) {
 	t.Logf("Voters for proposal %s: %v", propID, voters)
 }
 
+// TestGovProposalTally tests tallying the votes on a proposal.
+func TestGovProposalTally(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := gov.New(client)
+
+	proposals, err := mod.Proposals(ctx, nil)
+	requireNoError(t, err, "Failed to query proposals")
+
+	if len(proposals.Proposals) == 0 {
+		t.Log("No proposals found, skipping")
+		return
+	}
+
+	propID := proposals.Proposals[0].ProposalID
+	tally, err := mod.ProposalTally(ctx, propID, nil)
+	requireNoError(t, err, "Failed to tally proposal")
+	requireEqual(t, tally.TotalVotes, tally.Yes+tally.No+tally.Abstain+tally.NoWithVeto, "Vote counts should add up")
+	if tally.WouldPass {
+		requireTrue(t, tally.QuorumReached, "A passing proposal must reach quorum")
+	}
+	t.Logf("Tally for proposal %s: %+v", propID, tally)
+
+	_, err = mod.ProposalTally(ctx, propID, &gov.TallyOpts{VoteQuorum: "not-a-number"})
+	requireError(t, err, "Expected an invalid quorum to fail")
+}
+
 // TestGovProposalDuration tests querying a specific proposal duration.
 func TestGovProposalDuration(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -1849,3 +1982,47 @@ func TestGovProposalRemoveBlacklistedRolePermission(t *testing.T) {
 
 	t.Logf("Proposal remove blacklisted role permission TX: hash=%s, code=%d", resp.TxHash, resp.Code)
 }
+
+// TestGovParseProposalFile tests validation of generic proposal files.
+func TestGovParseProposalFile(t *testing.T) {
+	valid := `{"title": "Set quorum", "content": {"@type": "/kira.gov.SetNetworkPropertyProposal", "network_property": "VOTE_QUORUM", "value": {"value": "34"}}}`
+	proposal, err := gov.ParseProposalFile([]byte(valid))
+	requireNoError(t, err, "Valid proposal rejected")
+	requireEqual(t, "/kira.gov.SetNetworkPropertyProposal", proposal.ContentType(), "Content type mismatch")
+
+	invalid := map[string]string{
+		"malformed":     `{"title": "x", "content": `,
+		"no title":      `{"content": {"@type": "/kira.gov.SetNetworkPropertyProposal"}}`,
+		"no content":    `{"title": "x"}`,
+		"no type":       `{"title": "x", "content": {"network_property": "VOTE_QUORUM"}}`,
+		"unknown field": `{"title": "x", "contents": {"@type": "/kira.gov.SetNetworkPropertyProposal"}}`,
+	}
+	for name, data := range invalid {
+		_, err := gov.ParseProposalFile([]byte(data))
+		requireError(t, err, "Expected error for proposal with "+name)
+	}
+}
+
+// TestGovSubmitProposalFile tests submitting a proposal from a JSON file.
+func TestGovSubmitProposalFile(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	proposal, err := gov.ParseProposalFile([]byte(`{
+		"title": "Generic proposal test",
+		"description": "Submitted from a proposal file",
+		"content": {"@type": "/kira.gov.SetNetworkPropertyProposal", "network_property": "VOTE_QUORUM", "value": {"value": "34"}}
+	}`))
+	requireNoError(t, err, "Failed to parse proposal")
+
+	mod := gov.New(client)
+	resp, err := mod.SubmitProposal(ctx, TestKey, proposal, &gov.TxOptions{Fees: TestFees})
+	requireNoError(t, err, "Failed to submit proposal")
+	requireTxSuccess(t, resp, "Proposal submission failed")
+
+	t.Logf("Submitted proposal file: txhash=%s", resp.TxHash)
+}
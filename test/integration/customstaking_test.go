@@ -117,6 +117,33 @@ func TestStakingValidatorByMoniker(t *testing.T) {
 	t.Logf("Validator with moniker %s: address=%s, status=%s", moniker, result.Address, result.Status)
 }
 
+// TestStakingMonikers tests mapping validator addresses to monikers.
+func TestStakingMonikers(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	validators, err := getValidatorsWithRetry(t, client, nil)
+	requireNoError(t, err, "Failed to query validators")
+	if validators == nil || len(validators.Validators) == 0 || validators.Validators[0].Moniker == "" {
+		t.Skip("No validator with moniker found")
+		return
+	}
+	val := validators.Validators[0]
+
+	monikers, err := staking.New(client).Monikers(ctx)
+	requireNoError(t, err, "Failed to query monikers")
+	requireEqual(t, val.Moniker, monikers[val.GetValKey()], "Moniker of the operator address")
+	requireEqual(t, val.Moniker, monikers[val.Address], "Moniker of the account address")
+
+	consAddr, err := val.ConsAddress()
+	requireNoError(t, err, "Failed to derive consensus address")
+	requireEqual(t, val.Moniker, monikers[consAddr], "Moniker of the consensus address")
+}
+
 // TestStakingClaimValidatorSeat tests claiming a validator seat.
 // Note: This test verifies the SDK TX submission works, but the TX will fail
 // because the genesis account is already a validator.
@@ -96,3 +96,26 @@ func TestDistributorSnapshotPeriodPerformance(t *testing.T) {
 
 	t.Logf("Snapshot period performance: %s", string(result))
 }
+
+// TestDistributorAllSnapshotPerformance tests querying performance for all validators.
+func TestDistributorAllSnapshotPerformance(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := distributor.New(client)
+	perfs, err := mod.AllSnapshotPerformance(ctx)
+	if err != nil {
+		// The snapshot may be empty right after genesis
+		t.Logf("All snapshot performance query: %v (may be expected on a fresh network)", err)
+		return
+	}
+
+	requireNoError(t, distributor.SortPerformance(perfs, "performance", true), "Failed to sort performance")
+	for _, p := range perfs {
+		t.Logf("  %s (%s): performance=%s error=%s", p.Moniker, p.Validator, p.Performance, p.Error)
+	}
+}
@@ -0,0 +1,25 @@
+// Package integration provides integration tests for the ethereum module.
+package integration
+
+import (
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/ethereum"
+)
+
+// TestEthereumState tests querying the ethereum module state.
+func TestEthereumState(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := ethereum.New(client)
+	result, err := mod.State(ctx)
+	requireNoError(t, err, "Failed to query ethereum state")
+	requireNotNil(t, result, "Ethereum state is nil")
+
+	t.Logf("Ethereum state: %s", string(result))
+}
@@ -0,0 +1,52 @@
+// Package integration provides integration tests for the evidence module.
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/evidence"
+)
+
+// TestEvidenceAll tests listing submitted evidence, which is usually empty
+// on a test network.
+func TestEvidenceAll(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := evidence.New(client)
+	result, err := mod.AllEvidencePage(ctx, &sdk.Pagination{Limit: 10})
+	requireNoError(t, err, "Failed to query evidence")
+	requireNotNil(t, result, "Evidence is nil")
+
+	t.Logf("Evidence: %s", string(result))
+}
+
+// TestEvidenceNotFound tests that an unknown hash is reported as not found.
+func TestEvidenceNotFound(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := evidence.New(client)
+	_, err := mod.Evidence(ctx, strings.Repeat("ab", 32))
+	requireError(t, err, "Unknown evidence should fail")
+	requireTrue(t, sdk.IsNotFound(err), "Error should be not found: "+err.Error())
+}
+
+// TestEvidenceInvalidHash tests that a hash that is not hex is rejected
+// before querying.
+func TestEvidenceInvalidHash(t *testing.T) {
+	mod := evidence.New(nil)
+	_, err := mod.Evidence(context.Background(), "not-a-hash")
+	requireError(t, err, "Invalid hash should fail")
+}
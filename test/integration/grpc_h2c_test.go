@@ -0,0 +1,47 @@
+//go:build go1.24
+
+package integration
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/grpc"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
+)
+
+// TestGRPCPlaintext tests querying a plaintext host:port target, as sekaid
+// serves gRPC by default, over unencrypted HTTP/2.
+func TestGRPCPlaintext(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 || r.URL.Path != "/kira.gov.Query/NetworkProperties" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		io.ReadAll(r.Body)
+		msg := pbBytes(1, pbVarint(1, 100))
+		frame := make([]byte, 5)
+		binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Write(append(frame, msg...))
+		w.Header().Set("Grpc-Status", "0")
+	}))
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	defer srv.Close()
+
+	client, err := grpc.NewClient(strings.TrimPrefix(srv.URL, "http://"))
+	requireNoError(t, err, "Plaintext target should be supported")
+	defer client.Close()
+
+	props, err := gov.New(client).NetworkProperties(context.Background())
+	requireNoError(t, err, "Failed to query network properties over plaintext")
+	requireEqual(t, "100", props.MinTxFee, "Min fee mismatch")
+}
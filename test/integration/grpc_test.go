@@ -0,0 +1,200 @@
+// Package integration provides integration tests for the gRPC client.
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/grpc"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/auth"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/gov"
+)
+
+// pbVarint encodes protobuf field num as a varint.
+func pbVarint(num int, v uint64) []byte {
+	b := binary.AppendUvarint(nil, uint64(num)<<3)
+	return binary.AppendUvarint(b, v)
+}
+
+// pbBytes encodes protobuf field num as length-delimited bytes.
+func pbBytes(num int, v []byte) []byte {
+	b := binary.AppendUvarint(nil, uint64(num)<<3|2)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+// pbCoin encodes a cosmos.base.v1beta1.Coin.
+func pbCoin(denom, amount string) []byte {
+	return append(pbBytes(1, []byte(denom)), pbBytes(2, []byte(amount))...)
+}
+
+// grpcReply is the response of a fake gRPC method: a message, or a
+// non-zero status with a message.
+type grpcReply struct {
+	msg     []byte
+	status  int
+	message string
+}
+
+// newGRPCServer starts an HTTP/2 TLS server answering unary gRPC calls.
+// Unknown methods return Unimplemented. The requests are recorded by
+// method.
+func newGRPCServer(t *testing.T, methods map[string]func(req []byte) grpcReply) (*grpc.Client, map[string][]byte) {
+	t.Helper()
+	requests := map[string][]byte{}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 || r.Header.Get("Content-Type") != "application/grpc+proto" || r.Header.Get("TE") != "trailers" {
+			http.Error(w, "not a gRPC request", http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if len(body) < 5 || int(binary.BigEndian.Uint32(body[1:5])) != len(body)-5 {
+			http.Error(w, "bad frame", http.StatusBadRequest)
+			return
+		}
+		requests[r.URL.Path] = body[5:]
+
+		reply := grpcReply{status: 12, message: "unknown method " + r.URL.Path}
+		if handle, ok := methods[r.URL.Path]; ok {
+			reply = handle(body[5:])
+		}
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+		w.WriteHeader(http.StatusOK)
+		if reply.status == 0 {
+			frame := make([]byte, 5)
+			binary.BigEndian.PutUint32(frame[1:], uint32(len(reply.msg)))
+			w.Write(append(frame, reply.msg...))
+		}
+		w.Header().Set("Grpc-Status", strconv.Itoa(reply.status))
+		w.Header().Set("Grpc-Message", strings.ReplaceAll(reply.message, " ", "%20"))
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	client, err := grpc.NewClient(srv.URL, grpc.WithTLS(srv.Client().Transport.(*http.Transport).TLSClientConfig))
+	requireNoError(t, err, "Failed to create gRPC client")
+	t.Cleanup(func() { client.Close() })
+	return client, requests
+}
+
+// TestGRPCQueries tests that bank, auth and network property queries are
+// answered over gRPC in the JSON shape the modules parse.
+func TestGRPCQueries(t *testing.T) {
+	const addr = "kira1w508d6qejxtdg4y5r3zarvary0c5xw7k2ja5w4"
+	client, requests := newGRPCServer(t, map[string]func([]byte) grpcReply{
+		"/cosmos.bank.v1beta1.Query/AllBalances": func([]byte) grpcReply {
+			return grpcReply{msg: bytes.Join([][]byte{
+				pbBytes(1, pbCoin("ukex", "1000")),
+				pbBytes(1, pbCoin("lol", "7")),
+				pbBytes(2, pbVarint(2, 2)),
+			}, nil)}
+		},
+		"/cosmos.bank.v1beta1.Query/Balance": func([]byte) grpcReply {
+			return grpcReply{msg: pbBytes(1, pbCoin("lol", "7"))}
+		},
+		"/cosmos.auth.v1beta1.Query/Account": func([]byte) grpcReply {
+			pubKey := append(pbBytes(1, []byte("/cosmos.crypto.secp256k1.PubKey")), pbBytes(2, pbBytes(1, []byte{2, 1, 2}))...)
+			account := bytes.Join([][]byte{pbBytes(1, []byte(addr)), pbBytes(2, pubKey), pbVarint(3, 3), pbVarint(4, 5)}, nil)
+			return grpcReply{msg: pbBytes(1, append(pbBytes(1, []byte("/cosmos.auth.v1beta1.BaseAccount")), pbBytes(2, account)...))}
+		},
+		"/kira.gov.Query/NetworkProperties": func([]byte) grpcReply {
+			return grpcReply{msg: pbBytes(1, bytes.Join([][]byte{pbVarint(1, 100), pbVarint(2, 1000000), pbVarint(3, 33), pbVarint(8, 1)}, nil))}
+		},
+	})
+	ctx := context.Background()
+
+	coins, err := bank.New(client).Balances(ctx, addr)
+	requireNoError(t, err, "Failed to query balances")
+	requireEqual(t, "1000ukex,7lol", coins.String(), "Balances mismatch")
+	requireEqual(t, string(pbBytes(1, []byte(addr))), string(requests["/cosmos.bank.v1beta1.Query/AllBalances"]), "AllBalances request mismatch")
+
+	coin, err := bank.New(client).Balance(ctx, addr, "lol")
+	requireNoError(t, err, "Failed to query balance")
+	requireEqual(t, "7lol", coin.String(), "Balance mismatch")
+
+	acc, err := auth.New(client).Account(ctx, addr)
+	requireNoError(t, err, "Failed to query account")
+	requireEqual(t, addr, acc.Address, "Account address mismatch")
+	requireEqual(t, "3", acc.AccountNumber, "Account number mismatch")
+	requireEqual(t, "5", acc.Sequence, "Sequence mismatch")
+
+	props, err := gov.New(client).NetworkProperties(ctx)
+	requireNoError(t, err, "Failed to query network properties")
+	requireEqual(t, "100", props.MinTxFee, "Min fee mismatch")
+	requireEqual(t, "1000000", props.MaxTxFee, "Max fee mismatch")
+	requireEqual(t, "33", props.VoteQuorum, "Vote quorum mismatch")
+	requireTrue(t, props.EnableForeignFeePayments, "Foreign fee payments should be enabled")
+}
+
+// TestGRPCBroadcast tests broadcasting encoded transaction bytes.
+func TestGRPCBroadcast(t *testing.T) {
+	txBytes := []byte{0x0a, 0x02, 0x01, 0x02}
+	code := uint64(0)
+	client, requests := newGRPCServer(t, map[string]func([]byte) grpcReply{
+		"/cosmos.tx.v1beta1.Service/BroadcastTx": func([]byte) grpcReply {
+			resp := bytes.Join([][]byte{pbBytes(2, []byte("ABCDEF")), pbVarint(4, code), pbBytes(6, []byte("insufficient fees")), pbVarint(9, 200000)}, nil)
+			return grpcReply{msg: pbBytes(1, resp)}
+		},
+	})
+	ctx := context.Background()
+
+	resp, err := client.BroadcastTx(ctx, []byte(base64.StdEncoding.EncodeToString(txBytes)), "sync")
+	requireNoError(t, err, "Failed to broadcast")
+	requireEqual(t, "ABCDEF", resp.TxHash, "Tx hash mismatch")
+	requireEqual(t, int64(200000), resp.GasWanted, "Gas wanted mismatch")
+	requireEqual(t, string(append(pbBytes(1, txBytes), pbVarint(2, 2)...)), string(requests["/cosmos.tx.v1beta1.Service/BroadcastTx"]), "Broadcast request mismatch")
+
+	code = 13
+	resp, err = client.BroadcastTx(ctx, []byte(base64.StdEncoding.EncodeToString(txBytes)), "async")
+	requireError(t, err, "Rejected transaction should fail")
+	requireEqual(t, uint32(13), resp.Code, "Code mismatch")
+
+	_, err = client.BroadcastTx(ctx, []byte(`{"body":{}}`), "sync")
+	requireError(t, err, "Signed JSON should be rejected")
+}
+
+// TestGRPCUnsupported tests that operations without a gRPC mapping, and
+// methods the node does not implement, fail with sdk.ErrNotSupported, and
+// that node errors carry their status message.
+func TestGRPCUnsupported(t *testing.T) {
+	client, _ := newGRPCServer(t, map[string]func([]byte) grpcReply{
+		"/cosmos.auth.v1beta1.Query/Account": func([]byte) grpcReply {
+			return grpcReply{status: 5, message: "account not found"}
+		},
+	})
+	ctx := context.Background()
+
+	_, err := client.Query(ctx, &sdk.QueryRequest{Module: "customstaking", Endpoint: "validators"})
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Unmapped query should be unsupported")
+	requireTrue(t, strings.Contains(err.Error(), "unsupported over grpc"), "Error should say unsupported over grpc: "+err.Error())
+
+	_, err = client.Tx(ctx, &sdk.TxRequest{Module: "bank", Action: "send"})
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Tx should be unsupported")
+	_, err = client.Keys().List(ctx)
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Keys should be unsupported")
+
+	// The fake node implements no bank methods
+	_, err = bank.New(client).TotalSupply(ctx)
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Unimplemented method should be unsupported")
+
+	_, err = auth.New(client).Account(ctx, "kira1missing")
+	requireError(t, err, "Missing account should fail")
+	requireTrue(t, !errors.Is(err, sdk.ErrNotSupported), "Node error is not unsupported")
+	requireTrue(t, strings.Contains(err.Error(), "account not found"), "Error should carry the status message: "+err.Error())
+
+	_, err = grpc.NewClient("ftp://node:9090")
+	requireError(t, err, "Invalid scheme should be rejected")
+}
@@ -2,10 +2,17 @@
 package integration
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/keys"
 )
 
@@ -128,6 +135,54 @@ func TestKeysAddWithOptions(t *testing.T) {
 	requireNoError(t, err, "Failed to delete key")
 }
 
+// TestKeysAddMultisig tests creating a multisig key from existing keys.
+func TestKeysAddMultisig(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := keys.New(client)
+	keyA := generateUniqueID("msa")
+	keyB := generateUniqueID("msb")
+	multiName := generateUniqueID("multi")
+
+	for _, name := range []string{keyA, keyB} {
+		_, err := mod.Add(ctx, name, &sdk.KeyAddOptions{NoBackup: true})
+		requireNoError(t, err, "Failed to add member key")
+		defer mod.Delete(ctx, name, true)
+	}
+
+	// Threshold above the number of keys is rejected
+	_, err := mod.Add(ctx, multiName, &sdk.KeyAddOptions{
+		Multisig:          []string{keyA, keyB},
+		MultisigThreshold: 3,
+	})
+	requireError(t, err, "Threshold above key count should fail")
+
+	// Unknown member keys are rejected
+	_, err = mod.Add(ctx, multiName, &sdk.KeyAddOptions{
+		Multisig:          []string{keyA, generateUniqueID("missing")},
+		MultisigThreshold: 1,
+	})
+	requireError(t, err, "Unknown member key should fail")
+
+	result, err := mod.Add(ctx, multiName, &sdk.KeyAddOptions{
+		Multisig:          []string{keyA, keyB},
+		MultisigThreshold: 2,
+	})
+	requireNoError(t, err, "Failed to add multisig key")
+	requireNotNil(t, result, "Key info is nil")
+	requireEqual(t, multiName, result.Name, "Key name mismatch")
+
+	t.Logf("Created multisig key: %s -> %s (%s)", result.Name, result.Address, result.Type)
+
+	err = mod.Delete(ctx, multiName, true)
+	requireNoError(t, err, "Failed to delete multisig key")
+}
+
 // TestKeysRename tests renaming a key.
 func TestKeysRename(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -215,6 +270,71 @@ func TestKeysExportImport(t *testing.T) {
 	_ = mod.Delete(ctx, importedName, true)
 }
 
+// TestKeysExportImportWithPassphrase tests exporting a key as passphrase
+// encrypted armor and importing it again.
+func TestKeysExportImportWithPassphrase(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := keys.New(client)
+	originalName := generateUniqueID("exportarmor")
+	importedName := generateUniqueID("importarmor")
+
+	result, err := mod.Add(ctx, originalName, nil)
+	requireNoError(t, err, "Failed to add key")
+
+	passphrase := "export-test-passphrase"
+	armor, err := mod.ExportWithPassphrase(ctx, originalName, passphrase)
+	requireNoError(t, err, "Failed to export key")
+	requireTrue(t, strings.HasPrefix(armor, "-----BEGIN"), "Exported key should be armored")
+
+	err = mod.ImportWithPassphrase(ctx, importedName, armor, passphrase)
+	requireNoError(t, err, "Failed to import key")
+
+	importedKey, err := mod.Show(ctx, importedName)
+	requireNoError(t, err, "Failed to show imported key")
+	requireEqual(t, result.Address, importedKey.Address, "Imported key should have same address")
+
+	_ = mod.Delete(ctx, originalName, true)
+	_ = mod.Delete(ctx, importedName, true)
+}
+
+// TestKeysExportHex tests exporting a private key as unarmored hex and
+// importing it again.
+func TestKeysExportHex(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := keys.New(client)
+	originalName := generateUniqueID("exporthex")
+	importedName := generateUniqueID("importhex")
+
+	result, err := mod.Add(ctx, originalName, nil)
+	requireNoError(t, err, "Failed to add key")
+
+	hexKey, err := mod.ExportHex(ctx, originalName)
+	requireNoError(t, err, "Failed to export key as hex")
+	requireEqual(t, 64, len(hexKey), "Hex private key length")
+
+	err = mod.ImportHex(ctx, importedName, hexKey, "")
+	requireNoError(t, err, "Failed to import hex key")
+
+	importedKey, err := mod.Show(ctx, importedName)
+	requireNoError(t, err, "Failed to show imported key")
+	requireEqual(t, result.Address, importedKey.Address, "Imported key should have same address")
+
+	_ = mod.Delete(ctx, originalName, true)
+	_ = mod.Delete(ctx, importedName, true)
+}
+
 // TestKeysGetAddress tests getting address for a key.
 func TestKeysGetAddress(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -233,6 +353,39 @@ func TestKeysGetAddress(t *testing.T) {
 	t.Logf("Key %s has address %s", TestKey, address)
 }
 
+// TestKeysResolver tests resolving key names, address book aliases and
+// addresses.
+func TestKeysResolver(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	const bookAddr = "kira1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"
+	resolver := keys.NewResolver(client, map[string]string{
+		"treasury": bookAddr,
+		TestKey:    bookAddr,
+	})
+
+	address, err := resolver.Resolve(ctx, TestKey)
+	requireNoError(t, err, "Failed to resolve key name")
+	requireEqual(t, testAddr, address, "Key name should take precedence over the address book")
+
+	address, err = resolver.Resolve(ctx, "treasury")
+	requireNoError(t, err, "Failed to resolve address book alias")
+	requireEqual(t, bookAddr, address, "Alias address mismatch")
+
+	address, err = resolver.Resolve(ctx, testAddr)
+	requireNoError(t, err, "Failed to resolve address")
+	requireEqual(t, testAddr, address, "Address should be returned as is")
+
+	_, err = resolver.Resolve(ctx, "nonexistent_key_12345")
+	requireError(t, err, "Unknown name should fail to resolve")
+}
+
 // TestKeysExists tests checking if a key exists.
 func TestKeysExists(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -418,3 +571,288 @@ func TestKeysParse(t *testing.T) {
 		t.Logf("Parsed hex back: Human=%s, Bytes=%s", result2.Human, result2.Bytes)
 	}
 }
+
+// TestKeysSignVerifyMessage tests signing and verifying an off-chain message.
+func TestKeysSignVerifyMessage(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := keys.New(client)
+	signed, err := mod.SignMessage(ctx, TestKey, "hello sekai")
+	requireNoError(t, err, "Failed to sign message")
+	requireNotNil(t, signed, "Signed message is nil")
+	requireEqual(t, testAddr, signed.Signer, "Signer mismatch")
+	requireTrue(t, signed.Signature != "", "Signature should not be empty")
+
+	t.Logf("Signature: %s", signed.Signature)
+
+	valid, err := mod.VerifyMessage(ctx, testAddr, "hello sekai", signed.Signature)
+	requireNoError(t, err, "Failed to verify message")
+	requireTrue(t, valid, "Signature should be valid")
+
+	valid, err = keys.VerifyMessageWithPubKey(testAddr, "tampered", signed.Signature, signed.PubKey)
+	requireNoError(t, err, "Failed to verify tampered message")
+	requireTrue(t, !valid, "Signature of a different message should not be valid")
+}
+
+// messageSignExecutor fakes sekaid for message signing: it shows a key
+// with private key 1 and answers tx sign with a signature made with it.
+type messageSignExecutor struct {
+	signature string
+	commands  [][]string
+}
+
+func (e *messageSignExecutor) Exec(ctx context.Context, binary string, args ...string) (*docker.ExecResult, error) {
+	return e.ExecWithInput(ctx, binary, "", args...)
+}
+
+func (e *messageSignExecutor) ExecWithInput(ctx context.Context, binary, input string, args ...string) (*docker.ExecResult, error) {
+	e.commands = append(e.commands, args)
+	const pubKey = "Anm+Zn753LusVaBilc6HCwcCm/zbLc4o2VnygVsW+BeY"
+	if args[0] == "keys" && args[1] == "show" {
+		return &docker.ExecResult{Stdout: `{"name":"alice","type":"local","address":"kira1w508d6qejxtdg4y5r3zarvary0c5xw7k2ja5w4",` +
+			`"pubkey":"{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\",\"key\":\"` + pubKey + `\"}"}`}, nil
+	}
+	return &docker.ExecResult{Stdout: `{"body":{},"auth_info":{"signer_infos":[{"public_key":` +
+		`{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"` + pubKey + `"}}]},"signatures":["` + e.signature + `"]}`}, nil
+}
+
+// TestKeysSignMessageInKeyring tests that messages are signed by sekaid
+// offline, without exporting the key, and that a signature over anything
+// but the ADR-036 sign document is rejected.
+func TestKeysSignMessageInKeyring(t *testing.T) {
+	const signature = "iSJLk9hleIQJIYWRW7+Bo0sZRpa82rYckoHdYjDEncdfKCl4YHX5L24OjRgSYbOz0IE/3SB7mIYJkgsYFBUenA=="
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	executor := &messageSignExecutor{signature: signature}
+	client, err := docker.NewClient("sekai", docker.WithExecutor(executor))
+	requireNoError(t, err, "Failed to create client")
+
+	mod := keys.New(client)
+	signed, err := mod.SignMessage(ctx, "alice", "hello sekai")
+	requireNoError(t, err, "Failed to sign message")
+	requireEqual(t, signature, signed.Signature, "Signature mismatch")
+	requireEqual(t, "kira1w508d6qejxtdg4y5r3zarvary0c5xw7k2ja5w4", signed.Signer, "Signer mismatch")
+
+	valid, err := keys.VerifyMessageWithPubKey(signed.Signer, "hello sekai", signed.Signature, signed.PubKey)
+	requireNoError(t, err, "Failed to verify message")
+	requireTrue(t, valid, "Signature should be valid")
+
+	for _, args := range executor.commands {
+		requireTrue(t, !containsString(args, "export"), "Key was exported: "+strings.Join(args, " "))
+	}
+	sign := executor.commands[len(executor.commands)-1]
+	requireTrue(t, containsString(sign, "sign") && containsString(sign, "--offline"), "Message should be signed with tx sign --offline")
+
+	_, err = mod.SignMessage(ctx, "alice", "another message")
+	requireError(t, err, "Signature over a different document should be rejected")
+}
+
+// coreKeysClient exposes only the methods of sdk.KeysClient, like a
+// keyring that implements none of the optional interfaces.
+type coreKeysClient struct {
+	sdk.KeysClient
+}
+
+// coreKeysSDKClient is a client whose keyring is a coreKeysClient.
+type coreKeysSDKClient struct {
+	sdk.Client
+}
+
+func (c coreKeysSDKClient) Keys() sdk.KeysClient {
+	return coreKeysClient{KeysClient: c.Client.Keys()}
+}
+
+// TestKeysSignMessageUnsupported tests that signing a message fails with
+// sdk.ErrNotSupported on a keyring that cannot sign messages.
+func TestKeysSignMessageUnsupported(t *testing.T) {
+	mod := keys.New(coreKeysSDKClient{Client: mock.NewClient()})
+	_, err := mod.SignMessage(context.Background(), "alice", "hello sekai")
+	requireError(t, err, "Signing should fail without a message signer")
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Error should be not supported: "+err.Error())
+}
+
+// TestKeysExportUnsupported tests that the passphrase and hex exports and
+// imports fail with sdk.ErrNotSupported on a keyring that cannot do them.
+func TestKeysExportUnsupported(t *testing.T) {
+	ctx := context.Background()
+	mod := keys.New(coreKeysSDKClient{Client: mock.NewClient()})
+
+	_, err := mod.ExportWithPassphrase(ctx, "alice", "secret123")
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Passphrase export should be not supported")
+	_, err = mod.ExportHex(ctx, "alice")
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Hex export should be not supported")
+	err = mod.ImportWithPassphrase(ctx, "bob", "armor", "secret123")
+	requireTrue(t, errors.Is(err, sdk.ErrNotSupported), "Passphrase import should be not supported")
+}
+
+// TestKeysConvertAddress tests converting addresses between the kira,
+// kiravaloper and kiravalcons prefixes offline.
+func TestKeysConvertAddress(t *testing.T) {
+	const (
+		accAddr = "kira1xqcnyve5x5mrwwpexqcnyve5x5mrwwpecmpe6q"
+		valAddr = "kiravaloper1xqcnyve5x5mrwwpexqcnyve5x5mrwwpetaa6zv"
+	)
+
+	address, err := keys.ConvertAddress(accAddr, "kiravaloper")
+	requireNoError(t, err, "Failed to convert account address")
+	requireEqual(t, valAddr, address, "Validator address mismatch")
+
+	related, err := keys.ConvertAddressAll(strings.ToUpper(valAddr))
+	requireNoError(t, err, "Failed to convert upper-case validator address")
+	requireEqual(t, accAddr, related.Account, "Account address mismatch")
+
+	_, err = keys.ConvertAddress("Kira1xqcnyve5x5mrwwpexqcnyve5x5mrwwpecmpe6q", "kiravaloper")
+	requireError(t, err, "Mixed-case address should be rejected")
+
+	_, err = keys.ConvertAddress(accAddr[:len(accAddr)-1]+"p", "kiravaloper")
+	requireError(t, err, "Address with a bad checksum should be rejected")
+
+	_, err = keys.ConvertAddress(accAddr, "cosmos")
+	requireError(t, err, "Unsupported prefix should be rejected")
+}
+
+// keyExecutor fakes sekaid for hex key export and import: export prints
+// the key and import fails, so that the failure is traced.
+type keyExecutor struct {
+	hexKey string
+}
+
+func (e *keyExecutor) Exec(ctx context.Context, binary string, args ...string) (*docker.ExecResult, error) {
+	return e.ExecWithInput(ctx, binary, "", args...)
+}
+
+func (e *keyExecutor) ExecWithInput(ctx context.Context, binary, input string, args ...string) (*docker.ExecResult, error) {
+	if containsString(args, "import-hex") {
+		result := &docker.ExecResult{ExitCode: 1, Stderr: "Error: key already exists"}
+		return result, &sdk.ExecutionError{Command: binary, Args: args, ExitCode: 1, Stderr: result.Stderr, Err: errors.New("exit status 1")}
+	}
+	return &docker.ExecResult{Stdout: e.hexKey}, nil
+}
+
+// logBuffer collects debug output.
+type logBuffer struct {
+	strings.Builder
+}
+
+func (b *logBuffer) Printf(format string, v ...interface{}) {
+	fmt.Fprintf(&b.Builder, format+"\n", v...)
+}
+
+// TestKeysHexKeyNotLogged tests that hex private keys never appear in
+// debug output or traces of key export and import.
+func TestKeysHexKeyNotLogged(t *testing.T) {
+	const hexKey = "2a8b1b7b4f0e6c3f9d5a1e7c0b4d8f2e6a9c3b5d7f1e0a2c4b6d8e0f1a3c5e7d"
+
+	logger := &logBuffer{}
+	traceDir := t.TempDir()
+	client, err := docker.NewClient("sekai",
+		docker.WithExecutor(&keyExecutor{hexKey: hexKey}),
+		docker.WithLogger(logger),
+		docker.WithTraceDir(traceDir),
+	)
+	requireNoError(t, err, "Failed to create client")
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := keys.New(client)
+	exported, err := mod.ExportHex(ctx, "secret")
+	requireNoError(t, err, "Failed to export key as hex")
+	requireEqual(t, hexKey, exported, "Exported key mismatch")
+
+	err = mod.ImportHex(ctx, "secret2", hexKey, "")
+	requireError(t, err, "Import should fail")
+
+	output := logger.String()
+	requireTrue(t, strings.Contains(output, "import-hex"), "Import should be logged")
+	requireTrue(t, !strings.Contains(output, hexKey), "Hex key leaked into debug output:\n"+output)
+
+	traces, err := filepath.Glob(filepath.Join(traceDir, "*.log"))
+	requireNoError(t, err, "Failed to list traces")
+	requireEqual(t, 1, len(traces), "Failed import should be traced")
+	trace, err := os.ReadFile(traces[0])
+	requireNoError(t, err, "Failed to read trace")
+	requireTrue(t, !strings.Contains(string(trace), hexKey), "Hex key leaked into trace:\n"+string(trace))
+}
+
+// keyringExecutor fakes sekaid and records every command with its stdin.
+type keyringExecutor struct {
+	binaries []string
+	inputs   []string
+	commands [][]string
+}
+
+func (e *keyringExecutor) Exec(ctx context.Context, binary string, args ...string) (*docker.ExecResult, error) {
+	return e.ExecWithInput(ctx, binary, "", args...)
+}
+
+func (e *keyringExecutor) ExecWithInput(ctx context.Context, binary, input string, args ...string) (*docker.ExecResult, error) {
+	e.binaries = append(e.binaries, binary)
+	e.inputs = append(e.inputs, input)
+	e.commands = append(e.commands, args)
+	if containsString(args, "list") {
+		return &docker.ExecResult{Stdout: "[]"}, nil
+	}
+	return &docker.ExecResult{Stdout: `{"body":{},"auth_info":{},"signatures":["c2ln"]}`}, nil
+}
+
+// TestKeysFileKeyringPassphrase tests that the keyring directory is passed
+// to every keyring command, and that the file keyring passphrase goes to
+// stdin, leaving the transaction of tx sign in a file, and is never
+// logged.
+func TestKeysFileKeyringPassphrase(t *testing.T) {
+	const passphrase = "correct horse battery staple"
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	executor := &keyringExecutor{}
+	logger := &logBuffer{}
+	client, err := docker.NewClient("sekai",
+		docker.WithExecutor(executor),
+		docker.WithKeyringBackend("file"),
+		docker.WithKeyringDir("/keys"),
+		docker.WithKeyringPassphrase(passphrase),
+		docker.WithLogger(logger),
+	)
+	requireNoError(t, err, "Failed to create client")
+
+	_, err = keys.New(client).List(ctx)
+	requireNoError(t, err, "Failed to list keys")
+	requireTrue(t, containsString(executor.commands[0], "--keyring-dir") && containsString(executor.commands[0], "/keys"),
+		"Keyring dir not passed: "+strings.Join(executor.commands[0], " "))
+	requireEqual(t, passphrase+"\n"+passphrase+"\n", executor.inputs[0], "Passphrase should be on stdin")
+
+	tx := `{"body":{"messages":[]},"auth_info":{},"signatures":[]}`
+	_, err = client.SignTx(ctx, []byte(tx), &sdk.SignOptions{Signer: "alice", AccountNumber: "1", Sequence: "2"})
+	requireNoError(t, err, "Failed to sign")
+	requireEqual(t, "sh", executor.binaries[1], "Signing should go through a temporary file")
+	requireTrue(t, containsString(executor.commands[1], tx), "Transaction should be passed as an argument")
+	requireTrue(t, !containsString(executor.commands[1], "/dev/stdin"), "Transaction should not be read from stdin")
+	requireEqual(t, passphrase+"\n"+passphrase+"\n", executor.inputs[1], "Passphrase should be on stdin")
+
+	for _, args := range executor.commands {
+		requireTrue(t, !containsString(args, passphrase), "Passphrase passed as an argument")
+	}
+	requireTrue(t, !strings.Contains(logger.String(), passphrase), "Passphrase leaked into debug output:\n"+logger.String())
+
+	// Other backends never prompt, so nothing is written to stdin
+	executor = &keyringExecutor{}
+	client, err = docker.NewClient("sekai",
+		docker.WithExecutor(executor),
+		docker.WithKeyringPassphrase(passphrase),
+	)
+	requireNoError(t, err, "Failed to create client")
+	_, err = keys.New(client).List(ctx)
+	requireNoError(t, err, "Failed to list keys")
+	requireEqual(t, "", executor.inputs[0], "Test backend should get no stdin")
+	requireTrue(t, !containsString(executor.commands[0], "--keyring-dir"), "Keyring dir should not be passed by default")
+}
@@ -4,6 +4,7 @@ package integration
 import (
 	"testing"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/layer2"
 )
 
@@ -23,6 +24,22 @@ func TestLayer2AllDapps(t *testing.T) {
 	t.Logf("All dapps: %s", string(result))
 }
 
+// TestLayer2DappNotFound tests that an unregistered dapp is reported as
+// not found.
+func TestLayer2DappNotFound(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := layer2.New(client)
+	_, err := mod.Dapp(ctx, "nonexistent_dapp_12345")
+	requireError(t, err, "Unregistered dapp should fail")
+	requireTrue(t, sdk.IsNotFound(err), "Error should be not found, got: "+err.Error())
+}
+
 // TestLayer2TransferDapps tests querying transfer dapps.
 func TestLayer2TransferDapps(t *testing.T) {
 	skipIfContainerNotRunning(t)
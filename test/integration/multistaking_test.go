@@ -4,6 +4,9 @@ package integration
 import (
 	"testing"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/keys"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/multistaking"
 )
 
@@ -27,6 +30,36 @@ func TestMultistakingPools(t *testing.T) {
 	}
 }
 
+// TestMultistakingPoolsPage tests that pages of pools are cut in pool ID
+// order, with the next pool ID as the page key.
+func TestMultistakingPoolsPage(t *testing.T) {
+	client := mock.NewClient()
+	requireNoError(t, client.SetQueryResponse("multistaking", "pools", map[string]interface{}{
+		"pools": []map[string]string{{"id": "10"}, {"id": "2"}, {"id": "1"}},
+	}), "Failed to set query response")
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := multistaking.New(client)
+	first, err := mod.PoolsPage(ctx, &sdk.Pagination{Limit: 2, CountTotal: true})
+	requireNoError(t, err, "Failed to query first page")
+	requireEqual(t, 2, len(first.Pools), "First page size mismatch")
+	requireEqual(t, "1", first.Pools[0].ID, "First pool mismatch")
+	requireEqual(t, "10", first.Pagination.NextKey, "Next key mismatch")
+	requireEqual(t, "3", first.Pagination.Total, "Total mismatch")
+
+	last, err := mod.PoolsPage(ctx, &sdk.Pagination{Limit: 2, Key: first.Pagination.NextKey})
+	requireNoError(t, err, "Failed to query last page")
+	requireEqual(t, 1, len(last.Pools), "Last page size mismatch")
+	requireEqual(t, "10", last.Pools[0].ID, "Last pool mismatch")
+	requireTrue(t, last.Pagination == nil, "Last page should have no next key")
+
+	all, err := mod.PoolsPage(ctx, nil)
+	requireNoError(t, err, "Failed to query all pools")
+	requireEqual(t, 3, len(all.Pools), "Nil pagination should return every pool")
+}
+
 // TestMultistakingOutstandingRewards tests querying outstanding rewards.
 func TestMultistakingOutstandingRewards(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -99,6 +132,58 @@ func TestMultistakingStakingPoolDelegators(t *testing.T) {
 	t.Logf("Delegators for pool %s: %+v", poolID, result)
 }
 
+// TestMultistakingDelegatorDelegations tests listing a delegator's positions
+// across all pools.
+func TestMultistakingDelegatorDelegations(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := multistaking.New(client)
+	result, err := mod.DelegatorDelegations(ctx, testAddr, nil)
+	requireNoError(t, err, "Failed to query delegator delegations")
+	requireTrue(t, result.Delegations != nil, "Delegations should be an empty list, not nil")
+	for _, d := range result.Delegations {
+		t.Logf("  Pool %s (%s): shares=%v amount=%v", d.Pool, d.Validator, d.Shares, d.Amount)
+	}
+
+	// A fresh address has no delegations
+	keysMod := keys.New(client)
+	keyName := generateUniqueID("nodeleg")
+	keyInfo, err := keysMod.Add(ctx, keyName, nil)
+	requireNoError(t, err, "Failed to create key")
+	defer func() { _ = keysMod.Delete(ctx, keyName, true) }()
+
+	empty, err := mod.DelegatorDelegations(ctx, keyInfo.Address, &sdk.Pagination{Limit: 1})
+	requireNoError(t, err, "An address without delegations should not be an error")
+	requireEqual(t, 0, len(empty.Delegations), "Fresh address should have no delegations")
+}
+
+// TestMultistakingTotalRewards tests summing rewards across a delegator's pools.
+func TestMultistakingTotalRewards(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := multistaking.New(client)
+	result, err := mod.TotalRewards(ctx, testAddr)
+	requireNoError(t, err, "Failed to query total rewards")
+	requireEqual(t, result.Pools, len(result.PoolIDs), "Pool count should match the pool IDs")
+	t.Logf("Total rewards for %s from %d pools: %+v", testAddr, result.Pools, result.Total)
+
+	delegations, err := mod.DelegatorDelegations(ctx, testAddr, nil)
+	requireNoError(t, err, "Failed to query delegator delegations")
+	requireEqual(t, len(delegations.Delegations), result.Pools, "Pool count should match the delegations")
+}
+
 // TestMultistakingUndelegations tests querying undelegations.
 func TestMultistakingUndelegations(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -224,6 +309,29 @@ func TestMultistakingClaimRewards(t *testing.T) {
 	t.Logf("ClaimRewards TX: hash=%s, code=%d", resp.TxHash, resp.Code)
 }
 
+// TestMultistakingClaimAll tests claiming rewards from all of a delegator's pools.
+func TestMultistakingClaimAll(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	waitForBlocks(t, 1)
+
+	mod := multistaking.New(client)
+	result, err := mod.ClaimAll(ctx, TestKey, testAddr, nil, nil)
+	requireNoError(t, err, "Failed to discover pools to claim")
+	requireEqual(t, len(result.Claims), result.Succeeded+result.Failed, "Every pool should be reported")
+
+	for _, c := range result.Claims {
+		// Claims may fail if the pool has no rewards yet
+		t.Logf("  Pool %s: txhash=%s error=%s", c.Pool, c.TxHash, c.Error)
+	}
+}
+
 // TestMultistakingClaimUndelegation tests claiming a specific undelegation.
 func TestMultistakingClaimUndelegation(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -0,0 +1,42 @@
+// Package integration provides integration tests for the params module.
+package integration
+
+import (
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/params"
+)
+
+// TestParamsSubspace tests querying all parameters of a known subspace.
+func TestParamsSubspace(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := params.New(client)
+	result, err := mod.Params(ctx, "auth")
+	requireNoError(t, err, "Failed to query auth params")
+
+	keys, _ := params.SubspaceKeys("auth")
+	requireEqual(t, len(keys), len(result.Params), "Params count mismatch")
+	requireNotNil(t, result.Params["MaxMemoCharacters"], "MaxMemoCharacters missing")
+
+	t.Logf("Auth params: %v", result.Params)
+}
+
+// TestParamsUnknownSubspace tests that an unknown subspace needs a key.
+func TestParamsUnknownSubspace(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := params.New(client)
+	_, err := mod.Params(ctx, "nonexistent")
+	requireError(t, err, "Expected error for unknown subspace")
+}
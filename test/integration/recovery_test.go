@@ -2,8 +2,12 @@
 package integration
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/recovery"
 )
 
@@ -90,3 +94,39 @@ func TestRecoveryRRHolders(t *testing.T) {
 
 	t.Logf("RR holders: %s", string(result))
 }
+
+// TestRecoveryTx tests that the recovery transactions call sekaid with their
+// action, arguments, signer and tx flags.
+func TestRecoveryTx(t *testing.T) {
+	client := mock.NewClient()
+	mod := recovery.New(client)
+	ctx := context.Background()
+	opts := &recovery.TxOptions{Fees: "100ukex", Memo: "rotate"}
+
+	_, err := mod.RegisterRecoverySecret(ctx, "genesis", "challenge", "nonce", "proof", opts)
+	requireNoError(t, err, "Failed to register recovery secret")
+	_, err = mod.RotateRecoveryAddress(ctx, "kira1new", "kira1old", "kira1new", "proof", opts)
+	requireNoError(t, err, "Failed to rotate recovery address")
+	_, err = mod.IssueRecoveryTokens(ctx, "validator", nil)
+	requireNoError(t, err, "Failed to issue recovery tokens")
+
+	calls := client.GetTxCalls()
+	requireEqual(t, 3, len(calls), "Tx call count mismatch")
+	register, rotate, issue := calls[0].Request, calls[1].Request, calls[2].Request
+	requireEqual(t, "recovery", register.Module, "Module mismatch")
+	requireEqual(t, "register-recovery-secret", register.Action, "Register action mismatch")
+	requireEqual(t, "challenge nonce proof", strings.Join(register.Args, " "), "Register args mismatch")
+	requireEqual(t, "genesis", register.Signer, "Register signer mismatch")
+	requireEqual(t, "100ukex", register.Flags["fees"], "Fees flag mismatch")
+	requireEqual(t, "rotate", register.Flags["note"], "Memo should be passed as note")
+	requireEqual(t, "rotate-recovery-address", rotate.Action, "Rotate action mismatch")
+	requireEqual(t, "kira1old kira1new proof", strings.Join(rotate.Args, " "), "Rotate args mismatch")
+	requireEqual(t, "kira1new", rotate.Signer, "Rotate signer mismatch")
+	requireEqual(t, "issue-recovery-tokens", issue.Action, "Issue action mismatch")
+	requireEqual(t, 0, len(issue.Args), "Issue takes no args")
+
+	client.SetTxError("recovery", "claim-rrholder-rewards", errors.New("no rewards"))
+	_, err = mod.ClaimRRHolderRewards(ctx, "holder", nil)
+	requireError(t, err, "Claim should fail")
+	requireTrue(t, strings.Contains(err.Error(), "failed to claim RR holder rewards"), "Error should name the action: "+err.Error())
+}
@@ -0,0 +1,307 @@
+// Package integration provides integration tests for scenario execution.
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/scenarios"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/rest"
+)
+
+// benchmarkSteps is the size of the scenario run by the benchmarks.
+const benchmarkSteps = 100
+
+// queryScenario builds a scenario of n independent balance queries of
+// address, a key name to exercise the mapper's address cache.
+func queryScenario(n int, address string) *scenarios.Scenario {
+	scenario := &scenarios.Scenario{Name: "benchmark"}
+	for i := 0; i < n; i++ {
+		scenario.Steps = append(scenario.Steps, scenarios.Step{
+			Name:   fmt.Sprintf("balances-%d", i),
+			Module: "bank",
+			Action: "balances",
+			Params: map[string]string{"address": address},
+		})
+	}
+	return scenario
+}
+
+// perStepClient is the baseline of the benchmarks: every query runs on a
+// new client, with its own runtime lookup or HTTP connection pool, as
+// before clients were shared by the steps of a run.
+type perStepClient struct {
+	sdk.Client
+	newClient func() (sdk.Client, error)
+}
+
+func (c *perStepClient) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	client, err := c.newClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	return client.Query(ctx, req)
+}
+
+// benchmarkScenario runs a 100-step query scenario of address on client,
+// maxParallel steps at a time.
+func benchmarkScenario(b *testing.B, client sdk.Client, address string, maxParallel int) {
+	scenario := queryScenario(benchmarkSteps, address)
+	opts := scenarios.DefaultExecutorOptions()
+	opts.MaxParallel = maxParallel
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result, err := scenarios.NewExecutor(client, opts).Execute(context.Background(), scenario)
+		if err != nil {
+			b.Fatalf("Scenario failed: %v", err)
+		}
+		if !result.Success {
+			b.Fatalf("Scenario failed: %s", result.Error)
+		}
+	}
+	b.ReportMetric(float64(benchmarkSteps*b.N)/b.Elapsed().Seconds(), "steps/s")
+}
+
+// benchmarkDocker runs the scenario against the test container, on one
+// client reused by all steps or, with fresh, a new client per step.
+func benchmarkDocker(b *testing.B, maxParallel int, fresh bool) {
+	if !docker.IsContainerRunning(TestContainer) {
+		b.Skipf("Skipping benchmark: container %s is not running", TestContainer)
+	}
+	newClient := func() (sdk.Client, error) {
+		return docker.NewClient(TestContainer,
+			docker.WithChainID(TestChainID),
+			docker.WithKeyringBackend("test"),
+			docker.WithHome(TestHome),
+		)
+	}
+	client, err := newClient()
+	if err != nil {
+		b.Fatalf("Failed to create docker client: %v", err)
+	}
+	defer client.Close()
+	if fresh {
+		client = &perStepClient{Client: client, newClient: newClient}
+	}
+	benchmarkScenario(b, client, TestKey, maxParallel)
+}
+
+// BenchmarkScenarioSequential runs the scenario one step at a time.
+func BenchmarkScenarioSequential(b *testing.B) {
+	benchmarkDocker(b, 1, false)
+}
+
+// BenchmarkScenarioSequentialFreshClient is the baseline of
+// BenchmarkScenarioSequential, resolving the container runtime per step.
+func BenchmarkScenarioSequentialFreshClient(b *testing.B) {
+	benchmarkDocker(b, 1, true)
+}
+
+// BenchmarkScenarioParallel runs up to 8 steps concurrently on the shared
+// client.
+func BenchmarkScenarioParallel(b *testing.B) {
+	benchmarkDocker(b, 8, false)
+}
+
+// BenchmarkScenarioParallelFreshClient is the baseline of
+// BenchmarkScenarioParallel, with a new client per step.
+func BenchmarkScenarioParallelFreshClient(b *testing.B) {
+	benchmarkDocker(b, 8, true)
+}
+
+// benchmarkREST runs the scenario against a local Cosmos REST server, on
+// one pooled client or, with fresh, a new HTTP client and connection per
+// step. It needs no container.
+func benchmarkREST(b *testing.B, fresh bool) {
+	const address = "kira1w508d6qejxtdg4y5r3zarvary0c5xw7k2ja5w4"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"balances":[{"denom":"ukex","amount":"1000"}],"pagination":{"total":"1"}}`)
+	}))
+	defer srv.Close()
+
+	newClient := func() (sdk.Client, error) {
+		return rest.NewClient(srv.URL, rest.WithINTERX(false))
+	}
+	client, err := newClient()
+	if err != nil {
+		b.Fatalf("Failed to create REST client: %v", err)
+	}
+	defer client.Close()
+	if fresh {
+		client = &perStepClient{Client: client, newClient: newClient}
+	}
+	benchmarkScenario(b, client, address, 8)
+}
+
+// BenchmarkScenarioREST runs the scenario 8 steps at a time on one client
+// with keep-alive connections.
+func BenchmarkScenarioREST(b *testing.B) {
+	benchmarkREST(b, false)
+}
+
+// BenchmarkScenarioRESTFreshClient is the baseline of BenchmarkScenarioREST,
+// opening a new connection per step.
+func BenchmarkScenarioRESTFreshClient(b *testing.B) {
+	benchmarkREST(b, true)
+}
+
+// TestScenarioLint tests that lint reports missing params, variables set
+// only by later steps and the steps they leave unreachable, and accepts a
+// scenario of mapped actions.
+func TestScenarioLint(t *testing.T) {
+	scenario, err := scenarios.LoadFromString(`
+name: lint
+steps:
+  - name: send
+    module: bank
+    action: send
+    params:
+      from: genesis
+      to: "{{ recipient }}"
+  - name: resend
+    module: bank
+    action: send
+    params:
+      from: genesis
+      to: "{{ addr.address }}"
+      amount: 1ukex
+  - name: key
+    module: keys
+    action: show
+    output: addr
+    params:
+      name: genesis
+`)
+	requireNoError(t, err, "Failed to load scenario")
+
+	issues := scenarios.Lint(scenario, map[string]string{"recipient": "kira1..."})
+	requireEqual(t, 3, len(issues), "Unexpected number of issues")
+	requireEqual(t, 1, issues[0].Step, "Missing amount should be reported for step 1")
+	requireEqual(t, scenarios.SeverityError, issues[0].Severity, "Missing params should be an error")
+	requireEqual(t, 2, issues[1].Step, "Variable set by a later step should be reported for step 2")
+	requireEqual(t, 2, issues[2].Step, "Step 2 should be unreachable behind step 1")
+	requireEqual(t, scenarios.SeverityWarning, issues[2].Severity, "Unreachable steps should be a warning")
+
+	scenario.Steps[0].Params["amount"] = "1ukex"
+	scenario.Steps[1].Params["to"] = "{{ recipient }}"
+	issues = scenarios.Lint(scenario, map[string]string{"recipient": "kira1..."})
+	requireEqual(t, 0, len(issues), "Fixed scenario should have no issues")
+}
+
+// TestScenarioSchema tests that the scenario JSON Schema lists the modules
+// and the required params of mapped actions.
+func TestScenarioSchema(t *testing.T) {
+	schema := scenarios.Schema()
+	b, err := json.Marshal(schema)
+	requireNoError(t, err, "Failed to encode schema")
+	encoded := string(b)
+
+	requireEqual(t, "http://json-schema.org/draft-07/schema#", schema["$schema"], "Unexpected $schema")
+	requireTrue(t, strings.Contains(encoded, `"enum":["`), "Schema should list the modules")
+	requireTrue(t, strings.Contains(encoded, `"const":"bank"`), "Schema should have rules for bank")
+	requireTrue(t, strings.Contains(encoded, `"required":["from","to","amount"]`), "Schema should require the params of bank send")
+}
+
+// TestScenarioStepSelection tests that --step, --from-step and --to-step
+// run only the selected steps, and that a selected step needing the output
+// of an unselected one fails unless the value is given as a variable.
+func TestScenarioStepSelection(t *testing.T) {
+	scenario, err := scenarios.LoadFromString(`
+name: select
+steps:
+  - name: key
+    module: keys
+    action: show
+    output: addr
+    params:
+      name: genesis
+  - name: balances
+    module: bank
+    action: balances
+    params:
+      address: "{{ addr.address }}"
+  - name: supply
+    module: bank
+    action: total
+    depends_on: [key]
+`)
+	requireNoError(t, err, "Failed to load scenario")
+
+	run := func(opts *scenarios.ExecutorOptions) (*scenarios.ExecutionResult, error) {
+		opts.DryRun = true
+		executor := scenarios.NewExecutor(nil, opts)
+		executor.SetOutput(io.Discard)
+		return executor.Execute(context.Background(), scenario)
+	}
+
+	opts := scenarios.DefaultExecutorOptions()
+	opts.Steps = []string{"balances"}
+	_, err = run(opts)
+	requireError(t, err, "Step using the output of an unselected step should fail")
+	requireTrue(t, strings.Contains(err.Error(), "'key'"), "Error should name the providing step: "+err.Error())
+
+	opts = scenarios.DefaultExecutorOptions()
+	opts.Steps = []string{"supply"}
+	result, err := run(opts)
+	requireNoError(t, err, "Step depending on an unselected step should run")
+	requireEqual(t, 1, len(result.Steps), "Only the selected step should run")
+	requireEqual(t, "supply", result.Steps[0].Name, "Unexpected step")
+
+	opts = scenarios.DefaultExecutorOptions()
+	opts.FromStep = "key"
+	opts.ToStep = "balances"
+	result, err = run(opts)
+	requireNoError(t, err, "Range selection failed")
+	requireEqual(t, 2, len(result.Steps), "Range should select two steps")
+
+	opts = scenarios.DefaultExecutorOptions()
+	opts.Steps = []string{"nosuchstep"}
+	_, err = run(opts)
+	requireError(t, err, "Unknown step should be rejected")
+}
+
+// TestScenarioDryRunUnsignedTx tests that a dry run builds the unsigned
+// transaction of a transaction step without broadcasting it.
+func TestScenarioDryRunUnsignedTx(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	scenario := &scenarios.Scenario{
+		Name: "dry-run",
+		Steps: []scenarios.Step{{
+			Name:   "send",
+			Module: "bank",
+			Action: "send",
+			Params: map[string]string{"from": TestKey, "to": testAddr, "amount": "1ukex"},
+			TxOptions: &scenarios.StepTxOptions{
+				Fees: "100ukex",
+			},
+		}},
+	}
+	opts := scenarios.DefaultExecutorOptions()
+	opts.DryRun = true
+	executor := scenarios.NewExecutor(client, opts)
+	executor.SetOutput(io.Discard)
+
+	result, err := executor.Execute(ctx, scenario)
+	requireNoError(t, err, "Dry run failed")
+	requireTrue(t, result.Success, "Dry run should succeed: "+result.Error)
+	requireEqual(t, 1, len(result.Steps), "Unexpected number of step results")
+	requireTrue(t, result.Steps[0].Skipped, "Dry run step should be skipped")
+	requireTrue(t, strings.Contains(string(result.Steps[0].UnsignedTx), "MsgSend"), "Unsigned tx should hold the send message")
+}
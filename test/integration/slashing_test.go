@@ -3,6 +3,7 @@ package integration
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/slashing"
 )
@@ -61,6 +62,39 @@ func TestSlashingSigningInfo(t *testing.T) {
 		result.ValSigningInfo.Mischance)
 }
 
+// TestSlashingJailStatus tests the jail status of a validator by consensus
+// and operator address.
+func TestSlashingJailStatus(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := slashing.New(client)
+	allInfos, err := mod.SigningInfos(ctx)
+	requireNoError(t, err, "Failed to query signing infos")
+	requireTrue(t, len(allInfos.Info) > 0, "No signing infos found")
+
+	consAddr := allInfos.Info[0].Address
+	byCons, err := mod.JailStatus(ctx, consAddr, 10*time.Minute)
+	requireNoError(t, err, "Failed to query jail status by consensus address")
+	requireEqual(t, consAddr, byCons.ConsAddress, "Consensus address mismatch")
+	requireTrue(t, byCons.State != "" && byCons.Message != "", "Jail status should have a state and message")
+
+	if byCons.ValAddress != "" {
+		byVal, err := mod.JailStatus(ctx, byCons.ValAddress, 10*time.Minute)
+		requireNoError(t, err, "Failed to query jail status by operator address")
+		requireEqual(t, consAddr, byVal.ConsAddress, "Operator address should resolve to the same consensus address")
+		requireEqual(t, byCons.State, byVal.State, "State mismatch")
+	}
+
+	_, err = mod.JailStatus(ctx, getTestAddress(t), 0)
+	requireError(t, err, "An account address should be rejected")
+	t.Logf("Validator %s (%s): %s", byCons.Moniker, byCons.ValAddress, byCons.Message)
+}
+
 // TestSlashingActiveStakingPools tests querying active staking pools.
 func TestSlashingActiveStakingPools(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -2,8 +2,14 @@
 package integration
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/docker"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/failover"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/status"
 )
 
@@ -28,6 +34,38 @@ func TestStatusNodeInfo(t *testing.T) {
 	t.Logf("Node: %s, Network: %s, Version: %s", result.Moniker, result.Network, result.Version)
 }
 
+// TestStatusAppVersion tests that the node's sekaid version can be read
+// and lies within the supported range, which is pinned to the sekaid of
+// the integration network.
+func TestStatusAppVersion(t *testing.T) {
+	ok, err := sdk.IsSupportedSekaidVersion(sdk.MinSekaidVersion)
+	requireNoError(t, err, "Minimum version should parse")
+	requireTrue(t, ok, "Minimum version should be supported")
+	ok, err = sdk.IsSupportedSekaidVersion(sdk.MaxSekaidVersion)
+	requireNoError(t, err, "Maximum version should parse")
+	requireTrue(t, !ok, "Maximum version should be excluded")
+	_, err = sdk.IsSupportedSekaidVersion("not-a-version")
+	requireError(t, err, "Malformed version should be rejected")
+
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	avc, isAVC := client.(sdk.AppVersionClient)
+	requireTrue(t, isAVC, "Docker client should report the sekaid version")
+	version, err := avc.AppVersion(ctx)
+	requireNoError(t, err, "Failed to get sekaid version")
+	requireTrue(t, version != "", "sekaid version should not be empty")
+
+	supported, err := sdk.IsSupportedSekaidVersion(version)
+	requireNoError(t, err, "sekaid version should parse")
+	requireTrue(t, supported, "sekaid "+version+" of the integration network is outside the supported range "+sdk.SekaidVersionRange())
+	t.Logf("sekaid %s, supported %s", version, sdk.SekaidVersionRange())
+}
+
 // TestStatusSyncInfo tests querying sync info.
 func TestStatusSyncInfo(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -165,3 +203,109 @@ func TestStatusFull(t *testing.T) {
 		result.SyncInfo.LatestBlockHeight,
 		result.SyncInfo.CatchingUp)
 }
+
+// TestStatusSummary tests the flattened status digest.
+func TestStatusSummary(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := status.New(client)
+	result, err := mod.Summary(ctx)
+	requireNoError(t, err, "Failed to query status summary")
+	requireNotNil(t, result, "Summary is nil")
+
+	requireEqual(t, TestChainID, result.ChainID, "Chain ID mismatch")
+	requireTrue(t, result.LatestBlockHeight > 0, "Block height should be positive")
+	requireTrue(t, result.Sync == "synced" || result.Sync == "catching up", "Unexpected sync state")
+
+	peers := -1
+	if result.PeerCount != nil {
+		peers = *result.PeerCount
+	}
+	t.Logf("Sync: %s, Height: %d, Peers: %d", result.Sync, result.LatestBlockHeight, peers)
+}
+
+// TestStatusNodeFailover tests that a node list skips an unreachable node.
+func TestStatusNodeFailover(t *testing.T) {
+	skipIfContainerNotRunning(t)
+
+	var endpoints []failover.Endpoint
+	for _, node := range []string{"tcp://127.0.0.1:1", "tcp://localhost:26657"} {
+		client, err := docker.NewClient(TestContainer,
+			docker.WithChainID(TestChainID),
+			docker.WithKeyringBackend("test"),
+			docker.WithHome(TestHome),
+			docker.WithNode(node),
+		)
+		requireNoError(t, err, "Failed to create docker client")
+		endpoints = append(endpoints, failover.Endpoint{Name: node, Client: client})
+	}
+	client, err := failover.NewClient(endpoints)
+	requireNoError(t, err, "Failed to create failover client")
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	result, err := status.New(client).NodeInfo(ctx)
+	requireNoError(t, err, "Failed to query node info through failover")
+	requireEqual(t, TestChainID, result.Network, "Chain ID mismatch")
+	requireEqual(t, "tcp://localhost:26657", client.Active(), "Should stay on the healthy node")
+
+	// A list of only unreachable nodes fails with every node's error
+	dead, err := docker.NewClient(TestContainer, docker.WithHome(TestHome), docker.WithNode("tcp://127.0.0.1:1"))
+	requireNoError(t, err, "Failed to create docker client")
+	client, err = failover.NewClient([]failover.Endpoint{{Name: "dead", Client: dead}})
+	requireNoError(t, err, "Failed to create failover client")
+	_, err = client.Query(ctx, &sdk.QueryRequest{Module: "auth", Endpoint: "params"})
+	requireError(t, err, "Query should fail when no node is reachable")
+}
+
+// TestStatusNodeFailoverRecovery tests that nodes that went down are
+// probed again, after a backoff or at once when every node is down.
+func TestStatusNodeFailoverRecovery(t *testing.T) {
+	refused := errors.New("dial tcp 127.0.0.1:1: connect: connection refused")
+	query := &sdk.QueryRequest{Module: "auth", Endpoint: "params"}
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	// Every node down: the next call probes them all again
+	a, b := mock.NewClient(), mock.NewClient()
+	a.SetStatusError(refused)
+	b.SetStatusError(refused)
+	client, err := failover.NewClient([]failover.Endpoint{{Name: "a", Client: a}, {Name: "b", Client: b}},
+		failover.WithRetryAfter(time.Hour))
+	requireNoError(t, err, "Failed to create failover client")
+	_, err = client.Query(ctx, query)
+	requireError(t, err, "Query should fail when no node is reachable")
+
+	a.SetStatusError(nil)
+	requireNoError(t, a.SetQueryResponse("auth", "params", map[string]string{}), "Failed to set query response")
+	_, err = client.Query(ctx, query)
+	requireNoError(t, err, "Query should succeed once a node is back")
+	requireEqual(t, "a", client.Active(), "Should use the recovered node")
+
+	// One node down: it is probed again once its backoff has passed
+	a, b = mock.NewClient(), mock.NewClient()
+	a.SetStatusError(refused)
+	requireNoError(t, b.SetQueryResponse("auth", "params", map[string]string{}), "Failed to set query response")
+	client, err = failover.NewClient([]failover.Endpoint{{Name: "a", Client: a}, {Name: "b", Client: b}},
+		failover.WithRetryAfter(20*time.Millisecond))
+	requireNoError(t, err, "Failed to create failover client")
+	_, err = client.Query(ctx, query)
+	requireNoError(t, err, "Query should fail over to b")
+	requireEqual(t, "b", client.Active(), "Should use the healthy node")
+
+	a.SetStatusError(nil)
+	requireNoError(t, a.SetQueryResponse("auth", "params", map[string]string{}), "Failed to set query response")
+	b.SetQueryError("auth", "params", refused)
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.Query(ctx, query)
+	requireNoError(t, err, "Query should fail back to a after its backoff")
+	requireEqual(t, "a", client.Active(), "Should use the recovered node")
+}
@@ -0,0 +1,168 @@
+// Package integration provides tests for streamed list output.
+package integration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/kiracore/sekai-cli/internal/output"
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/auth"
+)
+
+// accountsClient answers "auth accounts" with pages of total generated
+// accounts. The next key of a page is the offset of the next account.
+type accountsClient struct {
+	*mock.Client
+	total int
+	pages int
+}
+
+func (c *accountsClient) Query(ctx context.Context, req *sdk.QueryRequest) (*sdk.QueryResponse, error) {
+	if req.Module != "auth" || req.Endpoint != "accounts" {
+		return c.Client.Query(ctx, req)
+	}
+	c.pages++
+	start, _ := strconv.Atoi(req.Params["page-key"])
+	limit, _ := strconv.Atoi(req.Params["limit"])
+	end := min(start+limit, c.total)
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"accounts":[`)
+	for i := start; i < end; i++ {
+		if i > start {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `{"@type":"/cosmos.auth.v1beta1.BaseAccount","address":"kira1account%07d","account_number":"%d","sequence":"0"}`, i, i)
+	}
+	next := ""
+	if end < c.total {
+		next = strconv.Itoa(end)
+	}
+	fmt.Fprintf(&buf, `],"pagination":{"next_key":%q,"total":"%d"}}`, next, c.total)
+	return &sdk.QueryResponse{Data: buf.Bytes()}, nil
+}
+
+// TestAccountsStream tests that streaming the accounts page by page prints
+// the same JSON array as formatting them all at once.
+func TestAccountsStream(t *testing.T) {
+	client := &accountsClient{Client: mock.NewClient(), total: 25}
+	mod := auth.New(client)
+	ctx := context.Background()
+
+	for _, indent := range []bool{true, false} {
+		client.pages = 0
+		formatter := &output.JSONFormatter{Indent: indent}
+		var streamed bytes.Buffer
+		stream := output.NewJSONArrayWriter(&streamed, formatter)
+		err := mod.AccountPages(ctx, &sdk.Pagination{Limit: 10}, func(page *auth.AccountsResponse) error {
+			for _, account := range page.Accounts {
+				if err := stream.Write(account); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		requireNoError(t, err, "Failed to stream accounts")
+		requireNoError(t, stream.Close(), "Failed to close stream")
+		requireEqual(t, 3, client.pages, "Page count mismatch")
+		requireEqual(t, 25, stream.Count(), "Streamed account count mismatch")
+
+		all, err := mod.Accounts(ctx, &sdk.Pagination{Limit: 25})
+		requireNoError(t, err, "Failed to query accounts")
+		var buffered bytes.Buffer
+		requireNoError(t, formatter.Format(&buffered, all.Accounts), "Failed to format accounts")
+		requireEqual(t, buffered.String(), streamed.String(), "Streamed output should match buffered output")
+	}
+
+	var empty bytes.Buffer
+	stream := output.NewJSONArrayWriter(&empty, &output.JSONFormatter{Indent: true})
+	requireNoError(t, stream.Close(), "Failed to close stream")
+	requireEqual(t, "[]\n", empty.String(), "Empty stream should be an empty array")
+}
+
+// benchmarkAccounts is the number of accounts queried by the accounts
+// output benchmarks.
+const benchmarkAccounts = 100000
+
+// liveHeap returns the bytes of heap in use after a garbage collection.
+func liveHeap() uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// reportPeak reports the peak live heap over the baseline in MB.
+func reportPeak(b *testing.B, peak, baseline uint64) {
+	b.ReportMetric(float64(peak-min(peak, baseline))/(1<<20), "peak-MB")
+}
+
+// BenchmarkAccountsBuffered queries 100k accounts in one page and formats
+// them as JSON, as "query auth accounts --limit 100000 --output json" does.
+func BenchmarkAccountsBuffered(b *testing.B) {
+	client := &accountsClient{Client: mock.NewClient(), total: benchmarkAccounts}
+	mod := auth.New(client)
+	formatter := &output.JSONFormatter{Indent: true}
+	baseline := liveHeap()
+	var peak uint64
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		accounts, err := mod.Accounts(context.Background(), &sdk.Pagination{Limit: benchmarkAccounts})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := formatter.Format(io.Discard, accounts.Accounts); err != nil {
+			b.Fatal(err)
+		}
+		b.StopTimer()
+		peak = max(peak, liveHeap())
+		runtime.KeepAlive(accounts)
+		b.StartTimer()
+	}
+	reportPeak(b, peak, baseline)
+}
+
+// BenchmarkAccountsStreamed queries 100k accounts 1000 at a time and
+// writes each page as it arrives, as "query auth accounts --limit 1000
+// --output json --stream" does.
+func BenchmarkAccountsStreamed(b *testing.B) {
+	client := &accountsClient{Client: mock.NewClient(), total: benchmarkAccounts}
+	mod := auth.New(client)
+	formatter := &output.JSONFormatter{Indent: true}
+	baseline := liveHeap()
+	var peak uint64
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stream := output.NewJSONArrayWriter(io.Discard, formatter)
+		err := mod.AccountPages(context.Background(), &sdk.Pagination{Limit: 1000}, func(page *auth.AccountsResponse) error {
+			for _, account := range page.Accounts {
+				if err := stream.Write(account); err != nil {
+					return err
+				}
+			}
+			if page.Pagination.NextKey == "50000" {
+				b.StopTimer()
+				peak = max(peak, liveHeap())
+				runtime.KeepAlive(page)
+				b.StartTimer()
+			}
+			return nil
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := stream.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	reportPeak(b, peak, baseline)
+}
@@ -28,6 +28,36 @@ func TestTokensAllRates(t *testing.T) {
 	}
 }
 
+// TestTokensAllRatesFiltered tests filtering and sorting all token rates.
+func TestTokensAllRatesFiltered(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := tokens.New(client)
+	all, err := mod.AllRates(ctx)
+	requireNoError(t, err, "Failed to query all rates")
+
+	filtered, err := mod.FilteredRates(ctx, &tokens.RatesQueryOpts{StakingOnly: true, FeePaymentsOnly: true})
+	requireNoError(t, err, "Failed to query filtered rates")
+	requireTrue(t, len(filtered.Data) <= len(all.Data), "Filtered rates should not exceed all rates")
+	for _, rate := range filtered.Data {
+		requireTrue(t, rate.Data.StakeEnabled && rate.Data.FeeEnabled, "Rate does not match the filters: "+rate.Data.Denom)
+	}
+
+	byPrefix, err := mod.FilteredRates(ctx, &tokens.RatesQueryOpts{DenomPrefix: "ukex"})
+	requireNoError(t, err, "Failed to query rates by denom prefix")
+	requireTrue(t, len(byPrefix.Data) > 0, "ukex should have a token rate")
+
+	err = tokens.SortRates(all.Data, "fee-rate", true)
+	requireNoError(t, err, "Failed to sort rates")
+	requireError(t, tokens.SortRates(all.Data, "supply", false), "Unknown sort key should fail")
+	t.Logf("%d of %d tokens are stakeable and pay fees", len(filtered.Data), len(all.Data))
+}
+
 // TestTokensRate tests querying a specific token rate.
 func TestTokensRate(t *testing.T) {
 	skipIfContainerNotRunning(t)
@@ -83,6 +113,25 @@ func TestTokensBlackWhites(t *testing.T) {
 	t.Logf("Blacklisted tokens: %v", result.Blacklisted)
 }
 
+// TestTokensTokenInfo tests querying aggregated token info.
+func TestTokensTokenInfo(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := tokens.New(client)
+	result, err := mod.TokenInfo(ctx, "ukex")
+	requireNoError(t, err, "Failed to query token info")
+	requireNotNil(t, result.Rate, "Rate is nil")
+	requireEqual(t, "ukex", result.Rate.Denom, "Denom mismatch")
+	requireNotNil(t, result.FeePayments, "Fee payments is nil")
+
+	t.Logf("Token info: rate=%s, supply=%v, missing=%v", result.Rate.FeeRate, result.Supply, result.Missing)
+}
+
 // TestTokensProposalUpsertRate tests creating a proposal to upsert a token rate.
 // This is an atomic test sequence that:
 // 1. Submits a proposal to upsert a new token rate
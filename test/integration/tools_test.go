@@ -0,0 +1,64 @@
+// Package integration provides integration tests for the coin helpers.
+package integration
+
+import (
+	"testing"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// TestCoinsAddSub tests that coin sums keep other denoms separate and that
+// subtraction fails rather than going negative.
+func TestCoinsAddSub(t *testing.T) {
+	a, err := types.ParseCoins("100ukex,5lol")
+	requireNoError(t, err, "Failed to parse coins")
+	b, err := types.ParseCoins("50ukex,1samolean")
+	requireNoError(t, err, "Failed to parse coins")
+
+	sum, err := a.Add(b)
+	requireNoError(t, err, "Failed to add coins")
+	requireEqual(t, "5lol,1samolean,150ukex", sum.String(), "Sum mismatch")
+
+	diff, err := sum.Sub(a)
+	requireNoError(t, err, "Failed to subtract coins")
+	requireEqual(t, "1samolean,50ukex", diff.String(), "Difference mismatch")
+
+	_, err = a.Sub(b)
+	requireError(t, err, "Subtracting a denom not held should fail")
+	_, err = b.Sub(a)
+	requireError(t, err, "Subtracting more than held should fail")
+}
+
+// TestCoinsConvert tests converting between a token's base denom and its
+// symbol.
+func TestCoinsConvert(t *testing.T) {
+	registry := types.DenomRegistry{
+		"ukex": {Denom: "ukex", Symbol: "KEX", Decimals: 6},
+		"lol":  {Denom: "lol", Symbol: "LOL", Decimals: 0},
+	}
+	convert := func(coin, to string) (string, error) {
+		c, err := types.ParseDecCoin(coin)
+		requireNoError(t, err, "Failed to parse "+coin)
+		result, err := registry.Convert(c, to)
+		return result.String(), err
+	}
+
+	got, err := convert("1.5kex", "ukex")
+	requireNoError(t, err, "Failed to convert to base denom")
+	requireEqual(t, "1500000ukex", got, "Conversion to base denom mismatch")
+
+	got, err = convert("2500001ukex", "KEX")
+	requireNoError(t, err, "Failed to convert to symbol")
+	requireEqual(t, "2.500001KEX", got, "Conversion to symbol mismatch")
+
+	got, err = convert("3000000ukex", "kex")
+	requireNoError(t, err, "Failed to convert to symbol")
+	requireEqual(t, "3kex", got, "Whole amounts should have no decimals")
+
+	_, err = convert("0.0000001kex", "ukex")
+	requireError(t, err, "Amounts finer than the base denom should fail")
+	_, err = convert("1kex", "lol")
+	requireError(t, err, "Converting between tokens should fail")
+	_, err = convert("1atom", "ukex")
+	requireError(t, err, "Unknown denoms should fail")
+}
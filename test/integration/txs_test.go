@@ -0,0 +1,99 @@
+// Package integration provides integration tests for the txs module.
+package integration
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/bank"
+	"github.com/kiracore/sekai-cli/pkg/sdk/modules/txs"
+	"github.com/kiracore/sekai-cli/pkg/sdk/types"
+)
+
+// TestTxsQuery tests querying an included transaction by hash.
+func TestTxsQuery(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	amount := types.NewCoins(types.NewCoin("ukex", 100))
+	resp, err := bank.New(client).Send(ctx, TestKey, testAddr, amount, nil)
+	requireNoError(t, err, "Failed to send tokens")
+	requireTxSuccess(t, resp, "Send transaction failed")
+
+	// Wait for TX to be included in block
+	time.Sleep(7 * time.Second)
+
+	result, err := txs.New(client).Tx(ctx, resp.TxHash)
+	requireNoError(t, err, "Failed to query tx")
+	requireNotNil(t, result, "Tx result is nil")
+	requireEqual(t, strings.ToUpper(resp.TxHash), strings.ToUpper(result.TxHash), "Tx hash mismatch")
+	requireTrue(t, result.Success(), "Tx should have succeeded")
+	requireTrue(t, result.Height > 0, "Tx should have a height")
+
+	t.Logf("Tx %s: height=%d gas=%d/%d events=%d", result.TxHash, result.Height, result.GasUsed, result.GasWanted, len(result.Events))
+}
+
+// TestTxsQueryNotFound tests that unknown and malformed hashes are reported distinctly.
+func TestTxsQueryNotFound(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	mod := txs.New(client)
+
+	_, err := mod.Tx(ctx, strings.Repeat("0", 64))
+	requireTrue(t, errors.Is(err, sdk.ErrTxNotFound), "Unknown hash should return ErrTxNotFound")
+
+	_, err = mod.Tx(ctx, "not-a-hash")
+	requireTrue(t, errors.Is(err, txs.ErrInvalidHash), "Malformed hash should return ErrInvalidHash")
+}
+
+// TestTxEncodeDecode tests converting a generated tx to base64 bytes and back.
+func TestTxEncodeDecode(t *testing.T) {
+	skipIfContainerNotRunning(t)
+	testAddr := getTestAddress(t)
+	client := getTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := getTestContext()
+	defer cancel()
+
+	otc, ok := client.(sdk.OfflineTxClient)
+	requireTrue(t, ok, "Docker client should generate transactions")
+	codec, ok := client.(sdk.TxCodec)
+	requireTrue(t, ok, "Docker client should encode transactions")
+
+	amount := types.NewCoins(types.NewCoin("ukex", 1000))
+	unsigned, err := otc.GenerateTx(ctx, &sdk.TxRequest{
+		Module: "bank",
+		Action: "send",
+		Args:   []string{TestKey, testAddr, amount.String()},
+		Signer: TestKey,
+		Flags:  map[string]string{"memo": "encode-decode"},
+	})
+	requireNoError(t, err, "Failed to generate unsigned tx")
+
+	encoded, err := codec.EncodeTx(ctx, unsigned)
+	requireNoError(t, err, "Failed to encode tx")
+	requireTrue(t, encoded != "", "Encoded tx should not be empty")
+
+	decoded, err := codec.DecodeTx(ctx, encoded)
+	requireNoError(t, err, "Failed to decode tx")
+	requireTrue(t, strings.Contains(string(decoded), "encode-decode"), "Decoded tx should carry the memo")
+
+	_, err = codec.DecodeTx(ctx, "not base64!")
+	requireError(t, err, "Decoding malformed base64 should fail")
+	_, err = codec.EncodeTx(ctx, []byte(`{"body":`))
+	requireError(t, err, "Encoding malformed JSON should fail")
+}
@@ -2,8 +2,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
+	"github.com/kiracore/sekai-cli/pkg/sdk"
+	"github.com/kiracore/sekai-cli/pkg/sdk/client/mock"
 	"github.com/kiracore/sekai-cli/pkg/sdk/modules/upgrade"
 )
 
@@ -108,3 +111,50 @@ func TestUpgradeProposalCancelPlan(t *testing.T) {
 	t.Logf("ProposalCancelPlan TX: hash=%s, code=%d", resp.TxHash, resp.Code)
 	requireTrue(t, resp.TxHash != "", "TX hash should not be empty")
 }
+
+// TestUpgradeCountdown tests the countdown to a plan's upgrade time and
+// height from the latest block, and the statuses of passed and missing
+// plans.
+func TestUpgradeCountdown(t *testing.T) {
+	client := mock.NewClient()
+	requireNoError(t, client.SetQueryResponse("upgrade", "current-plan", map[string]interface{}{
+		"plan": map[string]interface{}{"name": "v0.4", "height": "1500", "upgrade_time": "1700009000"},
+	}), "Failed to set plan")
+	plan, err := upgrade.New(client).CurrentPlan(context.Background())
+	requireNoError(t, err, "Failed to query current plan")
+
+	status := &sdk.StatusResponse{SyncInfo: sdk.SyncInfo{LatestBlockHeight: 1000, LatestBlockTime: "2023-11-14T22:13:20Z"}}
+	c, err := upgrade.NewCountdown(plan, status)
+	requireNoError(t, err, "Failed to compute countdown")
+	requireEqual(t, "v0.4", c.Plan, "Plan name mismatch")
+	requireEqual(t, "2023-11-15T00:43:20Z", c.UpgradeTime, "Upgrade time mismatch")
+	requireEqual(t, "2h30m0s", c.TimeRemaining, "Time remaining mismatch")
+	requireEqual(t, int64(500), *c.BlocksRemaining, "Blocks remaining mismatch")
+	requireEqual(t, "in 2h30m0s and in 500 blocks", c.Status, "Status mismatch")
+	requireTrue(t, !c.Passed, "Upgrade should not have passed")
+
+	// The height is reached but the time is not
+	status.SyncInfo.LatestBlockHeight = 1600
+	c, err = upgrade.NewCountdown(plan, status)
+	requireNoError(t, err, "Failed to compute countdown")
+	requireEqual(t, int64(0), *c.BlocksRemaining, "Blocks remaining should stop at 0")
+	requireEqual(t, "in 2h30m0s", c.Status, "Status mismatch")
+
+	status.SyncInfo.LatestBlockTime = "2023-11-15T01:00:00Z"
+	c, err = upgrade.NewCountdown(plan, status)
+	requireNoError(t, err, "Failed to compute countdown")
+	requireTrue(t, c.Passed, "Upgrade should have passed")
+	requireEqual(t, "passed", c.Status, "Status mismatch")
+
+	c, err = upgrade.NewCountdown(&upgrade.Plan{Name: "v0.5", UpgradeTime: "2023-11-15T02:00:00Z"}, status)
+	requireNoError(t, err, "Failed to compute countdown")
+	requireEqual(t, "in 1h0m0s", c.Status, "RFC 3339 upgrade time mismatch")
+	requireTrue(t, c.BlocksRemaining == nil, "Plan without height has no blocks remaining")
+
+	c, err = upgrade.NewCountdown(nil, status)
+	requireNoError(t, err, "Failed to compute countdown")
+	requireEqual(t, "no upgrade plan set", c.Status, "Missing plan status mismatch")
+
+	_, err = upgrade.NewCountdown(&upgrade.Plan{Name: "bad", UpgradeTime: "soon"}, status)
+	requireError(t, err, "Invalid upgrade time should fail")
+}